@@ -1,21 +1,34 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
+	"net/http"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/app"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/config"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
 	routes "github.com/GavFurtado/showdown-draft-league/new-backend/internal/router"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"golang.org/x/oauth2"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// shutdownTimeout bounds how long in-flight requests and running scheduler tasks get to finish on
+// SIGINT/SIGTERM. A task cut short stays PENDING and runs again after the next start.
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	// Load application configuration
 	cfg := config.LoadConfig()
@@ -55,14 +68,26 @@ func main() {
 		&models.PokemonSpecies{},
 		&models.Draft{},
 		&models.Game{},
+		&models.GameReport{},
 		&models.LeagueMember{},
 		&models.PoolEntry{},
 		&models.DraftPick{},
 		&models.Claim{},
+		&models.ClaimGameStat{},
+		&models.DraftQueueEntry{},
+		&models.DraftPickSlot{},
+		&models.DraftPickTrade{},
+		&models.ScheduledTask{},
 	)
 	if err != nil {
 		log.Fatalf("Failed to auto-migrate database: %v", err)
 	}
+	if err := db.Exec("CREATE SEQUENCE IF NOT EXISTS " + repositories.DraftEventIDSequence).Error; err != nil {
+		log.Fatalf("Failed to create the draft event ID sequence: %v", err)
+	}
+	if err := backfillTiebreakSeeds(db); err != nil {
+		log.Fatalf("Failed to backfill tiebreak seeds: %v", err)
+	}
 
 	// Initialize Repositories, Servies and Controllers
 	appRepositories := app.NewRepositories(db)
@@ -70,6 +95,13 @@ func main() {
 	appControllers := app.NewControllers(appServices, appRepositories, cfg, discordOauthConfig)
 
 	// Start the scheduler
+	if err := services.BackfillScheduledTasks(
+		appRepositories.ScheduledTaskRepository,
+		appRepositories.LeagueRepository,
+		appRepositories.DraftRepository,
+	); err != nil {
+		log.Fatalf("Failed to backfill scheduled tasks: %v", err)
+	}
 	if err := appServices.SchedulerService.Start(); err != nil {
 		log.Fatalf("Failed to start scheduler: %v", err)
 	}
@@ -88,8 +120,89 @@ func main() {
 	routes.RegisterRoutes(server, db, cfg, appRepositories, appServices, appControllers)
 
 	// Run server
-	fmt.Printf("Server started...\n")
-	if err := server.Run(":" + port); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	httpServer := &http.Server{
+		Addr:    ":" + port,
+		Handler: server,
+	}
+	// draft event streams never go idle on their own; end them so Shutdown can drain
+	httpServer.RegisterOnShutdown(appServices.DraftEventService.Close)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		fmt.Printf("Server started...\n")
+		serverErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Server failed to start: %v", err)
+		}
+	case <-ctx.Done():
+		log.Printf("LOG: Shutdown signal received. Shutting down...\n")
+	}
+	stop() // a second signal kills the process straight away
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	shutdown(shutdownCtx, httpServer, appServices, db)
+}
+
+// shutdown stops accepting requests and waits for in-flight requests and running scheduler tasks to
+// finish, both at once and no later than ctx's deadline, then closes the database pool. The scheduler
+// stores any task write that failed before it stops.
+func shutdown(ctx context.Context, httpServer *http.Server, appServices *app.Services, db *gorm.DB) {
+	httpDone := make(chan struct{})
+	go func() {
+		defer close(httpDone)
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Printf("ERROR: HTTP server did not shut down cleanly: %v\n", err)
+		}
+	}()
+
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		appServices.SchedulerService.Stop()
+	}()
+
+	<-httpDone
+	select {
+	case <-schedulerDone:
+	case <-ctx.Done():
+		log.Printf("ERROR: Scheduler tasks still running after %s; they will run again after the next start\n", shutdownTimeout)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Printf("ERROR: Could not get the database pool to close it: %v\n", err)
+		return
+	}
+	if err := sqlDB.Close(); err != nil {
+		log.Printf("ERROR: Could not close the database pool: %v\n", err)
+	}
+	log.Printf("LOG: Shutdown complete.\n")
+}
+
+// backfillTiebreakSeeds gives a seed to every league created before leagues got one at creation, so
+// that reading standings never has to write one.
+func backfillTiebreakSeeds(db *gorm.DB) error {
+	var leagueIDs []uuid.UUID
+	if err := db.Model(&models.League{}).Where("tiebreak_seed IS NULL").Pluck("id", &leagueIDs).Error; err != nil {
+		return fmt.Errorf("could not find leagues without a tiebreak seed: %w", err)
+	}
+	for _, leagueID := range leagueIDs {
+		err := db.Model(&models.League{}).Where("id = ? AND tiebreak_seed IS NULL", leagueID).
+			Update("tiebreak_seed", rand.Int64()).Error
+		if err != nil {
+			return fmt.Errorf("could not set the tiebreak seed of league %s: %w", leagueID, err)
+		}
+	}
+	if len(leagueIDs) > 0 {
+		log.Printf("LOG: Backfilled the tiebreak seed of %d league(s).\n", len(leagueIDs))
 	}
+	return nil
 }
@@ -13,15 +13,21 @@ type Repositories struct {
 	DraftRepository          repositories.DraftRepository
 	GameRepository           repositories.GameRepository
 
-	DraftPickRepository    repositories.DraftPickRepository
-	ClaimRepository        repositories.ClaimRepository
-	PoolEntryRepository    repositories.PoolEntryRepository
-	LeagueMemberRepository repositories.LeagueMemberRepository
+	DraftPickRepository     repositories.DraftPickRepository
+	ClaimRepository         repositories.ClaimRepository
+	PoolEntryRepository     repositories.PoolEntryRepository
+	LeagueMemberRepository  repositories.LeagueMemberRepository
+	DraftQueueRepository    repositories.DraftQueueRepository
+	DraftTradeRepository    repositories.DraftTradeRepository
+	ScheduledTaskRepository repositories.ScheduledTaskRepository
+	SchedulerLeaderLock     repositories.LeaderLock
+	DraftEventBus           repositories.DraftEventBus
 }
 
 type Services struct {
 	AuthService           services.AuthService
 	DraftService          services.DraftService
+	DraftEventService     services.DraftEventService
 	JWTService            services.JWTService
 	LeagueService         services.LeagueService
 	PokemonSpeciesService services.PokemonSpeciesService
@@ -31,11 +37,16 @@ type Services struct {
 	SchedulerService      services.SchedulerService
 	GameService           services.GameService
 	TransferService       services.TransferService
+	StandingsService      services.StandingsService
+	StatsService          services.StatsService
 
 	PoolEntryService    services.PoolEntryService
 	LeagueMemberService services.LeagueMemberService
 	DraftPickService    services.DraftPickService
 	ClaimService        services.ClaimService
+	DraftQueueService   services.DraftQueueService
+	DraftTradeService   services.DraftTradeService
+	MockDraftService    services.MockDraftService
 }
 
 type Controllers struct {
@@ -51,4 +62,10 @@ type Controllers struct {
 	LeagueMemberController controllers.LeagueMemberController
 	DraftPickController    controllers.DraftPickController
 	ClaimController        controllers.ClaimController
+	DraftQueueController   controllers.DraftQueueController
+	DraftTradeController   controllers.DraftTradeController
+	MockDraftController    controllers.MockDraftController
+	SchedulerController    controllers.SchedulerController
+	StandingsController    controllers.StandingsController
+	StatsController        controllers.StatsController
 }
@@ -22,13 +22,27 @@ func NewRepositories(db *gorm.DB) *Repositories {
 		ClaimRepository:        repositories.NewClaimRepository(db),
 		PoolEntryRepository:    repositories.NewPoolEntryRepository(db),
 		LeagueMemberRepository: repositories.NewLeagueMemberRepository(db),
+		DraftQueueRepository:   repositories.NewDraftQueueRepository(db),
+		DraftTradeRepository:   repositories.NewDraftTradeRepository(db),
+		ScheduledTaskRepository: repositories.NewScheduledTaskRepository(db),
+		SchedulerLeaderLock: repositories.NewAdvisoryLeaderLock(db, repositories.SchedulerLeaderLockKey),
+		DraftEventBus: repositories.NewPostgresDraftEventBus(db),
 	}
 }
 
 func NewServices(repos *Repositories, cfg *config.Config, discordOauthConfig *oauth2.Config) *Services {
-	jwtService := services.NewJWTService(cfg.JWTSecret)
+	jwtService := services.NewJWTService(cfg.JWT_SECRET)
 	rbacService := services.NewRBACService(repos.LeagueRepository, repos.UserRepository, repos.LeagueMemberRepository)
 	webhookService := services.NewWebhookService()
+	draftEventService := services.NewDraftEventService()
+	// every instance streams every instance's draft events
+	draftEventService.SetEventBus(repos.DraftEventBus)
+
+	// in dev the clock can be moved forward (POST /api/admin/scheduler/clock/advance)
+	var clock u.Clock = u.SystemClock
+	if cfg.ENVIRONMENT == "dev" {
+		clock = u.NewVirtualClock()
+	}
 
 	draftService := services.NewDraftService(
 		repos.LeagueRepository,
@@ -42,11 +56,13 @@ func NewServices(repos *Repositories, cfg *config.Config, discordOauthConfig *oa
 		repos.ClaimRepository,
 		repos.PoolEntryRepository,
 	)
+	draftService.SetDraftQueueRepository(repos.DraftQueueRepository)
+	draftService.SetDraftTradeRepository(repos.DraftTradeRepository)
 
 	schedulerService := services.NewSchedulerService(
 		&u.TaskHeap{},
-		repos.LeagueRepository,
-		repos.DraftRepository,
+		repos.ScheduledTaskRepository,
+		repos.SchedulerLeaderLock,
 	)
 
 	transferService := services.NewTransferService(
@@ -61,10 +77,12 @@ func NewServices(repos *Repositories, cfg *config.Config, discordOauthConfig *oa
 	)
 
 	gameService := services.NewGameService(repos.GameRepository, repos.LeagueRepository, repos.LeagueMemberRepository)
+	gameService.SetClaimRepository(repos.ClaimRepository)
 
 	leagueService := services.NewLeagueService(repos.LeagueRepository, repos.LeagueMemberRepository, repos.DraftRepository, repos.GameRepository)
 
 	draftService.SetSchedulerService(schedulerService)
+	draftService.SetDraftEventService(draftEventService)
 	schedulerService.SetDraftService(draftService.(services.DraftService))
 
 	transferService.SetSchedulerService(schedulerService)
@@ -78,6 +96,18 @@ func NewServices(repos *Repositories, cfg *config.Config, discordOauthConfig *oa
 
 	leagueService.SetTransferService(transferService)
 
+	draftTradeService := services.NewDraftTradeService(repos.DraftTradeRepository, repos.LeagueRepository, repos.DraftRepository, repos.LeagueMemberRepository, draftEventService)
+	mockDraftService := services.NewMockDraftService(repos.LeagueRepository, repos.LeagueMemberRepository, repos.PoolEntryRepository)
+
+	draftService.SetClock(clock)
+	transferService.SetClock(clock)
+	leagueService.SetClock(clock)
+	schedulerService.SetClock(clock)
+	gameService.SetClock(clock)
+	draftTradeService.SetClock(clock)
+	draftEventService.SetClock(clock)
+	mockDraftService.SetClock(clock)
+
 	return &Services{
 		JWTService:           *jwtService,
 		UserService:          services.NewUserService(repos.UserRepository),
@@ -86,15 +116,21 @@ func NewServices(repos *Repositories, cfg *config.Config, discordOauthConfig *oa
 		LeagueService:        leagueService,
 		AuthService:          services.NewAuthService(repos.UserRepository, jwtService, discordOauthConfig),
 		DraftService:         draftService,
+		DraftEventService:    draftEventService,
 		PokemonSpeciesService: services.NewPokemonSpeciesService(repos.PokemonSpeciesRepository),
 		SchedulerService:      schedulerService,
-		GameService:           services.NewGameService(repos.GameRepository, repos.LeagueRepository, repos.LeagueMemberRepository),
+		GameService:           gameService,
 		TransferService:       transferService,
+		StandingsService:      services.NewStandingsService(repos.LeagueRepository, repos.LeagueMemberRepository, repos.GameRepository),
+		StatsService:          services.NewStatsService(repos.LeagueRepository, repos.GameRepository),
 
 		PoolEntryService:    services.NewPoolEntryService(repos.PoolEntryRepository, repos.LeagueRepository, repos.UserRepository, repos.PokemonSpeciesRepository),
 		LeagueMemberService: services.NewLeagueMemberService(repos.LeagueMemberRepository, repos.LeagueRepository, repos.UserRepository),
 		DraftPickService:    services.NewDraftPickService(repos.DraftPickRepository, repos.DraftRepository),
 		ClaimService:        services.NewClaimService(repos.ClaimRepository),
+		DraftQueueService:   services.NewDraftQueueService(repos.DraftQueueRepository, repos.LeagueMemberRepository, repos.PoolEntryRepository),
+		DraftTradeService:   draftTradeService,
+		MockDraftService:    mockDraftService,
 	}
 }
 
@@ -104,7 +140,7 @@ func NewControllers(services *Services, repos *Repositories, cfg *config.Config,
 		LeagueController:         controllers.NewLeagueController(services.LeagueService),
 		UserController:           controllers.NewUserController(services.UserService),
 		PokemonSpeciesController: controllers.NewPokemonSpeciesController(services.PokemonSpeciesService),
-		DraftController:          controllers.NewDraftController(services.DraftService),
+		DraftController:          controllers.NewDraftController(services.DraftService, services.DraftEventService, services.MockDraftService),
 		GameController:           controllers.NewGameController(services.GameService, services.LeagueService),
 		TransferController:       controllers.NewTransferController(services.TransferService),
 
@@ -112,5 +148,11 @@ func NewControllers(services *Services, repos *Repositories, cfg *config.Config,
 		LeagueMemberController: controllers.NewLeagueMemberController(services.LeagueMemberService),
 		DraftPickController:    controllers.NewDraftPickController(services.DraftPickService, services.DraftService),
 		ClaimController:        controllers.NewClaimController(services.ClaimService),
+		DraftQueueController:   controllers.NewDraftQueueController(services.DraftQueueService),
+		DraftTradeController:   controllers.NewDraftTradeController(services.DraftTradeService),
+		MockDraftController:    controllers.NewMockDraftController(services.MockDraftService),
+		SchedulerController:    controllers.NewSchedulerController(services.SchedulerService),
+		StandingsController:    controllers.NewStandingsController(services.StandingsService),
+		StatsController:        controllers.NewStatsController(services.StatsService),
 	}
 }
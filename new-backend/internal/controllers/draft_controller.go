@@ -2,13 +2,16 @@ package controllers
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -16,18 +19,37 @@ import (
 type DraftController interface {
 	GetDraftByID(ctx *gin.Context)
 	GetDraftByLeagueID(ctx *gin.Context)
+	GetPickSchedule(ctx *gin.Context)
 	StartDraft(ctx *gin.Context)
+	ScheduleDraftStart(ctx *gin.Context)
+	CancelScheduledDraftStart(ctx *gin.Context)
 	MakePick(ctx *gin.Context)
 	SkipPick(ctx *gin.Context)
+	StreamDraftEvents(ctx *gin.Context)
+	ForcePick(ctx *gin.Context)
+	PauseDraft(ctx *gin.Context)
+	ResumeDraft(ctx *gin.Context)
+	UndoLastPick(ctx *gin.Context)
+	SetCustomDraftOrder(ctx *gin.Context)
+	NominateAuctionLot(ctx *gin.Context)
+	PlaceAuctionBid(ctx *gin.Context)
 }
 
 type draftControllerImpl struct {
-	draftService services.DraftService
+	draftService      services.DraftService
+	draftEventService services.DraftEventService
+	mockDraftService  services.MockDraftService
 }
 
-func NewDraftController(draftService services.DraftService) DraftController {
+func NewDraftController(
+	draftService services.DraftService,
+	draftEventService services.DraftEventService,
+	mockDraftService services.MockDraftService,
+) DraftController {
 	return &draftControllerImpl{
-		draftService: draftService,
+		draftService:      draftService,
+		draftEventService: draftEventService,
+		mockDraftService:  mockDraftService,
 	}
 }
 
@@ -79,6 +101,31 @@ func (dc *draftControllerImpl) GetDraftByLeagueID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, draft)
 }
 
+// GetPickSchedule handles GET /api/leagues/:leagueId/draft/schedule: the projected order of every pick
+// with estimated clock times.
+func (dc *draftControllerImpl) GetPickSchedule(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	schedule, err := dc.draftService.GetPickSchedule(leagueID)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrLeagueNotFound), errors.Is(err, types.ErrDraftNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Auction drafts have no fixed pick order"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schedule)
+}
+
 func (dc *draftControllerImpl) StartDraft(ctx *gin.Context) {
 	leagueIDStr := ctx.Param("leagueId")
 
@@ -119,6 +166,62 @@ func (dc *draftControllerImpl) StartDraft(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, draft)
 }
 
+// ScheduleDraftStart handles POST /api/leagues/:leagueId/draft/start/schedule, where staff set a time for
+// the draft to start by itself.
+func (dc *draftControllerImpl) ScheduleDraftStart(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var input requests.DraftScheduleStartRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	league, err := dc.draftService.ScheduleDraftStart(leagueID, &input)
+	if err != nil {
+		dc.respondScheduledStartError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, league)
+}
+
+// CancelScheduledDraftStart handles DELETE /api/leagues/:leagueId/draft/start/schedule.
+func (dc *draftControllerImpl) CancelScheduledDraftStart(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	league, err := dc.draftService.CancelScheduledDraftStart(leagueID)
+	if err != nil {
+		dc.respondScheduledStartError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, league)
+}
+
+func (dc *draftControllerImpl) respondScheduledStartError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrLeagueNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrLeagueNotFound.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Start time must be in the future"})
+	case errors.Is(err, types.ErrInvalidLeagueConfiguration):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidLeagueConfiguration.Error()})
+	case errors.Is(err, types.ErrInvalidState):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "No draft start is scheduled, or the draft has already started"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
+
 func (dc *draftControllerImpl) MakePick(c *gin.Context) {
 	leagueIDStr := c.Param("leagueId")
 	leagueID, err := uuid.Parse(leagueIDStr)
@@ -144,9 +247,22 @@ func (dc *draftControllerImpl) MakePick(c *gin.Context) {
 		return
 	}
 
-	if err := dc.draftService.MakePick(user, leagueID, &input); err != nil {
+	// the same endpoint drives mock drafts when mounted under /mock/:mockDraftId
+	if mockDraftIDStr := c.Param("mockDraftId"); mockDraftIDStr != "" {
+		mockDraftID, parseErr := uuid.Parse(mockDraftIDStr)
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+			return
+		}
+		err = dc.mockDraftService.MakePick(user, leagueID, mockDraftID, &input)
+	} else {
+		err = dc.draftService.MakePick(user, leagueID, &input)
+	}
+	if err != nil {
 		// Handle specific errors from the service layer
 		switch {
+		case errors.Is(err, types.ErrMockDraftNotFound):
+			respondMockDraftNotFound(c, dc.mockDraftService)
 		case errors.Is(err, types.ErrUnauthorized):
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Not your turn to pick"})
 		case errors.Is(err, types.ErrInvalidState):
@@ -155,6 +271,8 @@ func (dc *draftControllerImpl) MakePick(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Requested too many picks"})
 		case errors.Is(err, types.ErrInsufficientDraftPoints):
 			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient draft points"})
+		case errors.Is(err, types.ErrTierLimitExceeded):
+			c.JSON(http.StatusForbidden, gin.H{"error": types.ErrTierLimitExceeded.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to make pick", "details": err.Error()})
 		}
@@ -183,8 +301,20 @@ func (dc *draftControllerImpl) SkipPick(c *gin.Context) {
 		return
 	}
 
-	if err := dc.draftService.SkipTurn(user, leagueID); err != nil {
+	if mockDraftIDStr := c.Param("mockDraftId"); mockDraftIDStr != "" {
+		mockDraftID, parseErr := uuid.Parse(mockDraftIDStr)
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mock draft ID"})
+			return
+		}
+		err = dc.mockDraftService.SkipTurn(user, leagueID, mockDraftID)
+	} else {
+		err = dc.draftService.SkipTurn(user, leagueID)
+	}
+	if err != nil {
 		switch {
+		case errors.Is(err, types.ErrMockDraftNotFound):
+			respondMockDraftNotFound(c, dc.mockDraftService)
 		case errors.Is(err, types.ErrUnauthorized):
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Not your turn to skip"})
 		case errors.Is(err, types.ErrInvalidState):
@@ -199,3 +329,269 @@ func (dc *draftControllerImpl) SkipPick(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "Turn skipped successfully"})
 }
+
+// StreamDraftEvents handles the GET /api/leagues/:leagueId/draft/events endpoint.
+// It keeps the connection open and pushes draft events to the client as Server-Sent Events.
+// A reconnecting client resumes by sending the ID of the last event it saw, either through the
+// standard Last-Event-ID header (browsers' EventSource does this automatically) or ?lastEventId=.
+func (dc *draftControllerImpl) StreamDraftEvents(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	lastEventIDStr := ctx.GetHeader("Last-Event-ID")
+	if lastEventIDStr == "" {
+		lastEventIDStr = ctx.Query("lastEventId")
+	}
+	var lastEventID uint64
+	if lastEventIDStr != "" {
+		lastEventID, err = strconv.ParseUint(lastEventIDStr, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid last event ID"})
+			return
+		}
+	}
+
+	backlog, events, unsubscribe := dc.draftEventService.Subscribe(leagueID, lastEventID)
+	defer unsubscribe()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no") // disable proxy buffering (nginx)
+	ctx.Status(http.StatusOK)
+
+	for _, event := range backlog {
+		renderDraftEvent(ctx, event)
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(draftEventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				// dropped for being too slow; the client reconnects with its last event ID
+				return false
+			}
+			renderDraftEvent(ctx, event)
+			return true
+		case <-heartbeat.C:
+			// SSE comment line; keeps idle connections from being closed by proxies
+			_, err := io.WriteString(w, ": keepalive\n\n")
+			return err == nil
+		}
+	})
+}
+
+const draftEventHeartbeatInterval = 25 * time.Second
+
+func renderDraftEvent(ctx *gin.Context, event types.DraftEvent) {
+	ctx.Render(-1, sse.Event{
+		Id:    strconv.FormatUint(event.ID, 10),
+		Event: string(event.Type),
+		Data:  event,
+	})
+}
+
+// ForcePick handles POST /api/leagues/:leagueId/draft/force-pick.
+// League staff make a pick on behalf of the member currently on the clock.
+func (dc *draftControllerImpl) ForcePick(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var input requests.DraftMakePickRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	if err := dc.draftService.ForcePick(leagueID, &input); err != nil {
+		switch {
+		case errors.Is(err, types.ErrLeagueNotFound), errors.Is(err, types.ErrDraftNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Draft is not in a valid state for picking"})
+		case errors.Is(err, types.ErrTooManyRequestedPicks):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Requested too many picks"})
+		case errors.Is(err, types.ErrInvalidInput), errors.Is(err, types.ErrPoolEntryNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrConflict):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "One or more Pokemon are not available"})
+		case errors.Is(err, types.ErrInsufficientDraftPoints):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Insufficient draft points"})
+		case errors.Is(err, types.ErrTierLimitExceeded):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": types.ErrTierLimitExceeded.Error()})
+		case errors.Is(err, types.ErrCannotSkipBelowMinimumRoster):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Cannot skip, minimum roster requirement not met"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to force pick", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Pick successful"})
+}
+
+// PauseDraft handles POST /api/leagues/:leagueId/draft/pause.
+func (dc *draftControllerImpl) PauseDraft(ctx *gin.Context) {
+	dc.changeDraftState(ctx, dc.draftService.PauseDraft)
+}
+
+// ResumeDraft handles POST /api/leagues/:leagueId/draft/resume.
+func (dc *draftControllerImpl) ResumeDraft(ctx *gin.Context) {
+	dc.changeDraftState(ctx, dc.draftService.ResumeDraft)
+}
+
+// UndoLastPick handles POST /api/leagues/:leagueId/draft/undo.
+func (dc *draftControllerImpl) UndoLastPick(ctx *gin.Context) {
+	dc.changeDraftState(ctx, dc.draftService.UndoLastPick)
+}
+
+// SetCustomDraftOrder handles PUT /api/leagues/:leagueId/draft/order, where staff upload the pick order
+// of every round before the draft starts.
+func (dc *draftControllerImpl) SetCustomDraftOrder(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var input requests.DraftCustomOrderRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	league, err := dc.draftService.SetCustomDraftOrder(leagueID, &input)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrLeagueNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrLeagueNotFound.Error()})
+		case errors.Is(err, types.ErrIncompleteDraftOrder), errors.Is(err, types.ErrInvalidDraftPosition),
+			errors.Is(err, types.ErrDuplicateDraftPosition):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Draft order can only be changed before the draft starts"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, league)
+}
+
+// changeDraftState runs one of the staff draft controls that take a league and return the updated draft.
+func (dc *draftControllerImpl) changeDraftState(ctx *gin.Context, action func(leagueID uuid.UUID) (*models.Draft, error)) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	draft, err := action(leagueID)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrLeagueNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrLeagueNotFound.Error()})
+		case errors.Is(err, types.ErrDraftNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrDraftNotFound.Error()})
+		case errors.Is(err, types.ErrDraftPickNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "No picks to undo"})
+		case errors.Is(err, types.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, gin.H{"error": types.ErrInvalidState.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// NominateAuctionLot handles POST /api/leagues/:leagueId/draft/auction/nominate.
+// The member on the clock in an auction draft puts a pool entry up for bidding.
+func (dc *draftControllerImpl) NominateAuctionLot(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var input requests.DraftAuctionNominateRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	draft, err := dc.draftService.NominateAuctionLot(user, leagueID, &input)
+	if err != nil {
+		handleAuctionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// PlaceAuctionBid handles POST /api/leagues/:leagueId/draft/auction/bid.
+func (dc *draftControllerImpl) PlaceAuctionBid(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var input requests.DraftAuctionBidRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	draft, err := dc.draftService.PlaceAuctionBid(user, leagueID, &input)
+	if err != nil {
+		handleAuctionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+}
+
+func handleAuctionError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrLeagueNotFound), errors.Is(err, types.ErrDraftNotFound),
+		errors.Is(err, types.ErrPlayerNotFound), errors.Is(err, types.ErrPoolEntryNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrUnauthorized):
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Not your turn to nominate"})
+	case errors.Is(err, types.ErrNotAuctionDraft), errors.Is(err, types.ErrBidTooLow):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrInvalidState), errors.Is(err, types.ErrAuctionLotOpen), errors.Is(err, types.ErrNoAuctionLotOpen):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Pokemon is not available or you already hold the high bid"})
+	case errors.Is(err, types.ErrInsufficientDraftPoints), errors.Is(err, types.ErrAboveMaxPokemon):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DraftQueueController exposes the current user's own draft queue for a league.
+type DraftQueueController interface {
+	GetQueue(ctx *gin.Context)
+	SetQueue(ctx *gin.Context)
+	AddToQueue(ctx *gin.Context)
+	RemoveFromQueue(ctx *gin.Context)
+}
+
+type draftQueueControllerImpl struct {
+	draftQueueService services.DraftQueueService
+}
+
+func NewDraftQueueController(draftQueueService services.DraftQueueService) DraftQueueController {
+	return &draftQueueControllerImpl{
+		draftQueueService: draftQueueService,
+	}
+}
+
+func (c *draftQueueControllerImpl) GetQueue(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	queue, err := c.draftQueueService.GetQueue(user, leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftQueueController: GetQueue) - Service method error: %v\n", err)
+		handleDraftQueueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, queue)
+}
+
+func (c *draftQueueControllerImpl) SetQueue(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var input requests.DraftQueueSetRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	queue, err := c.draftQueueService.SetQueue(user, leagueID, &input)
+	if err != nil {
+		log.Printf("LOG: (DraftQueueController: SetQueue) - Service method error: %v\n", err)
+		handleDraftQueueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, queue)
+}
+
+func (c *draftQueueControllerImpl) AddToQueue(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var input requests.DraftQueueAddRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	queue, err := c.draftQueueService.AddToQueue(user, leagueID, &input)
+	if err != nil {
+		log.Printf("LOG: (DraftQueueController: AddToQueue) - Service method error: %v\n", err)
+		handleDraftQueueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, queue)
+}
+
+func (c *draftQueueControllerImpl) RemoveFromQueue(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	poolEntryID, err := uuid.Parse(ctx.Param("poolEntryId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	queue, err := c.draftQueueService.RemoveFromQueue(user, leagueID, poolEntryID)
+	if err != nil {
+		log.Printf("LOG: (DraftQueueController: RemoveFromQueue) - Service method error: %v\n", err)
+		handleDraftQueueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, queue)
+}
+
+func currentUserFromContext(ctx *gin.Context) (*models.User, bool) {
+	currentUser, exists := ctx.Get("currentUser")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": types.ErrNoUserInContext.Error()})
+		return nil, false
+	}
+	user, ok := currentUser.(*models.User)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process user information"})
+		return nil, false
+	}
+	return user, true
+}
+
+func handleDraftQueueError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrPlayerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrPlayerNotFound.Error()})
+	case errors.Is(err, types.ErrPoolEntryNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrPoolEntryNotFound.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+	case errors.Is(err, types.ErrConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Pool entry is already queued or has been drafted"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DraftTradeController exposes draft slot trades between league members.
+type DraftTradeController interface {
+	GetTrades(ctx *gin.Context)
+	GetSlots(ctx *gin.Context)
+	ProposeTrade(ctx *gin.Context)
+	AcceptTrade(ctx *gin.Context)
+	RejectTrade(ctx *gin.Context)
+	VetoTrade(ctx *gin.Context)
+}
+
+type draftTradeControllerImpl struct {
+	draftTradeService services.DraftTradeService
+}
+
+func NewDraftTradeController(draftTradeService services.DraftTradeService) DraftTradeController {
+	return &draftTradeControllerImpl{
+		draftTradeService: draftTradeService,
+	}
+}
+
+func (c *draftTradeControllerImpl) GetTrades(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	trades, err := c.draftTradeService.GetTradesByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeController: GetTrades) - Service method error: %v\n", err)
+		handleDraftTradeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, trades)
+}
+
+func (c *draftTradeControllerImpl) GetSlots(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	slots, err := c.draftTradeService.GetSlotsByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeController: GetSlots) - Service method error: %v\n", err)
+		handleDraftTradeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, slots)
+}
+
+func (c *draftTradeControllerImpl) ProposeTrade(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var input requests.DraftPickTradeProposeRequestDTO
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+		return
+	}
+
+	trade, err := c.draftTradeService.ProposeTrade(user, leagueID, &input)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeController: ProposeTrade) - Service method error: %v\n", err)
+		handleDraftTradeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, trade)
+}
+
+func (c *draftTradeControllerImpl) AcceptTrade(ctx *gin.Context) {
+	c.resolveTrade(ctx, "AcceptTrade", c.draftTradeService.AcceptTrade)
+}
+
+func (c *draftTradeControllerImpl) RejectTrade(ctx *gin.Context) {
+	c.resolveTrade(ctx, "RejectTrade", c.draftTradeService.RejectTrade)
+}
+
+func (c *draftTradeControllerImpl) VetoTrade(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	tradeID, err := uuid.Parse(ctx.Param("tradeId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	trade, err := c.draftTradeService.VetoTrade(leagueID, tradeID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeController: VetoTrade) - Service method error: %v\n", err)
+		handleDraftTradeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, trade)
+}
+
+// resolveTrade handles the accept/reject endpoints, which only differ in the service method they call.
+func (c *draftTradeControllerImpl) resolveTrade(
+	ctx *gin.Context,
+	method string,
+	resolve func(currentUser *models.User, leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error),
+) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	tradeID, err := uuid.Parse(ctx.Param("tradeId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	trade, err := resolve(user, leagueID, tradeID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeController: %s) - Service method error: %v\n", method, err)
+		handleDraftTradeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, trade)
+}
+
+func handleDraftTradeError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrPlayerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrPlayerNotFound.Error()})
+	case errors.Is(err, types.ErrLeagueNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrLeagueNotFound.Error()})
+	case errors.Is(err, types.ErrDraftPickTradeNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrDraftPickTradeNotFound.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+	case errors.Is(err, types.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": types.ErrUnauthorized.Error()})
+	case errors.Is(err, types.ErrInsufficientDraftPoints):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInsufficientDraftPoints.Error()})
+	case errors.Is(err, types.ErrAboveMaxPokemon), errors.Is(err, types.ErrBelowMinPokemon):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrInvalidState):
+		ctx.JSON(http.StatusConflict, gin.H{"error": types.ErrInvalidState.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
@@ -18,6 +18,10 @@ import (
 type GameController interface {
 	ReportGame(ctx *gin.Context)
 	FinalizeGame(ctx *gin.Context)
+	ReadBattleLogs(ctx *gin.Context)
+	ConfirmGame(ctx *gin.Context)
+	DisputeGame(ctx *gin.Context)
+	GetDisputedGames(ctx *gin.Context)
 	GetGameByID(ctx *gin.Context)
 	GetGamesByLeague(ctx *gin.Context)
 	GetGamesByPlayer(ctx *gin.Context)
@@ -150,6 +154,38 @@ func (c *gameControllerImpl) ReportGame(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "Game result reported successfully for approval"})
 }
 
+// ReadBattleLogs handles a player uploading the Showdown logs of a game's battles to prefill their report.
+func (c *gameControllerImpl) ReadBattleLogs(ctx *gin.Context) {
+	gameID, err := uuid.Parse(ctx.Param("gameId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var dto requests.BattleLogsRequestDTO
+	if err := ctx.ShouldBindJSON(&dto); err != nil {
+		log.Printf("ERROR: (Controller: ReadBattleLogs): Error binding request: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid request payload: %v", err)})
+		return
+	}
+
+	result, err := c.gameService.ReadBattleLogs(gameID, dto.BattleLogs)
+	if err != nil {
+		log.Printf("ERROR: (Controller: ReadBattleLogs) - %s\n", err.Error())
+		switch {
+		case errors.Is(err, types.ErrInvalidInput):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrGameNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
 // FinalizeGame handles league staff finalizing a game result (approve, submit, or retroactively edit).
 func (c *gameControllerImpl) FinalizeGame(ctx *gin.Context) {
 	gameID, err := uuid.Parse(ctx.Param("gameId"))
@@ -190,6 +226,102 @@ func (c *gameControllerImpl) FinalizeGame(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "Game result finalized successfully"})
 }
 
+// ConfirmGame handles the reporter's opponent agreeing with a reported game result.
+func (c *gameControllerImpl) ConfirmGame(ctx *gin.Context) {
+	gameID, err := uuid.Parse(ctx.Param("gameId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	memberIDStr, exists := ctx.Get("playerID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Player ID not found in context"})
+		return
+	}
+
+	finalized, err := c.gameService.ConfirmGameResult(gameID, memberIDStr.(uuid.UUID))
+	if err != nil {
+		log.Printf("ERROR: (Controller: ConfirmGame) - %s\n", err.Error())
+		respondGameAnswerError(ctx, err)
+		return
+	}
+
+	if finalized {
+		ctx.JSON(http.StatusOK, gin.H{"message": "Game result confirmed and finalized"})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "Game result confirmed, awaiting staff approval"})
+}
+
+// DisputeGame handles the reporter's opponent disputing a reported game result.
+func (c *gameControllerImpl) DisputeGame(ctx *gin.Context) {
+	gameID, err := uuid.Parse(ctx.Param("gameId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	var dto requests.DisputeGameRequestDTO
+	if err := ctx.ShouldBindJSON(&dto); err != nil {
+		log.Printf("ERROR: (Controller: DisputeGame): Error binding request: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid request payload: %v", err)})
+		return
+	}
+
+	disputerIDStr, exists := ctx.Get("playerID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Player ID not found in context"})
+		return
+	}
+	dto.DisputerID = disputerIDStr.(uuid.UUID)
+
+	if err := c.gameService.DisputeGameResult(gameID, &dto); err != nil {
+		log.Printf("ERROR: (Controller: DisputeGame) - %s\n", err.Error())
+		respondGameAnswerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Game result disputed, awaiting a staff ruling"})
+}
+
+// respondGameAnswerError maps the errors of confirming or disputing a game result to a response.
+func respondGameAnswerError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrGameNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+	case errors.Is(err, types.ErrLeagueNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "League not found"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
+
+// GetDisputedGames handles league staff fetching the queue of disputed games to rule on.
+func (c *gameControllerImpl) GetDisputedGames(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		log.Printf("ERROR: (Controller: GetDisputedGames) - Error parsing leagueId param: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	games, err := c.gameService.GetDisputedGames(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (Controller: GetDisputedGames) - Error fetching disputed games for League %s : %v", leagueID, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"games": games})
+}
+
 func (c *gameControllerImpl) StartRegularSeason(ctx *gin.Context) {
 	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
 	if err != nil {
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MockDraftController manages mock draft sandboxes. Picks and skips inside a mock draft go through
+// DraftController.MakePick/SkipPick, mounted under the mock draft's route.
+type MockDraftController interface {
+	CreateMockDraft(ctx *gin.Context)
+	GetMockDraft(ctx *gin.Context)
+	JoinMockDraft(ctx *gin.Context)
+	StartMockDraft(ctx *gin.Context)
+	DeleteMockDraft(ctx *gin.Context)
+}
+
+// mockDraftInstanceCookie names the instance holding the caller's mock draft. Load balancers route mock
+// draft requests on it; see services.MockDraftService.
+const mockDraftInstanceCookie = "mock_draft_instance"
+
+type mockDraftControllerImpl struct {
+	mockDraftService services.MockDraftService
+}
+
+func NewMockDraftController(mockDraftService services.MockDraftService) MockDraftController {
+	return &mockDraftControllerImpl{
+		mockDraftService: mockDraftService,
+	}
+}
+
+func (c *mockDraftControllerImpl) CreateMockDraft(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var input requests.MockDraftCreateRequestDTO
+	// every field is optional, so an empty body is fine
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+			return
+		}
+	}
+
+	mockDraft, err := c.mockDraftService.CreateMockDraft(user, leagueID, &input)
+	if err != nil {
+		log.Printf("LOG: (MockDraftController: CreateMockDraft) - Service method error: %v\n", err)
+		handleMockDraftError(ctx, err, c.mockDraftService)
+		return
+	}
+
+	pinMockDraftInstance(ctx, c.mockDraftService)
+	ctx.JSON(http.StatusCreated, mockDraft)
+}
+
+func (c *mockDraftControllerImpl) GetMockDraft(ctx *gin.Context) {
+	leagueID, mockDraftID, ok := parseMockDraftParams(ctx)
+	if !ok {
+		return
+	}
+
+	mockDraft, err := c.mockDraftService.GetMockDraft(leagueID, mockDraftID)
+	if err != nil {
+		log.Printf("LOG: (MockDraftController: GetMockDraft) - Service method error: %v\n", err)
+		handleMockDraftError(ctx, err, c.mockDraftService)
+		return
+	}
+
+	pinMockDraftInstance(ctx, c.mockDraftService)
+	ctx.JSON(http.StatusOK, mockDraft)
+}
+
+func (c *mockDraftControllerImpl) JoinMockDraft(ctx *gin.Context) {
+	leagueID, mockDraftID, ok := parseMockDraftParams(ctx)
+	if !ok {
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	mockDraft, err := c.mockDraftService.JoinMockDraft(user, leagueID, mockDraftID)
+	if err != nil {
+		log.Printf("LOG: (MockDraftController: JoinMockDraft) - Service method error: %v\n", err)
+		handleMockDraftError(ctx, err, c.mockDraftService)
+		return
+	}
+
+	pinMockDraftInstance(ctx, c.mockDraftService)
+	ctx.JSON(http.StatusOK, mockDraft)
+}
+
+func (c *mockDraftControllerImpl) StartMockDraft(ctx *gin.Context) {
+	leagueID, mockDraftID, ok := parseMockDraftParams(ctx)
+	if !ok {
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	mockDraft, err := c.mockDraftService.StartMockDraft(user, leagueID, mockDraftID)
+	if err != nil {
+		log.Printf("LOG: (MockDraftController: StartMockDraft) - Service method error: %v\n", err)
+		handleMockDraftError(ctx, err, c.mockDraftService)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mockDraft)
+}
+
+func (c *mockDraftControllerImpl) DeleteMockDraft(ctx *gin.Context) {
+	leagueID, mockDraftID, ok := parseMockDraftParams(ctx)
+	if !ok {
+		return
+	}
+	user, ok := currentUserFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.mockDraftService.DeleteMockDraft(user, leagueID, mockDraftID); err != nil {
+		log.Printf("LOG: (MockDraftController: DeleteMockDraft) - Service method error: %v\n", err)
+		handleMockDraftError(ctx, err, c.mockDraftService)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func parseMockDraftParams(ctx *gin.Context) (leagueID, mockDraftID uuid.UUID, ok bool) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return uuid.Nil, uuid.Nil, false
+	}
+	mockDraftID, err = uuid.Parse(ctx.Param("mockDraftId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return leagueID, mockDraftID, true
+}
+
+// pinMockDraftInstance points the caller's later mock draft requests at this instance.
+func pinMockDraftInstance(ctx *gin.Context, mockDraftService services.MockDraftService) {
+	ctx.SetCookie(mockDraftInstanceCookie, mockDraftService.InstanceID().String(), 0, "/", "", false, true)
+}
+
+// respondMockDraftNotFound answers a mock draft this instance doesn't hold. When the caller's instance
+// cookie names another instance, the request was misrouted and the mock draft may well exist there.
+func respondMockDraftNotFound(ctx *gin.Context, mockDraftService services.MockDraftService) {
+	if instance, err := ctx.Cookie(mockDraftInstanceCookie); err == nil && instance != mockDraftService.InstanceID().String() {
+		log.Printf("WARN: (MockDraftController: respondMockDraftNotFound) - request for instance %s reached instance %s; is the load balancer routing on the %s cookie?\n", instance, mockDraftService.InstanceID(), mockDraftInstanceCookie)
+		ctx.JSON(http.StatusMisdirectedRequest, gin.H{"error": types.ErrMockDraftOnOtherInstance.Error()})
+		return
+	}
+	ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrMockDraftNotFound.Error()})
+}
+
+func handleMockDraftError(ctx *gin.Context, err error, mockDraftService services.MockDraftService) {
+	switch {
+	case errors.Is(err, types.ErrMockDraftNotFound):
+		respondMockDraftNotFound(ctx, mockDraftService)
+	case errors.Is(err, types.ErrLeagueNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrLeagueNotFound.Error()})
+	case errors.Is(err, types.ErrPlayerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": types.ErrPlayerNotFound.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrInvalidInput.Error()})
+	case errors.Is(err, types.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": types.ErrUnauthorized.Error()})
+	case errors.Is(err, types.ErrUserAlreadyInLeague):
+		ctx.JSON(http.StatusConflict, gin.H{"error": types.ErrUserAlreadyInLeague.Error()})
+	case errors.Is(err, types.ErrConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "You already have a mock draft in this league, or it has no open seats"})
+	case errors.Is(err, types.ErrInvalidState):
+		ctx.JSON(http.StatusConflict, gin.H{"error": types.ErrInvalidState.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
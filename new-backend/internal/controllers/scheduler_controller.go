@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SchedulerController lets league staff look into and manage the scheduler's tasks for their league.
+// The task endpoints are also mounted under /api/admin/scheduler without a league, where they reach
+// every league's tasks.
+type SchedulerController interface {
+	GetDeadLetterTasks(ctx *gin.Context)
+	ListPendingTasks(ctx *gin.Context)
+	RescheduleTask(ctx *gin.Context)
+	RunTaskNow(ctx *gin.Context)
+	CancelTask(ctx *gin.Context)
+	AdvanceClock(ctx *gin.Context)
+}
+
+type schedulerControllerImpl struct {
+	schedulerService services.SchedulerService
+}
+
+func NewSchedulerController(schedulerService services.SchedulerService) SchedulerController {
+	return &schedulerControllerImpl{
+		schedulerService: schedulerService,
+	}
+}
+
+// GetDeadLetterTasks handles GET /api/leagues/:leagueId/scheduler/dead-letters and
+// GET /api/admin/scheduler/dead-letters.
+// It lists the tasks that kept failing after every retry, with their last error.
+func (c *schedulerControllerImpl) GetDeadLetterTasks(ctx *gin.Context) {
+	leagueID, ok := taskScopeFromParams(ctx)
+	if !ok {
+		return // response already sent
+	}
+
+	tasks, err := c.schedulerService.GetDeadLetterTasks(leagueID)
+	if err != nil {
+		log.Printf("LOG: (SchedulerController: GetDeadLetterTasks) - Service method error: %v\n", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, responses.NewScheduledTaskResponses(tasks))
+}
+
+// ListPendingTasks handles GET /api/leagues/:leagueId/scheduler/tasks and GET /api/admin/scheduler/tasks.
+func (c *schedulerControllerImpl) ListPendingTasks(ctx *gin.Context) {
+	leagueID, ok := taskScopeFromParams(ctx)
+	if !ok {
+		return // response already sent
+	}
+
+	ctx.JSON(http.StatusOK, responses.NewScheduledTaskResponses(c.schedulerService.ListPendingTasks(leagueID)))
+}
+
+// RescheduleTask handles POST .../scheduler/tasks/:taskId/reschedule.
+func (c *schedulerControllerImpl) RescheduleTask(ctx *gin.Context) {
+	leagueID, ok := taskScopeFromParams(ctx)
+	if !ok {
+		return // response already sent
+	}
+
+	var req requests.ScheduledTaskRescheduleRequestDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	task, err := c.schedulerService.RescheduleTask(leagueID, ctx.Param("taskId"), req.ExecuteAt)
+	if err != nil {
+		log.Printf("LOG: (SchedulerController: RescheduleTask) - Service method error: %v\n", err)
+		respondSchedulerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, responses.NewScheduledTaskResponse(task))
+}
+
+// RunTaskNow handles POST .../scheduler/tasks/:taskId/run. The task runs on the next free worker.
+func (c *schedulerControllerImpl) RunTaskNow(ctx *gin.Context) {
+	leagueID, ok := taskScopeFromParams(ctx)
+	if !ok {
+		return // response already sent
+	}
+
+	task, err := c.schedulerService.RunTaskNow(leagueID, ctx.Param("taskId"))
+	if err != nil {
+		log.Printf("LOG: (SchedulerController: RunTaskNow) - Service method error: %v\n", err)
+		respondSchedulerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, responses.NewScheduledTaskResponse(task))
+}
+
+// CancelTask handles DELETE .../scheduler/tasks/:taskId.
+func (c *schedulerControllerImpl) CancelTask(ctx *gin.Context) {
+	leagueID, ok := taskScopeFromParams(ctx)
+	if !ok {
+		return // response already sent
+	}
+
+	if err := c.schedulerService.CancelTask(leagueID, ctx.Param("taskId")); err != nil {
+		log.Printf("LOG: (SchedulerController: CancelTask) - Service method error: %v\n", err)
+		respondSchedulerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Task cancelled successfully"})
+}
+
+// AdvanceClock handles POST /api/admin/scheduler/clock/advance, which is only mounted in dev. It moves the
+// virtual clock forward and responds once every task that came due on the way has run.
+func (c *schedulerControllerImpl) AdvanceClock(ctx *gin.Context) {
+	var req requests.ClockAdvanceRequestDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil || d <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Duration must be a positive duration such as \"72h\""})
+		return
+	}
+
+	now, err := c.schedulerService.AdvanceClock(d)
+	if err != nil {
+		log.Printf("LOG: (SchedulerController: AdvanceClock) - Service method error: %v\n", err)
+		respondSchedulerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"now": now})
+}
+
+// taskScopeFromParams returns the league whose tasks the request may touch, or nil on the admin routes,
+// which have no :leagueId.
+func taskScopeFromParams(ctx *gin.Context) (*uuid.UUID, bool) {
+	leagueIDStr := ctx.Param("leagueId")
+	if leagueIDStr == "" {
+		return nil, true
+	}
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return nil, false
+	}
+	return &leagueID, true
+}
+
+func respondSchedulerError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrScheduledTaskNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrInvalidInput):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ExecuteAt must be in the future"})
+	case errors.Is(err, types.ErrTimeTravelDisabled):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, types.ErrInvalidState):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "this instance is not running the scheduler"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
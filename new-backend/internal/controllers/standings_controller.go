@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StandingsController interface {
+	GetStandings(ctx *gin.Context)
+}
+
+type standingsControllerImpl struct {
+	standingsService services.StandingsService
+}
+
+func NewStandingsController(standingsService services.StandingsService) StandingsController {
+	return &standingsControllerImpl{
+		standingsService: standingsService,
+	}
+}
+
+// GetStandings handles GET /api/leagues/:leagueId/standings.
+// It ranks the members of every group, or of one with ?group=N.
+func (c *standingsControllerImpl) GetStandings(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+	var groupNumber *int
+	if groupStr := ctx.Query("group"); groupStr != "" {
+		group, err := strconv.Atoi(groupStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group value"})
+			return
+		}
+		groupNumber = &group
+	}
+
+	standings, err := c.standingsService.GetStandings(leagueID, groupNumber)
+	if err != nil {
+		log.Printf("LOG: (StandingsController: GetStandings) - Service method error: %v\n", err)
+		switch {
+		case errors.Is(err, types.ErrLeagueNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, types.ErrInvalidInput):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "The league has no such group"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, standings)
+}
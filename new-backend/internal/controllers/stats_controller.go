@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StatsController interface {
+	GetPokemonLeaderboard(ctx *gin.Context)
+	GetCoachStats(ctx *gin.Context)
+}
+
+type statsControllerImpl struct {
+	statsService services.StatsService
+}
+
+func NewStatsController(statsService services.StatsService) StatsController {
+	return &statsControllerImpl{
+		statsService: statsService,
+	}
+}
+
+// GetPokemonLeaderboard handles GET /api/leagues/:leagueId/stats/pokemon.
+// It ranks the league's claimed Pokémon by KOs over its completed games.
+func (c *statsControllerImpl) GetPokemonLeaderboard(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	leaderboard, err := c.statsService.GetPokemonLeaderboard(leagueID)
+	if err != nil {
+		log.Printf("LOG: (StatsController: GetPokemonLeaderboard) - Service method error: %v\n", err)
+		respondStatsError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, leaderboard)
+}
+
+// GetCoachStats handles GET /api/leagues/:leagueId/stats/coaches.
+// It totals each member's Pokémon's stats and names their MVP.
+func (c *statsControllerImpl) GetCoachStats(ctx *gin.Context) {
+	leagueID, err := uuid.Parse(ctx.Param("leagueId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": types.ErrParsingParams.Error()})
+		return
+	}
+
+	coaches, err := c.statsService.GetCoachStats(leagueID)
+	if err != nil {
+		log.Printf("LOG: (StatsController: GetCoachStats) - Service method error: %v\n", err)
+		respondStatsError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, coaches)
+}
+
+func respondStatsError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, types.ErrLeagueNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
+	}
+}
@@ -136,6 +136,8 @@ func (tc *transferControllerImpl) PickupFreeAgent(ctx *gin.Context) {
 			ctx.JSON(http.StatusConflict, gin.H{"error": "Pokemon is not available to sign"})
 		case types.ErrForbidden:
 			ctx.JSON(http.StatusForbidden, gin.H{"error": "Pokemon not in this league"})
+		case types.ErrTierLimitExceeded:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		default:
 			ctx.JSON(http.StatusInternalServerError, gin.H{"error": types.ErrInternalService.Error()})
 		}
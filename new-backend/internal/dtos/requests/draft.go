@@ -1,6 +1,8 @@
 package requests
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -13,3 +15,59 @@ type RequestedPickDTO struct {
 	PoolEntryID     uuid.UUID `json:"PoolEntryID" binding:"required"`
 	DraftPickNumber int       `json:"DraftPickNumber" binding:"required"`
 }
+
+// DraftQueueSetRequestDTO replaces a member's whole draft queue.
+// PoolEntryIDs are ordered from highest to lowest priority.
+type DraftQueueSetRequestDTO struct {
+	PoolEntryIDs []uuid.UUID `json:"PoolEntryIDs"`
+}
+
+type DraftQueueAddRequestDTO struct {
+	PoolEntryID uuid.UUID `json:"PoolEntryID" binding:"required"`
+}
+
+// DraftAuctionNominateRequestDTO puts a pool entry up for auction in an auction draft.
+// OpeningBid is the nominator's own first bid on it.
+type DraftAuctionNominateRequestDTO struct {
+	PoolEntryID uuid.UUID `json:"PoolEntryID" binding:"required"`
+	OpeningBid  int       `json:"OpeningBid" binding:"required,min=1"`
+}
+
+// DraftScheduleStartRequestDTO schedules a league's draft to start at StartAt.
+// TurnTimeLimit is in minutes and defaults to 120, like POST /draft/start.
+type DraftScheduleStartRequestDTO struct {
+	StartAt       time.Time `json:"StartAt" binding:"required"`
+	TurnTimeLimit int       `json:"TurnTimeLimit" binding:"omitempty,min=1"`
+}
+
+type DraftAuctionBidRequestDTO struct {
+	Amount int `json:"Amount" binding:"required,min=1"`
+}
+
+// DraftCustomOrderRequestDTO sets a league's custom round order: Rounds[i] lists the draft positions
+// in the order they pick in round i+1.
+type DraftCustomOrderRequestDTO struct {
+	Rounds [][]int `json:"Rounds" binding:"required,min=1"`
+}
+
+type DraftSlotRefDTO struct {
+	OriginalOwnerID uuid.UUID `json:"OriginalOwnerID" binding:"required"`
+	RoundNumber     int       `json:"RoundNumber" binding:"required,min=1"`
+}
+
+// DraftPickTradeProposeRequestDTO proposes a draft slot trade to another member.
+// Offered* is what the proposer gives up; Requested* is what they want from the recipient.
+type DraftPickTradeProposeRequestDTO struct {
+	RecipientID     uuid.UUID         `json:"RecipientID" binding:"required"`
+	OfferedSlots    []DraftSlotRefDTO `json:"OfferedSlots" binding:"dive"`
+	RequestedSlots  []DraftSlotRefDTO `json:"RequestedSlots" binding:"dive"`
+	OfferedPoints   int               `json:"OfferedPoints" binding:"min=0"`
+	RequestedPoints int               `json:"RequestedPoints" binding:"min=0"`
+}
+
+// MockDraftCreateRequestDTO sets up a mock draft sandbox. Seats defaults to the league's member count;
+// DraftPosition is the creator's seat, 0 for a random one. Seats not taken by members are filled by bots.
+type MockDraftCreateRequestDTO struct {
+	Seats         int `json:"Seats" binding:"omitempty,min=2,max=32"`
+	DraftPosition int `json:"DraftPosition" binding:"omitempty,min=1"`
+}
@@ -4,12 +4,15 @@ import (
 	"github.com/google/uuid"
 )
 
+// ReportGameRequestDTO is a player reporting a game's result. With BattleLogs, any of WinnerID and the
+// wins left out are read from the logs, and any given must agree with them.
 type ReportGameRequestDTO struct {
 	ReporterID  uuid.UUID `json:"ReporterID" binding:"omitempty"`
-	WinnerID    uuid.UUID `json:"WinnerID" binding:"required"`
-	Player1Wins *int      `json:"Player1Wins" binding:"required,gte=0"`
-	Player2Wins *int      `json:"Player2Wins" binding:"required,gte=0"`
+	WinnerID    uuid.UUID `json:"WinnerID" binding:"required_without=BattleLogs"`
+	Player1Wins *int      `json:"Player1Wins" binding:"required_without=BattleLogs,omitempty,gte=0"`
+	Player2Wins *int      `json:"Player2Wins" binding:"required_without=BattleLogs,omitempty,gte=0"`
 	ReplayLinks []string  `json:"ReplayLinks" binding:"dive,url"`
+	BattleLogs  []string  `json:"BattleLogs"` // raw Showdown logs or saved replay pages, one per battle of the series
 }
 
 type FinalizeGameRequestDTO struct {
@@ -18,4 +21,21 @@ type FinalizeGameRequestDTO struct {
 	Player1Wins *int      `json:"Player1Wins" binding:"required,gte=0"`
 	Player2Wins *int      `json:"Player2Wins" binding:"required,gte=0"`
 	ReplayLinks []string  `json:"ReplayLinks" binding:"dive,url"`
+	Reason      *string   `json:"Reason"` // optional note on the ruling, kept in the game's history
+}
+
+// DisputeGameRequestDTO is the opponent disputing a reported result. WinnerID and the wins, if given,
+// are their counter-report of what actually happened.
+type DisputeGameRequestDTO struct {
+	DisputerID  uuid.UUID  `json:"DisputerID" binding:"omitempty"`
+	Reason      string     `json:"Reason" binding:"required"`
+	WinnerID    *uuid.UUID `json:"WinnerID"`
+	Player1Wins *int       `json:"Player1Wins" binding:"omitempty,gte=0"`
+	Player2Wins *int       `json:"Player2Wins" binding:"omitempty,gte=0"`
+	ReplayLinks []string   `json:"ReplayLinks" binding:"dive,url"`
+}
+
+// BattleLogsRequestDTO is the Showdown logs or saved replay pages of a game's battles, one per battle.
+type BattleLogsRequestDTO struct {
+	BattleLogs []string `json:"BattleLogs" binding:"required,min=1"`
 }
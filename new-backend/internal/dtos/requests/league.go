@@ -2,6 +2,7 @@ package requests
 
 import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
 )
 
 type LeagueCreateRequestDTO struct {
@@ -11,4 +12,6 @@ type LeagueCreateRequestDTO struct {
 	MinPokemonPerPlayer int                `json:"MinPokemonPerPlayer" binding:"gte=0,max=20"`
 	StartingDraftPoints int                `json:"StartingDraftPoints" binding:"gte=20,max=150"`
 	Format              types.LeagueFormat `json:"Format"`
+	// the league's previous season, owned by the same user; used to weight a WEIGHTED_LOTTERY draft order
+	PreviousSeasonLeagueID *uuid.UUID `json:"PreviousSeasonLeagueID"`
 }
@@ -8,10 +8,12 @@ type PoolEntryCreateRequestDTO struct {
 	LeagueID         uuid.UUID `json:"LeagueID" binding:"required"`
 	PokemonSpeciesID int64     `json:"PokemonSpeciesID" binding:"required"`
 	Cost             *int      `json:"Cost" validate:"max=20"`
+	Tier             *string   `json:"Tier"` // one of the league's LeagueFormat.Tiers
 }
 
 type PoolEntryUpdateRequestDTO struct {
 	PoolEntryID uuid.UUID `json:"PoolEntryID" binding:"required"`
 	Cost        *int      `json:"Cost" validate:"max=20"`
 	IsAvailable *bool     `json:"IsAvailable"`
+	Tier        *string   `json:"Tier"` // "" moves the entry out of its tier
 }
@@ -0,0 +1,13 @@
+package requests
+
+import "time"
+
+// ScheduledTaskRescheduleRequestDTO moves a pending scheduler task to a new due time.
+type ScheduledTaskRescheduleRequestDTO struct {
+	ExecuteAt time.Time `json:"ExecuteAt" binding:"required"`
+}
+
+// ClockAdvanceRequestDTO moves the dev virtual clock forward. Duration is a Go duration, e.g. "72h".
+type ClockAdvanceRequestDTO struct {
+	Duration string `json:"Duration" binding:"required"`
+}
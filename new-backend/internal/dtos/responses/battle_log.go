@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/showdown"
+	"github.com/google/uuid"
+)
+
+// BattleLogResultResponse is a game's result as its Showdown battle logs tell it, in the shape a
+// report takes, so it can prefill one.
+type BattleLogResultResponse struct {
+	WinnerID    uuid.UUID           `json:"WinnerID"`
+	Player1Wins int                 `json:"Player1Wins"`
+	Player2Wins int                 `json:"Player2Wins"`
+	Battles     []BattleLogResponse `json:"Battles"`
+}
+
+// BattleLogResponse is one battle of the series, with the sides matched to the game's players.
+type BattleLogResponse struct {
+	WinnerID    uuid.UUID          `json:"WinnerID"`
+	Format      string             `json:"Format"`
+	Turns       int                `json:"Turns"`
+	Player1Team []showdown.Pokemon `json:"Player1Team"`
+	Player2Team []showdown.Pokemon `json:"Player2Team"`
+}
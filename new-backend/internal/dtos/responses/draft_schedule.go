@@ -0,0 +1,35 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+)
+
+// DraftScheduleResponse is the projected pick order of a draft, from the first pick to the last pick number
+// the draft can reach. The draft completes once every roster is full, which can happen earlier when
+// members use accumulated picks.
+type DraftScheduleResponse struct {
+	DraftID            uuid.UUID           `json:"DraftID"`
+	Status             enums.DraftStatus   `json:"Status"`
+	TurnTimeLimit      int                 `json:"TurnTimeLimit"` // minutes; rounds may override it, see DraftScheduleSlot.TurnTimeLimit
+	CurrentPickOnClock int                 `json:"CurrentPickOnClock"`
+	Picks              []DraftScheduleSlot `json:"Picks"`
+}
+
+// DraftScheduleSlot is one overall pick number.
+//
+// EstimatedClockStart assumes every turn before it runs its full time limit, with clocks frozen through
+// the league's quiet hours, so it is the latest the clock can start for that pick. It is nil for picks
+// already past and for every pick while the draft is paused.
+type DraftScheduleSlot struct {
+	PickNumber          int                           `json:"PickNumber"`
+	Round               int                           `json:"Round"`
+	PickInRound         int                           `json:"PickInRound"`
+	MemberID            uuid.UUID                     `json:"MemberID"`
+	OriginalOwnerID     uuid.UUID                     `json:"OriginalOwnerID"` // differs from MemberID if the slot was traded
+	Status              enums.DraftScheduleSlotStatus `json:"Status"`
+	TurnTimeLimit       int                           `json:"TurnTimeLimit"` // minutes, for this pick's round
+	EstimatedClockStart *time.Time                    `json:"EstimatedClockStart"`
+}
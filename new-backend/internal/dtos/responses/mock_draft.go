@@ -0,0 +1,30 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/google/uuid"
+)
+
+// MockDraftResponse is the state of a mock draft sandbox. Draft is nil until the mock draft is started.
+type MockDraftResponse struct {
+	ID        uuid.UUID          `json:"ID"`
+	LeagueID  uuid.UUID          `json:"LeagueID"`
+	CreatedBy uuid.UUID          `json:"CreatedBy"`
+	CreatedAt time.Time          `json:"CreatedAt"`
+	Draft     *models.Draft      `json:"Draft"`
+	Seats     []MockDraftSeat    `json:"Seats"`
+	Picks     []models.DraftPick `json:"Picks"`
+}
+
+// MockDraftSeat is one drafter in a mock draft. MemberID is only valid inside the sandbox.
+type MockDraftSeat struct {
+	MemberID      uuid.UUID  `json:"MemberID"`
+	UserID        *uuid.UUID `json:"UserID"` // nil for bots
+	TeamName      string     `json:"TeamName"`
+	DraftPosition int        `json:"DraftPosition"`
+	DraftPoints   int        `json:"DraftPoints"`
+	SkipsLeft     int        `json:"SkipsLeft"`
+	IsBot         bool       `json:"IsBot"`
+}
@@ -0,0 +1,48 @@
+package responses
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+)
+
+// ScheduledTaskResponse is a stored scheduler task with its payload as a JSON object rather than the
+// string it is stored as.
+type ScheduledTaskResponse struct {
+	ID          string                    `json:"ID"`
+	TaskID      string                    `json:"TaskID"`
+	Type        string                    `json:"Type"`
+	LeagueID    uuid.UUID                 `json:"LeagueID"`
+	Payload     json.RawMessage           `json:"Payload"`
+	ExecuteAt   time.Time                 `json:"ExecuteAt"`
+	Status      enums.ScheduledTaskStatus `json:"Status"`
+	Attempts    int                       `json:"Attempts"`
+	LastError   *string                   `json:"LastError"`
+	CompletedAt *time.Time                `json:"CompletedAt"`
+}
+
+func NewScheduledTaskResponse(task *models.ScheduledTask) ScheduledTaskResponse {
+	return ScheduledTaskResponse{
+		ID:          task.ID,
+		TaskID:      task.SchedulerTaskID(),
+		Type:        task.Type,
+		LeagueID:    task.LeagueID,
+		Payload:     json.RawMessage(task.Payload),
+		ExecuteAt:   task.ExecuteAt,
+		Status:      task.Status,
+		Attempts:    task.Attempts,
+		LastError:   task.LastError,
+		CompletedAt: task.CompletedAt,
+	}
+}
+
+func NewScheduledTaskResponses(tasks []models.ScheduledTask) []ScheduledTaskResponse {
+	response := make([]ScheduledTaskResponse, 0, len(tasks))
+	for i := range tasks {
+		response = append(response, NewScheduledTaskResponse(&tasks[i]))
+	}
+	return response
+}
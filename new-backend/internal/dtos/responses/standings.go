@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+)
+
+// GroupStandingsResponse ranks the members of one group, best record first. Members level on wins and
+// losses are separated by Tiebreakers, in order.
+type GroupStandingsResponse struct {
+	GroupNumber  int                         `json:"GroupNumber"`
+	Tiebreakers  []enums.StandingsTiebreaker `json:"Tiebreakers"`
+	TiebreakSeed *int64                      `json:"TiebreakSeed"` // seeds the coin flip; set when the league is created
+	Standings    []StandingResponse          `json:"Standings"`
+}
+
+// StandingResponse is one member's place in their group. GameDifferential and StrengthOfSchedule count
+// completed regular season games only.
+type StandingResponse struct {
+	Rank               int                        `json:"Rank"`
+	MemberID           uuid.UUID                  `json:"MemberID"`
+	InLeagueName       *string                    `json:"InLeagueName"`
+	TeamName           *string                    `json:"TeamName"`
+	Wins               int                        `json:"Wins"`
+	Losses             int                        `json:"Losses"`
+	GameDifferential   int                        `json:"GameDifferential"`
+	StrengthOfSchedule float64                    `json:"StrengthOfSchedule"` // opponents' average win rate, 0 to 1
+	DecidedBy          *enums.StandingsTiebreaker `json:"DecidedBy"`          // the tiebreaker that settled this place; nil if there was no tie
+}
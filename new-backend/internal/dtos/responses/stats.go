@@ -0,0 +1,36 @@
+package responses
+
+import "github.com/google/uuid"
+
+// PokemonStatsResponse is one Claim's line on a league's Pokémon leaderboard, over the league's completed
+// games. A Pokémon claimed more than once, e.g. dropped and picked up again, has a line per Claim.
+type PokemonStatsResponse struct {
+	Rank           int       `json:"Rank"`
+	ClaimID        uuid.UUID `json:"ClaimID"`
+	SpeciesID      int64     `json:"SpeciesID"`
+	SpeciesName    string    `json:"SpeciesName"`
+	MemberID       uuid.UUID `json:"MemberID"`
+	InLeagueName   *string   `json:"InLeagueName"`
+	TeamName       *string   `json:"TeamName"`
+	IsActive       bool      `json:"IsActive"`     // still on the member's roster
+	GamesBrought   int       `json:"GamesBrought"` // games it was sent out in at least once
+	BattlesBrought int       `json:"BattlesBrought"`
+	DirectKOs      int       `json:"DirectKOs"`
+	PassiveKOs     int       `json:"PassiveKOs"`
+	Faints         int       `json:"Faints"`
+	KODifferential int       `json:"KODifferential"` // direct and passive KOs, less faints
+}
+
+// CoachStatsResponse is a member's Pokémon's stats added up, over the league's completed games.
+type CoachStatsResponse struct {
+	Rank           int                   `json:"Rank"`
+	MemberID       uuid.UUID             `json:"MemberID"`
+	InLeagueName   *string               `json:"InLeagueName"`
+	TeamName       *string               `json:"TeamName"`
+	GamesPlayed    int                   `json:"GamesPlayed"` // games with battle logs
+	DirectKOs      int                   `json:"DirectKOs"`
+	PassiveKOs     int                   `json:"PassiveKOs"`
+	Faints         int                   `json:"Faints"`
+	KODifferential int                   `json:"KODifferential"`
+	MVP            *PokemonStatsResponse `json:"MVP"` // the member's highest ranked Pokémon
+}
@@ -116,6 +116,22 @@ func LeagueRBACMiddleware(
 	}
 }
 
+// AdminMiddleware only lets site admins through. It must run after AuthMiddleware.
+func AdminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		currentUser, exists := GetUserFromContext(ctx)
+		if !exists {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "User not found in context"})
+			return
+		}
+		if currentUser.Role != "admin" {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: admin only"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
 // Helper for Controllers to get current user context
 func GetUserFromContext(ctx *gin.Context) (*models.User, bool) {
 	val, exists := ctx.Get("currentUser")
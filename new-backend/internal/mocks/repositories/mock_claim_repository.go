@@ -43,6 +43,11 @@ func (m *MockClaimRepository) GetActiveByPlayer(playerID uuid.UUID) ([]models.Cl
 	return args.Get(0).([]models.Claim), args.Error(1)
 }
 
+func (m *MockClaimRepository) GetHeldByPlayerInWeek(playerID uuid.UUID, week int) ([]models.Claim, error) {
+	args := m.Called(playerID, week)
+	return args.Get(0).([]models.Claim), args.Error(1)
+}
+
 func (m *MockClaimRepository) GetActiveByLeague(leagueID uuid.UUID) ([]models.Claim, error) {
 	args := m.Called(leagueID)
 	return args.Get(0).([]models.Claim), args.Error(1)
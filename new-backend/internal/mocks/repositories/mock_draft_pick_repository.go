@@ -47,3 +47,17 @@ func (m *MockDraftPickRepository) GetCountByDraft(draftID uuid.UUID) (int64, err
 	args := m.Called(draftID)
 	return args.Get(0).(int64), args.Error(1)
 }
+
+func (m *MockDraftPickRepository) GetLatestByDraft(draftID uuid.UUID) (*models.DraftPick, error) {
+	args := m.Called(draftID)
+	var result *models.DraftPick
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.DraftPick)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftPickRepository) UndoPick(pick *models.DraftPick, draft *models.Draft, league *models.League) (int, error) {
+	args := m.Called(pick, draft, league)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,30 @@
+package mock_repositories
+
+import (
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDraftQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockDraftQueueRepository) GetByPlayer(playerID uuid.UUID) ([]models.DraftQueueEntry, error) {
+	args := m.Called(playerID)
+	var result []models.DraftQueueEntry
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.DraftQueueEntry)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftQueueRepository) ReplaceForPlayer(playerID uuid.UUID, entries []models.DraftQueueEntry) error {
+	args := m.Called(playerID, entries)
+	return args.Error(0)
+}
+
+func (m *MockDraftQueueRepository) DeleteByPlayerAndPoolEntry(playerID, poolEntryID uuid.UUID) error {
+	args := m.Called(playerID, poolEntryID)
+	return args.Error(0)
+}
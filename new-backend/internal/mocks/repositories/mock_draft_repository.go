@@ -1,6 +1,8 @@
 package mock_repositories
 
 import (
+	"time"
+
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/google/uuid"
@@ -51,3 +53,23 @@ func (m *MockDraftRepository) GetAllDraftsByStatus(status enums.DraftStatus) ([]
 	}
 	return result, args.Error(1)
 }
+
+func (m *MockDraftRepository) OpenAuctionLot(draftID, nominatorID, poolEntryID uuid.UUID, openingBid int, lotEndsAt time.Time) error {
+	args := m.Called(draftID, nominatorID, poolEntryID, openingBid, lotEndsAt)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) RaiseAuctionBid(draftID, poolEntryID, bidderID uuid.UUID, amount int, now, lotEndsAt time.Time) error {
+	args := m.Called(draftID, poolEntryID, bidderID, amount, now, lotEndsAt)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) TakeAuctionLotForClose(draftID, poolEntryID, highBidderID uuid.UUID, highBid int, now time.Time) error {
+	args := m.Called(draftID, poolEntryID, highBidderID, highBid, now)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) TakeAuctionNominationForPass(draftID, memberID uuid.UUID) error {
+	args := m.Called(draftID, memberID)
+	return args.Error(0)
+}
@@ -0,0 +1,70 @@
+package mock_repositories
+
+import (
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDraftTradeRepository struct {
+	mock.Mock
+}
+
+func (m *MockDraftTradeRepository) CreateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error) {
+	args := m.Called(trade)
+	var result *models.DraftPickTrade
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.DraftPickTrade)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) GetTradeByID(id uuid.UUID) (*models.DraftPickTrade, error) {
+	args := m.Called(id)
+	var result *models.DraftPickTrade
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.DraftPickTrade)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) GetTradesByLeague(leagueID uuid.UUID) ([]models.DraftPickTrade, error) {
+	args := m.Called(leagueID)
+	var result []models.DraftPickTrade
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.DraftPickTrade)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) UpdateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error) {
+	args := m.Called(trade)
+	var result *models.DraftPickTrade
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.DraftPickTrade)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) GetSlot(originalOwnerID uuid.UUID, roundNumber int) (*models.DraftPickSlot, error) {
+	args := m.Called(originalOwnerID, roundNumber)
+	var result *models.DraftPickSlot
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.DraftPickSlot)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) GetSlotsByLeague(leagueID uuid.UUID) ([]models.DraftPickSlot, error) {
+	args := m.Called(leagueID)
+	var result []models.DraftPickSlot
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.DraftPickSlot)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftTradeRepository) ApplyTrade(trade *models.DraftPickTrade, slots []models.DraftPickSlot, proposerPointsDelta, recipientPointsDelta int) error {
+	args := m.Called(trade, slots, proposerPointsDelta, recipientPointsDelta)
+	return args.Error(0)
+}
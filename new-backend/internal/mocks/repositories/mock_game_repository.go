@@ -4,6 +4,7 @@ import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -53,8 +54,13 @@ func (m *MockGameRepository) GetGamesByLeagueAndRound(leagueID uuid.UUID, roundN
 	return result, args.Error(1)
 }
 
-func (m *MockGameRepository) DisputeGame(gameID uuid.UUID, reporterID uuid.UUID) error {
-	args := m.Called(gameID, reporterID)
+func (m *MockGameRepository) DisputeGame(dispute *models.GameReport) error {
+	args := m.Called(dispute)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) AddGameReport(report *models.GameReport) error {
+	args := m.Called(report)
 	return args.Error(0)
 }
 
@@ -123,12 +129,27 @@ func (m *MockGameRepository) HasGames(leagueID uuid.UUID, gameType enums.GameTyp
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockGameRepository) UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO) error {
-	args := m.Called(gameID, loserID, dto)
+func (m *MockGameRepository) UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO, stats []models.ClaimGameStat) error {
+	args := m.Called(gameID, loserID, dto, stats)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetClaimGameStatsByLeague(leagueID uuid.UUID) ([]models.ClaimGameStat, error) {
+	args := m.Called(leagueID)
+	var result []models.ClaimGameStat
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.ClaimGameStat)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockGameRepository) FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport) error {
+	args := m.Called(game, loserID, dto, report)
 	return args.Error(0)
 }
 
-func (m *MockGameRepository) FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error {
-	args := m.Called(game, loserID, dto)
+func (m *MockGameRepository) FinalizeGameAndUpdateBracket(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport,
+	replay func(league *models.League, games []models.Game) (*repositories.BracketUpdate, error)) error {
+	args := m.Called(game, loserID, dto, report, replay)
 	return args.Error(0)
 }
@@ -0,0 +1,19 @@
+package mock_repositories
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLeaderLock struct {
+	mock.Mock
+}
+
+func (m *MockLeaderLock) TryAcquire() (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLeaderLock) Release() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -40,6 +40,10 @@ func (m *MockLeagueRepository) UpdateLeague(league *models.League) (*models.Leag
 	}
 	return result, args.Error(1)
 }
+func (m *MockLeagueRepository) ClearScheduledDraftStart(leagueID uuid.UUID) error {
+	args := m.Called(leagueID)
+	return args.Error(0)
+}
 func (m *MockLeagueRepository) GetLeaguesByOwner(ownerID uuid.UUID) ([]models.League, error) {
 	args := m.Called(ownerID)
 	return args.Get(0).([]models.League), args.Error(1)
@@ -84,3 +88,8 @@ func (m *MockLeagueRepository) GetLeaguesThatAllowTransfers() ([]models.League,
 	args := m.Called()
 	return args.Get(0).([]models.League), args.Error(1)
 }
+
+func (m *MockLeagueRepository) GetLeaguesWithScheduledDraftStart() ([]models.League, error) {
+	args := m.Called()
+	return args.Get(0).([]models.League), args.Error(1)
+}
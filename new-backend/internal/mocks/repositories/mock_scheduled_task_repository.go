@@ -0,0 +1,61 @@
+package mock_repositories
+
+import (
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduledTaskRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduledTaskRepository) Save(task *models.ScheduledTask) error {
+	args := m.Called(task)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTaskRepository) DeadLetter(record *models.ScheduledTask) error {
+	args := m.Called(record)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTaskRepository) UpdateStatus(id string, status enums.ScheduledTaskStatus) error {
+	args := m.Called(id, status)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTaskRepository) GetByStatus(status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error) {
+	args := m.Called(status)
+	var result []models.ScheduledTask
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.ScheduledTask)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockScheduledTaskRepository) GetByLeagueAndStatus(leagueID uuid.UUID, status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error) {
+	args := m.Called(leagueID, status)
+	var result []models.ScheduledTask
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.ScheduledTask)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockScheduledTaskRepository) GetUpdatedSince(since time.Time) ([]models.ScheduledTask, error) {
+	args := m.Called(since)
+	var result []models.ScheduledTask
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.ScheduledTask)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockScheduledTaskRepository) Count() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -1,9 +1,14 @@
 package mock_services
 
 import (
+	"time"
+
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -31,6 +36,15 @@ func (m *MockDraftService) GetDraftByLeagueID(leagueID uuid.UUID) (*models.Draft
 	return result, args.Error(1)
 }
 
+func (m *MockDraftService) GetPickSchedule(leagueID uuid.UUID) (*responses.DraftScheduleResponse, error) {
+	args := m.Called(leagueID)
+	var result *responses.DraftScheduleResponse
+	if args.Get(0) != nil {
+		result = args.Get(0).(*responses.DraftScheduleResponse)
+	}
+	return result, args.Error(1)
+}
+
 func (m *MockDraftService) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*models.Draft, error) {
 	args := m.Called(leagueID, TurnTimeLimit)
 	var result *models.Draft
@@ -50,11 +64,123 @@ func (m *MockDraftService) SkipTurn(currentUser *models.User, leagueID uuid.UUID
 	return args.Error(0)
 }
 
-func (m *MockDraftService) AutoSkipTurn(playerID, leagueID uuid.UUID) error {
-	args := m.Called(playerID, leagueID)
+func (m *MockDraftService) AutoSkipTurn(playerID, leagueID uuid.UUID, pickNumber int) error {
+	args := m.Called(playerID, leagueID, pickNumber)
 	return args.Error(0)
 }
 
 func (m *MockDraftService) SetSchedulerService(schedulerService services.SchedulerService) {
 	m.Called(schedulerService)
 }
+
+func (m *MockDraftService) SetDraftEventService(eventService services.DraftEventService) {
+	m.Called(eventService)
+}
+
+func (m *MockDraftService) SetDraftQueueRepository(draftQueueRepo repositories.DraftQueueRepository) {
+	m.Called(draftQueueRepo)
+}
+
+func (m *MockDraftService) SetDraftTradeRepository(draftTradeRepo repositories.DraftTradeRepository) {
+	m.Called(draftTradeRepo)
+}
+
+func (m *MockDraftService) SetNewRepositories(draftPickRepo repositories.DraftPickRepository, claimRepo repositories.ClaimRepository, poolEntryRepo repositories.PoolEntryRepository) {
+	m.Called(draftPickRepo, claimRepo, poolEntryRepo)
+}
+
+func (m *MockDraftService) ForcePick(leagueID uuid.UUID, input *requests.DraftMakePickRequestDTO) error {
+	args := m.Called(leagueID, input)
+	return args.Error(0)
+}
+
+func (m *MockDraftService) PauseDraft(leagueID uuid.UUID) (*models.Draft, error) {
+	args := m.Called(leagueID)
+	var result *models.Draft
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.Draft)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) ResumeDraft(leagueID uuid.UUID) (*models.Draft, error) {
+	args := m.Called(leagueID)
+	var result *models.Draft
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.Draft)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) SetCustomDraftOrder(leagueID uuid.UUID, input *requests.DraftCustomOrderRequestDTO) (*models.League, error) {
+	args := m.Called(leagueID, input)
+	var result *models.League
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.League)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) UndoLastPick(leagueID uuid.UUID) (*models.Draft, error) {
+	args := m.Called(leagueID)
+	var result *models.Draft
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.Draft)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) NominateAuctionLot(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftAuctionNominateRequestDTO) (*models.Draft, error) {
+	args := m.Called(currentUser, leagueID, input)
+	var result *models.Draft
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.Draft)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) PlaceAuctionBid(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftAuctionBidRequestDTO) (*models.Draft, error) {
+	args := m.Called(currentUser, leagueID, input)
+	var result *models.Draft
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.Draft)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) CloseAuctionLot(leagueID, poolEntryID uuid.UUID) error {
+	args := m.Called(leagueID, poolEntryID)
+	return args.Error(0)
+}
+
+func (m *MockDraftService) SendTurnReminder(leagueID, memberID uuid.UUID, pickNumber, percent int) error {
+	args := m.Called(leagueID, memberID, pickNumber, percent)
+	return args.Error(0)
+}
+
+func (m *MockDraftService) ScheduleDraftStart(leagueID uuid.UUID, input *requests.DraftScheduleStartRequestDTO) (*models.League, error) {
+	args := m.Called(leagueID, input)
+	var result *models.League
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.League)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) CancelScheduledDraftStart(leagueID uuid.UUID) (*models.League, error) {
+	args := m.Called(leagueID)
+	var result *models.League
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.League)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockDraftService) RunScheduledDraftStart(leagueID uuid.UUID, scheduledFor time.Time) error {
+	args := m.Called(leagueID, scheduledFor)
+	return args.Error(0)
+}
+
+func (m *MockDraftService) SetClock(clock utils.Clock) {
+	m.Called(clock)
+}
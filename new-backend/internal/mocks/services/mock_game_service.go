@@ -2,8 +2,11 @@ package mock_services
 
 import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -55,3 +58,39 @@ func (m *MockGameService) FinalizeGameResult(gameID uuid.UUID, dto *requests.Fin
 func (m *MockGameService) SetLeagueService(leagueService services.LeagueService) {
 	m.Called(leagueService)
 }
+
+func (m *MockGameService) ConfirmGameResult(gameID uuid.UUID, memberID uuid.UUID) (bool, error) {
+	args := m.Called(gameID, memberID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGameService) DisputeGameResult(gameID uuid.UUID, dto *requests.DisputeGameRequestDTO) error {
+	args := m.Called(gameID, dto)
+	return args.Error(0)
+}
+
+func (m *MockGameService) GetDisputedGames(leagueID uuid.UUID) ([]models.Game, error) {
+	args := m.Called(leagueID)
+	var result []models.Game
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.Game)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockGameService) ReadBattleLogs(gameID uuid.UUID, battleLogs []string) (*responses.BattleLogResultResponse, error) {
+	args := m.Called(gameID, battleLogs)
+	var result *responses.BattleLogResultResponse
+	if args.Get(0) != nil {
+		result = args.Get(0).(*responses.BattleLogResultResponse)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockGameService) SetClaimRepository(claimRepo repositories.ClaimRepository) {
+	m.Called(claimRepo)
+}
+
+func (m *MockGameService) SetClock(clock utils.Clock) {
+	m.Called(clock)
+}
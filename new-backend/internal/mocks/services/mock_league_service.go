@@ -4,6 +4,7 @@ import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -62,3 +63,7 @@ func (m *MockLeagueService) StartRegularSeason(leagueID uuid.UUID) error {
 	args := m.Called(leagueID)
 	return args.Error(0)
 }
+
+func (m *MockLeagueService) SetClock(clock utils.Clock) {
+	m.Called(clock)
+}
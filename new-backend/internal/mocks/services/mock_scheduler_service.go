@@ -1,8 +1,12 @@
 package mock_services
 
 import (
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
 	u "github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -23,6 +27,44 @@ func (m *MockSchedulerService) DeregisterTask(taskID string) {
 	m.Called(taskID)
 }
 
+func (m *MockSchedulerService) GetDeadLetterTasks(leagueID *uuid.UUID) ([]models.ScheduledTask, error) {
+	args := m.Called(leagueID)
+	var result []models.ScheduledTask
+	if args.Get(0) != nil {
+		result = args.Get(0).([]models.ScheduledTask)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockSchedulerService) ListPendingTasks(leagueID *uuid.UUID) []models.ScheduledTask {
+	args := m.Called(leagueID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]models.ScheduledTask)
+}
+
+func (m *MockSchedulerService) RescheduleTask(leagueID *uuid.UUID, taskID string, executeAt time.Time) (*models.ScheduledTask, error) {
+	args := m.Called(leagueID, taskID, executeAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ScheduledTask), args.Error(1)
+}
+
+func (m *MockSchedulerService) RunTaskNow(leagueID *uuid.UUID, taskID string) (*models.ScheduledTask, error) {
+	args := m.Called(leagueID, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ScheduledTask), args.Error(1)
+}
+
+func (m *MockSchedulerService) CancelTask(leagueID *uuid.UUID, taskID string) error {
+	args := m.Called(leagueID, taskID)
+	return args.Error(0)
+}
+
 func (m *MockSchedulerService) Stop() {
 	m.Called()
 }
@@ -38,3 +80,12 @@ func (m *MockSchedulerService) SetTransferService(transferService services.Trans
 func (m *MockSchedulerService) SetLeagueService(leagueService services.LeagueService) {
 	m.Called(leagueService)
 }
+
+func (m *MockSchedulerService) AdvanceClock(d time.Duration) (time.Time, error) {
+	args := m.Called(d)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockSchedulerService) SetClock(clock u.Clock) {
+	m.Called(clock)
+}
@@ -3,6 +3,7 @@ package mock_services
 import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -39,3 +40,7 @@ func (m *MockTransferService) SetSchedulerService(schedulerService services.Sche
 func (m *MockTransferService) SetLeagueService(leagueService services.LeagueService) {
 	m.Called(leagueService)
 }
+
+func (m *MockTransferService) SetClock(clock utils.Clock) {
+	m.Called(clock)
+}
@@ -45,3 +45,22 @@ type Claim struct {
 	Player         *LeagueMember   `gorm:"foreignKey:player_id;references:id" json:"Player,omitempty"`
 	PokemonSpecies *PokemonSpecies `gorm:"foreignKey:species_id;references:id" json:"PokemonSpecies,omitempty"`
 }
+
+// ClaimGameStat is how a claimed Pokémon did in one game, read from the game's battle logs when it was
+// reported. It points at the Claim its player held then, so a dropped Pokémon keeps its history. Only
+// stats of completed games count toward the league's leaderboards.
+type ClaimGameStat struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
+	LeagueID       uuid.UUID `gorm:"type:uuid;not null;index;column:league_id" json:"LeagueID"`
+	GameID         uuid.UUID `gorm:"type:uuid;not null;index;column:game_id" json:"GameID"`
+	ClaimID        uuid.UUID `gorm:"type:uuid;not null;index;column:claim_id" json:"ClaimID"`
+	BattlesBrought int       `gorm:"not null;default:0;column:battles_brought" json:"BattlesBrought"` // battles of the series it was sent out in
+	DirectKOs      int       `gorm:"not null;default:0;column:direct_kos" json:"DirectKOs"`
+	PassiveKOs     int       `gorm:"not null;default:0;column:passive_kos" json:"PassiveKOs"`
+	Faints         int       `gorm:"not null;default:0;column:faints" json:"Faints"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"CreatedAt"`
+
+	// Relationships
+	Claim *Claim `gorm:"foreignKey:claim_id;references:id" json:"Claim,omitempty"`
+	Game  *Game  `gorm:"foreignKey:game_id;references:id" json:"Game,omitempty"`
+}
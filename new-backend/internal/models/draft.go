@@ -4,9 +4,12 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -29,11 +32,57 @@ type Draft struct {
 	UpdatedAt                   time.Time              `gorm:"type:timestamp with time zone;column:updated_at" json:"UpdatedAt"`
 	DeletedAt                   gorm.DeletedAt         `gorm:"index;type:timestamp with time zone;column:deleted_at" json:"-"`
 
+	// Auction draft state (LeagueFormat.DraftMode == AUCTION). All nil/zero while no lot is open;
+	// CurrentTurnMemberID is then the member due to nominate the next lot (nil for the moment a lot is
+	// being closed or a nomination passed on).
+	AuctionPoolEntryID  *uuid.UUID `gorm:"type:uuid;column:auction_pool_entry_id" json:"AuctionPoolEntryID"`
+	AuctionHighBid      int        `gorm:"default:0;not null;column:auction_high_bid" json:"AuctionHighBid"`
+	AuctionHighBidderID *uuid.UUID `gorm:"type:uuid;column:auction_high_bidder_id" json:"AuctionHighBidderID"`
+	AuctionLotEndsAt    *time.Time `gorm:"type:timestamp with time zone;column:auction_lot_ends_at" json:"AuctionLotEndsAt"`
+	// nominations passed in a row since the last lot opened; a full lap of them pauses the draft
+	AuctionPasses int `gorm:"default:0;not null;column:auction_passes" json:"AuctionPasses"`
+
+	// set when the draft order was drawn by weighted lottery (DraftOrderType WEIGHTED_LOTTERY)
+	Lottery *DraftLottery `gorm:"type:jsonb;column:lottery" json:"Lottery"`
+	// quiet hours and per round time limits, copied from the league's format at start; nil for a plain clock
+	Clock *types.DraftClock `gorm:"type:jsonb;column:clock" json:"Clock"`
+
 	// Relationships
 	League            *League       `gorm:"foreignKey:league_id;references:id" json:"League,omitempty"`
 	CurrentTurnMember *LeagueMember `gorm:"foreignKey:current_turn_player_id;references:id" json:"CurrentTurnMember,omitempty"`
 }
 
+// TurnTimeLimitForRound returns the turn time limit in minutes for round, after any per round override.
+func (d *Draft) TurnTimeLimitForRound(round int) int {
+	return d.Clock.TurnTimeLimit(round, d.TurnTimeLimit)
+}
+
+// TurnDeadline returns when a turn of round whose clock started at start runs out, accounting for the
+// round's time limit and for quiet hours.
+func (d *Draft) TurnDeadline(start time.Time, round int) time.Time {
+	return d.Clock.Deadline(start, time.Duration(d.TurnTimeLimitForRound(round))*time.Minute)
+}
+
+// CurrentTurnEndsAt returns when the clock of the turn in progress runs out, or nil if no turn is timed.
+func (d *Draft) CurrentTurnEndsAt() *time.Time {
+	if d.CurrentTurnStartTime == nil {
+		return nil
+	}
+	endsAt := d.TurnDeadline(*d.CurrentTurnStartTime, d.CurrentRound)
+	return &endsAt
+}
+
+// CurrentTurnReminderAt returns when percent of the current turn's clock has run, frozen through quiet
+// hours like the deadline, or nil if no turn is timed.
+func (d *Draft) CurrentTurnReminderAt(percent int) *time.Time {
+	if d.CurrentTurnStartTime == nil {
+		return nil
+	}
+	limit := time.Duration(d.TurnTimeLimitForRound(d.CurrentRound)) * time.Minute
+	remindAt := d.Clock.Deadline(*d.CurrentTurnStartTime, limit*time.Duration(percent)/100)
+	return &remindAt
+}
+
 // PlayerAccumulatedPicks is a custom type for storing a map of player IDs to their accumulated pick numbers.
 type PlayerAccumulatedPicks map[uuid.UUID][]int
 
@@ -73,3 +122,59 @@ func (p *PlayerAccumulatedPicks) Scan(value interface{}) error {
 	}
 	return json.Unmarshal(byteValue, p)
 }
+
+// DraftLottery is the record of a weighted draft lottery, kept so the draw can be audited:
+// calling Draw on the stored Seed and Entries always yields DrawnOrder again.
+type DraftLottery struct {
+	Seed       int64               `json:"Seed"`
+	Entries    []DraftLotteryEntry `json:"Entries"`    // sorted by MemberID, the order Draw walks them in
+	DrawnOrder []uuid.UUID         `json:"DrawnOrder"` // member IDs, first pick first
+}
+
+// DraftLotteryEntry is one member's lottery weight. A member with twice the weight of another is
+// twice as likely to be drawn before them at each step.
+type DraftLotteryEntry struct {
+	MemberID uuid.UUID `json:"MemberID"`
+	Weight   int       `json:"Weight"`
+}
+
+// Draw runs the lottery: positions are drawn one at a time, without replacement, each remaining entry
+// being chosen with probability Weight / (sum of remaining weights), using math/rand seeded with Seed.
+func (l DraftLottery) Draw() []uuid.UUID {
+	r := rand.New(rand.NewSource(l.Seed))
+	remaining := slices.Clone(l.Entries)
+	order := make([]uuid.UUID, 0, len(remaining))
+	for len(remaining) > 0 {
+		total := 0
+		for _, e := range remaining {
+			total += max(e.Weight, 1)
+		}
+		ticket := r.Intn(total)
+		i := 0
+		for ; ticket >= max(remaining[i].Weight, 1); i++ {
+			ticket -= max(remaining[i].Weight, 1)
+		}
+		order = append(order, remaining[i].MemberID)
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+	return order
+}
+
+// Value implements the driver.Valuer interface for DraftLottery.
+func (l DraftLottery) Value() (driver.Value, error) {
+	return json.Marshal(l)
+}
+
+// Scan implements the sql.Scanner interface for DraftLottery.
+func (l *DraftLottery) Scan(value interface{}) error {
+	var byteValue []byte
+	switch v := value.(type) {
+	case []byte:
+		byteValue = v
+	case string:
+		byteValue = []byte(v)
+	default:
+		return errors.New("unsupported type for DraftLottery")
+	}
+	return json.Unmarshal(byteValue, l)
+}
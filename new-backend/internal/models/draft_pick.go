@@ -17,6 +17,8 @@ type DraftPick struct {
 	PoolEntryID uuid.UUID `gorm:"type:uuid;not null;column:pool_entry_id" json:"PoolEntryID"`
 	RoundNumber int       `gorm:"not null;column:round_number" json:"RoundNumber"`
 	PickNumber  int       `gorm:"not null;column:pick_number" json:"PickNumber"`
+	// OriginalOwnerID is the member the pick slot was originally dealt to; only set when the slot was traded.
+	OriginalOwnerID *uuid.UUID `gorm:"type:uuid;column:original_owner_id" json:"OriginalOwnerID,omitempty"`
 	CreatedAt   time.Time `gorm:"column:created_at" json:"CreatedAt"`
 	UpdatedAt   time.Time `gorm:"column:updated_at" json:"UpdatedAt"`
 
@@ -24,4 +26,5 @@ type DraftPick struct {
 	Draft     *Draft       `gorm:"foreignKey:draft_id;references:id" json:"Draft,omitempty"`
 	Player    *LeagueMember `gorm:"foreignKey:player_id;references:id" json:"Player,omitempty"`
 	PoolEntry *PoolEntry   `gorm:"foreignKey:pool_entry_id;references:id" json:"PoolEntry,omitempty"`
+	OriginalOwner *LeagueMember `gorm:"foreignKey:original_owner_id;references:id" json:"OriginalOwner,omitempty"`
 }
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+)
+
+// DraftPickSlot records the current owner of a draft slot that has changed hands through a trade.
+// A slot is identified by the member it was originally dealt to and its round, so slots can be
+// traded before the draft order is known. Slots without a row still belong to their original owner.
+type DraftPickSlot struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
+	LeagueID        uuid.UUID `gorm:"type:uuid;not null;index;column:league_id" json:"LeagueID"`
+	OriginalOwnerID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_draft_pick_slot;column:original_owner_id" json:"OriginalOwnerID"`
+	RoundNumber     int       `gorm:"not null;uniqueIndex:idx_draft_pick_slot;column:round_number" json:"RoundNumber"`
+	OwnerID         uuid.UUID `gorm:"type:uuid;not null;index;column:owner_id" json:"OwnerID"`
+	CreatedAt       time.Time `gorm:"column:created_at" json:"CreatedAt"`
+	UpdatedAt       time.Time `gorm:"column:updated_at" json:"UpdatedAt"`
+
+	// Relationships
+	OriginalOwner *LeagueMember `gorm:"foreignKey:original_owner_id;references:id" json:"OriginalOwner,omitempty"`
+	Owner         *LeagueMember `gorm:"foreignKey:owner_id;references:id" json:"Owner,omitempty"`
+}
+
+// DraftPickTrade is a proposed exchange of draft slots (and optionally draft points) between two members.
+// Offered* is what the proposer gives up, Requested* is what the recipient gives up.
+type DraftPickTrade struct {
+	ID              uuid.UUID                  `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
+	LeagueID        uuid.UUID                  `gorm:"type:uuid;not null;index;column:league_id" json:"LeagueID"`
+	ProposerID      uuid.UUID                  `gorm:"type:uuid;not null;index;column:proposer_id" json:"ProposerID"`
+	RecipientID     uuid.UUID                  `gorm:"type:uuid;not null;index;column:recipient_id" json:"RecipientID"`
+	OfferedSlots    DraftSlotRefs              `gorm:"type:jsonb;column:offered_slots" json:"OfferedSlots"`
+	RequestedSlots  DraftSlotRefs              `gorm:"type:jsonb;column:requested_slots" json:"RequestedSlots"`
+	OfferedPoints   int                        `gorm:"default:0;not null;column:offered_points" json:"OfferedPoints"`
+	RequestedPoints int                        `gorm:"default:0;not null;column:requested_points" json:"RequestedPoints"`
+	Status          enums.DraftPickTradeStatus `gorm:"type:varchar(20);not null;default:'PENDING';column:status" json:"Status"`
+	ResolvedAt      *time.Time                 `gorm:"column:resolved_at" json:"ResolvedAt"`
+	CreatedAt       time.Time                  `gorm:"column:created_at" json:"CreatedAt"`
+	UpdatedAt       time.Time                  `gorm:"column:updated_at" json:"UpdatedAt"`
+
+	// Relationships
+	Proposer  *LeagueMember `gorm:"foreignKey:proposer_id;references:id" json:"Proposer,omitempty"`
+	Recipient *LeagueMember `gorm:"foreignKey:recipient_id;references:id" json:"Recipient,omitempty"`
+}
+
+// DraftSlotRef identifies a draft slot by the member it was originally dealt to and its round.
+type DraftSlotRef struct {
+	OriginalOwnerID uuid.UUID `json:"OriginalOwnerID"`
+	RoundNumber     int       `json:"RoundNumber"`
+}
+
+// DraftSlotRefs is a custom type for storing a list of slot references as JSONB.
+type DraftSlotRefs []DraftSlotRef
+
+// Value implements the driver.Valuer interface for DraftSlotRefs.
+func (r DraftSlotRefs) Value() (driver.Value, error) {
+	if r == nil {
+		return json.Marshal([]DraftSlotRef{})
+	}
+	return json.Marshal(r)
+}
+
+// Scan implements the sql.Scanner interface for DraftSlotRefs.
+func (r *DraftSlotRefs) Scan(value any) error {
+	if value == nil {
+		*r = DraftSlotRefs{}
+		return nil
+	}
+	var byteValue []byte
+	switch v := value.(type) {
+	case []byte:
+		byteValue = v
+	case string:
+		byteValue = []byte(v)
+	default:
+		return errors.New("unsupported type for DraftSlotRefs")
+	}
+	if len(byteValue) == 0 {
+		*r = DraftSlotRefs{}
+		return nil
+	}
+	return json.Unmarshal(byteValue, r)
+}
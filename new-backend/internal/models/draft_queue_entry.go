@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DraftQueueEntry is one slot in a league member's private, pre-ranked draft queue.
+// When the member's turn times out, the draft service picks the highest ranked entry
+// that is still available and affordable instead of skipping the turn.
+type DraftQueueEntry struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
+	PlayerID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_draft_queue_player_entry;column:player_id" json:"PlayerID"`
+	PoolEntryID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_draft_queue_player_entry;column:pool_entry_id" json:"PoolEntryID"`
+	Rank        int       `gorm:"not null;column:rank" json:"Rank"` // 1 is the top of the queue
+	CreatedAt   time.Time `gorm:"column:created_at" json:"CreatedAt"`
+	UpdatedAt   time.Time `gorm:"column:updated_at" json:"UpdatedAt"`
+
+	// Relationships
+	Player    *LeagueMember `gorm:"foreignKey:player_id;references:id" json:"Player,omitempty"`
+	PoolEntry *PoolEntry    `gorm:"foreignKey:pool_entry_id;references:id" json:"PoolEntry,omitempty"`
+}
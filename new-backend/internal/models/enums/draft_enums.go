@@ -12,6 +12,21 @@ type DraftStatus string
 // DraftOrderType defines the possible methods for determining draft order.
 type DraftOrderType string
 
+// DraftRoundOrder defines the order members pick in within each round, given their draft positions.
+type DraftRoundOrder string
+
+// DraftMode defines how pokemon are acquired during a draft.
+type DraftMode string
+
+// DraftPickTradeStatus defines the lifecycle of a proposed draft pick trade.
+type DraftPickTradeStatus string
+
+// DraftTimeoutPolicy defines what happens when a member's turn timer runs out.
+type DraftTimeoutPolicy string
+
+// DraftScheduleSlotStatus describes a pick number in a projected draft schedule. Never stored.
+type DraftScheduleSlotStatus string
+
 const (
 	DraftStatusPending   DraftStatus = "PENDING"
 	DraftStatusOngoing   DraftStatus = "ONGOING"
@@ -22,6 +37,50 @@ const (
 const (
 	DraftOrderTypeRandom DraftOrderType = "RANDOM"
 	DraftOrderTypeManual DraftOrderType = "MANUAL"
+	// worst record picks first; for supplemental drafts run after games have been played
+	DraftOrderTypeReverseStandings DraftOrderType = "REVERSE_STANDINGS"
+	// a seeded draw where worse finishers of the previous season get better odds; see models.DraftLottery
+	DraftOrderTypeWeightedLottery DraftOrderType = "WEIGHTED_LOTTERY"
+)
+
+const (
+	DraftRoundOrderLinear DraftRoundOrder = "LINEAR" // every round 1..n
+	DraftRoundOrderSnake  DraftRoundOrder = "SNAKE"  // odd rounds 1..n, even rounds n..1
+	// like snake, but round 3 repeats round 2 (n..1) and the alternation continues from there
+	DraftRoundOrderThirdRoundReversal DraftRoundOrder = "THIRD_ROUND_REVERSAL"
+	// every round follows LeagueFormat.CustomDraftOrder, set by league staff
+	DraftRoundOrderCustom DraftRoundOrder = "CUSTOM"
+)
+
+const (
+	// members pick in turn (linear or snake) and pay each pool entry's fixed cost
+	DraftModeStandard DraftMode = "STANDARD"
+	// members take turns nominating a pool entry and everyone bids on it with their draft points
+	DraftModeAuction DraftMode = "AUCTION"
+)
+
+const (
+	DraftPickTradeStatusPending   DraftPickTradeStatus = "PENDING"
+	DraftPickTradeStatusAccepted  DraftPickTradeStatus = "ACCEPTED"
+	DraftPickTradeStatusRejected  DraftPickTradeStatus = "REJECTED"
+	DraftPickTradeStatusCancelled DraftPickTradeStatus = "CANCELLED" // withdrawn by the proposer
+	DraftPickTradeStatusVetoed    DraftPickTradeStatus = "VETOED"    // blocked or reversed by league staff
+)
+
+const (
+	DraftTimeoutPolicySkip DraftTimeoutPolicy = "SKIP" // skip the turn; pause for staff if the member can't skip
+	// draft the best base stat total per point the member can afford, else skip
+	DraftTimeoutPolicyAutoPickBestAvailable DraftTimeoutPolicy = "AUTO_PICK_BEST_AVAILABLE"
+	// draft the member's highest ranked valid queue entry, else skip
+	DraftTimeoutPolicyAutoPickQueue DraftTimeoutPolicy = "AUTO_PICK_QUEUE"
+	DraftTimeoutPolicyPause         DraftTimeoutPolicy = "PAUSE" // pause the draft for league staff
+)
+
+const (
+	DraftScheduleSlotDone        DraftScheduleSlotStatus = "DONE"        // picked, or skipped and already made up
+	DraftScheduleSlotAccumulated DraftScheduleSlotStatus = "ACCUMULATED" // skipped; the member can still use it on a later turn
+	DraftScheduleSlotOnClock     DraftScheduleSlotStatus = "ON_CLOCK"
+	DraftScheduleSlotUpcoming    DraftScheduleSlotStatus = "UPCOMING"
 )
 
 // IsValid validates DraftStatus for database interactions
@@ -68,7 +127,7 @@ func (ds DraftStatus) Normalize() DraftStatus {
 // IsValid Validate DraftOrderType for database interactions
 func (dot DraftOrderType) IsValid() bool {
 	switch dot {
-	case DraftOrderTypeRandom, DraftOrderTypeManual:
+	case DraftOrderTypeRandom, DraftOrderTypeManual, DraftOrderTypeReverseStandings, DraftOrderTypeWeightedLottery:
 		return true
 	default:
 		return false
@@ -104,3 +163,112 @@ func (dot *DraftOrderType) Scan(value any) error {
 func (dot DraftOrderType) Normalize() DraftOrderType {
 	return DraftOrderType(strings.ToUpper(string(dot)))
 }
+
+// IsValid validates DraftRoundOrder
+func (ro DraftRoundOrder) IsValid() bool {
+	switch ro {
+	case DraftRoundOrderLinear, DraftRoundOrderSnake, DraftRoundOrderThirdRoundReversal, DraftRoundOrderCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ro DraftRoundOrder) Normalize() DraftRoundOrder {
+	return DraftRoundOrder(strings.ToUpper(string(ro)))
+}
+
+// IsValid validates DraftTimeoutPolicy
+func (tp DraftTimeoutPolicy) IsValid() bool {
+	switch tp {
+	case DraftTimeoutPolicySkip, DraftTimeoutPolicyAutoPickBestAvailable, DraftTimeoutPolicyAutoPickQueue, DraftTimeoutPolicyPause:
+		return true
+	default:
+		return false
+	}
+}
+
+func (tp DraftTimeoutPolicy) Normalize() DraftTimeoutPolicy {
+	return DraftTimeoutPolicy(strings.ToUpper(string(tp)))
+}
+
+// IsValid validates DraftMode for database interactions
+func (dm DraftMode) IsValid() bool {
+	switch dm {
+	case DraftModeStandard, DraftModeAuction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Value implements the driver.Valuer interface for GORM/database saving.
+func (dm DraftMode) Value() (driver.Value, error) {
+	if !dm.IsValid() {
+		return nil, fmt.Errorf("invalid DraftMode value: %s", dm)
+	}
+	return string(dm), nil
+}
+
+// Scan implements the sql.Scanner interface for GORM/database loading.
+func (dm *DraftMode) Scan(value any) error {
+	if value == nil {
+		*dm = DraftModeStandard
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("DraftMode: expected string, got %T", value)
+	}
+	newMode := DraftMode(str).Normalize()
+	if !newMode.IsValid() {
+		return fmt.Errorf("invalid DraftMode value retrieved from DB: %s", str)
+	}
+	*dm = newMode
+	return nil
+}
+
+func (dm DraftMode) Normalize() DraftMode {
+	return DraftMode(strings.ToUpper(string(dm)))
+}
+
+// IsValid validates DraftPickTradeStatus for database interactions
+func (ts DraftPickTradeStatus) IsValid() bool {
+	switch ts {
+	case DraftPickTradeStatusPending, DraftPickTradeStatusAccepted, DraftPickTradeStatusRejected,
+		DraftPickTradeStatusCancelled, DraftPickTradeStatusVetoed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Value implements the driver.Valuer interface for GORM/database saving.
+func (ts DraftPickTradeStatus) Value() (driver.Value, error) {
+	if !ts.IsValid() {
+		return nil, fmt.Errorf("invalid DraftPickTradeStatus value: %s", ts)
+	}
+	return string(ts), nil
+}
+
+// Scan implements the sql.Scanner interface for GORM/database loading.
+func (ts *DraftPickTradeStatus) Scan(value any) error {
+	if value == nil {
+		*ts = DraftPickTradeStatusPending
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("DraftPickTradeStatus: expected string, got %T", value)
+	}
+	newStatus := DraftPickTradeStatus(str).Normalize()
+	if !newStatus.IsValid() {
+		return fmt.Errorf("invalid DraftPickTradeStatus value retrieved from DB: %s", str)
+	}
+	*ts = newStatus
+	return nil
+}
+
+func (ts DraftPickTradeStatus) Normalize() DraftPickTradeStatus {
+	return DraftPickTradeStatus(strings.ToUpper(string(ts)))
+}
@@ -10,9 +10,12 @@ import (
 type GameStatus string
 type GameType string
 
+// GameReportAction is what an entry in a game's result history records.
+type GameReportAction string
+
 const (
 	GameStatusScheduled       GameStatus = "SCHEDULED"
-	GameStatusApprovalPending GameStatus = "APPROVAL_PENDING" // unused
+	GameStatusApprovalPending GameStatus = "APPROVAL_PENDING" // reported, awaiting the opponent or league staff
 	GameStatusCompleted       GameStatus = "COMPLETED"
 	GameStatusDisputed        GameStatus = "DISPUTED"
 )
@@ -30,6 +33,13 @@ const (
 	GameTypeTournamentGrandFinal GameType = "GRAND_FINAL"
 )
 
+const (
+	GameReportActionReport  GameReportAction = "REPORT"  // a player reported the result
+	GameReportActionConfirm GameReportAction = "CONFIRM" // the opponent agreed with the reported result
+	GameReportActionDispute GameReportAction = "DISPUTE" // the opponent disputed it, optionally with their own result
+	GameReportActionRuling  GameReportAction = "RULING"  // league staff finalized the result
+)
+
 var gameStatuses = []GameStatus{
 	GameStatusScheduled,
 	GameStatusApprovalPending,
@@ -122,3 +132,43 @@ func (gt *GameType) Scan(value any) error {
 func (gt GameType) Normalize() GameType {
 	return GameType(strings.ToUpper(string(gt)))
 }
+
+// IsValid checks if the GameReportAction is one of the predefined valid actions.
+func (a GameReportAction) IsValid() bool {
+	switch a {
+	case GameReportActionReport, GameReportActionConfirm, GameReportActionDispute, GameReportActionRuling:
+		return true
+	default:
+		return false
+	}
+}
+
+// Value implements the driver.Valuer interface for GORM/database saving.
+func (a GameReportAction) Value() (driver.Value, error) {
+	if !a.IsValid() {
+		return nil, fmt.Errorf("invalid GameReportAction value: %s", a)
+	}
+	return string(a), nil
+}
+
+// Scan implements the sql.Scanner interface for GORM/database loading.
+func (a *GameReportAction) Scan(value any) error {
+	if value == nil {
+		*a = GameReportActionReport
+		return nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("GameReportAction: expected string, got %T", value)
+	}
+	newAction := GameReportAction(str).Normalize()
+	if !newAction.IsValid() {
+		return fmt.Errorf("invalid GameReportAction value retrieved from DB: %s", str)
+	}
+	*a = newAction
+	return nil
+}
+
+func (a GameReportAction) Normalize() GameReportAction {
+	return GameReportAction(strings.ToUpper(string(a)))
+}
@@ -297,3 +297,33 @@ func (v *LeagueVisibility) Scan(value any) error {
 	*v = newVisibility
 	return nil
 }
+
+//
+// StandingsTiebreaker stuff
+//
+
+// StandingsTiebreaker separates members level on wins and losses in the standings. A league applies them
+// in the order of its LeagueFormat.Tiebreakers.
+type StandingsTiebreaker string
+
+const (
+	StandingsTiebreakerHeadToHead         StandingsTiebreaker = "HEAD_TO_HEAD"         // series won against the other tied members
+	StandingsTiebreakerGameDifferential   StandingsTiebreaker = "GAME_DIFFERENTIAL"    // games won minus games lost across all series
+	StandingsTiebreakerStrengthOfSchedule StandingsTiebreaker = "STRENGTH_OF_SCHEDULE" // average win rate of the opponents played
+	StandingsTiebreakerCoinFlip           StandingsTiebreaker = "COIN_FLIP"            // a draw seeded with League.TiebreakSeed
+)
+
+var StandingsTiebreakers = []StandingsTiebreaker{
+	StandingsTiebreakerHeadToHead,
+	StandingsTiebreakerGameDifferential,
+	StandingsTiebreakerStrengthOfSchedule,
+	StandingsTiebreakerCoinFlip,
+}
+
+func (tb StandingsTiebreaker) IsValid() bool {
+	return slices.Contains(StandingsTiebreakers, tb)
+}
+
+func (tb StandingsTiebreaker) Normalize() StandingsTiebreaker {
+	return StandingsTiebreaker(strings.ToUpper(string(tb)))
+}
@@ -0,0 +1,19 @@
+package enums
+
+// ScheduledTaskStatus is where a persisted scheduler task is in its lifecycle.
+type ScheduledTaskStatus string
+
+const (
+	ScheduledTaskStatusPending    ScheduledTaskStatus = "PENDING"     // waiting to run; reloaded on startup
+	ScheduledTaskStatusCompleted  ScheduledTaskStatus = "COMPLETED"   // ran without error
+	ScheduledTaskStatusDeadLetter ScheduledTaskStatus = "DEAD_LETTER" // kept failing after every retry; left for staff to inspect
+	ScheduledTaskStatusCancelled  ScheduledTaskStatus = "CANCELLED"   // deregistered before it was due
+)
+
+func (s ScheduledTaskStatus) IsValid() bool {
+	switch s {
+	case ScheduledTaskStatusPending, ScheduledTaskStatusCompleted, ScheduledTaskStatusDeadLetter, ScheduledTaskStatusCancelled:
+		return true
+	}
+	return false
+}
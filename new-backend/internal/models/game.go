@@ -46,4 +46,26 @@ type Game struct {
 	Player2         *LeagueMember `gorm:"foreignKey:player2_id;references:ID" json:"Player2,omitempty"`
 	Winner          *LeagueMember `gorm:"foreignKey:winner_id;references:ID" json:"Winner,omitempty"`
 	Loser           *LeagueMember `gorm:"foreignKey:loser_id;references:ID" json:"Loser,omitempty"`
+	Reports         []GameReport  `gorm:"foreignKey:GameID;references:ID" json:"Reports,omitempty"` // result history, oldest first
+}
+
+// GameReport is one entry in a game's result history: a player's report, the opponent confirming or
+// disputing it, or a staff ruling. A dispute carrying a result is the opponent's counter-report.
+type GameReport struct {
+	ID       uuid.UUID              `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
+	GameID   uuid.UUID              `gorm:"type:uuid;not null;index;column:game_id" json:"GameID"`
+	MemberID uuid.UUID              `gorm:"type:uuid;not null;column:member_id" json:"MemberID"` // who reported, confirmed, disputed or ruled
+	Action   enums.GameReportAction `gorm:"type:varchar(20);not null;column:action" json:"Action"`
+
+	// the result reported, counter-reported or ruled; nil for a confirmation or a dispute without a result
+	WinnerID    *uuid.UUID `gorm:"type:uuid;column:winner_id" json:"WinnerID"`
+	Player1Wins *int       `gorm:"column:player1_wins" json:"Player1Wins"`
+	Player2Wins *int       `gorm:"column:player2_wins" json:"Player2Wins"`
+	ReplayLinks []string   `gorm:"type:jsonb;serializer:json;column:replay_links" json:"ReplayLinks"`
+	Reason      *string    `gorm:"type:text;column:reason" json:"Reason"` // why it was disputed, or the staff's note on a ruling
+
+	CreatedAt time.Time `gorm:"column:created_at" json:"CreatedAt"`
+
+	// Relationships
+	Member *LeagueMember `gorm:"foreignKey:member_id;references:ID" json:"Member,omitempty"`
 }
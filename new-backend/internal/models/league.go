@@ -35,6 +35,18 @@ type League struct {
 
 	NewPlayerGroupNumber int `gorm:"default:1;column:new_player_group_count" json:"NewPlayerGroupNumber"` // used to assign a group number for new players
 
+	// the same league's previous season, if any; its final standings weight the draft lottery
+	PreviousSeasonLeagueID *uuid.UUID `gorm:"type:uuid;column:previous_season_league_id" json:"PreviousSeasonLeagueID"`
+
+	// set while a draft start is scheduled (DraftService.ScheduleDraftStart); cleared once it fires, is
+	// cancelled, or the draft is started by hand
+	ScheduledDraftStart         *time.Time `gorm:"type:timestamp with time zone;column:scheduled_draft_start" json:"ScheduledDraftStart"`
+	ScheduledDraftTurnTimeLimit int        `gorm:"not null;default:0;column:scheduled_draft_turn_time_limit" json:"ScheduledDraftTurnTimeLimit"` // minutes
+
+	// seeds the coin flip tiebreaker (enums.StandingsTiebreakerCoinFlip), so a tie it broke can be redrawn
+	// and checked; set on creation, or on startup for leagues created before it existed
+	TiebreakSeed *int64 `gorm:"column:tiebreak_seed" json:"TiebreakSeed"`
+
 	// Relationships
 	OwnerUser *User          `gorm:"foreignKey:owner_user_id;references:id" json:"OwnerUser,omitempty"`
 	Members   []LeagueMember `gorm:"foreignKey:league_id" json:"Members,omitempty"`
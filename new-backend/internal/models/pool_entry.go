@@ -11,6 +11,8 @@ import (
 // Each league has its own pool of PoolEntries with league-specific costs.
 // When a player acquires a Pokemon (via draft or free-agent pickup), the
 // corresponding PoolEntry is marked as unavailable.
+// Leagues that split their pool into tiers assign each entry to one; roster
+// limits per tier live in the league's format.
 // (Previously named LeaguePokemon)
 type PoolEntry struct {
 	ID               uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id" json:"ID"`
@@ -18,6 +20,7 @@ type PoolEntry struct {
 	PokemonSpeciesID int64          `gorm:"not null;uniqueIndex:idx_pool_entry_species;column:pokemon_species_id" json:"PokemonSpeciesID"`
 	Cost             *int           `gorm:"not null;column:cost" json:"Cost"`
 	IsAvailable      bool           `gorm:"not null;default:true;column:is_available" json:"IsAvailable"`
+	Tier             *string        `gorm:"type:varchar(50);column:tier" json:"Tier"` // name of one of the league's LeagueFormat.Tiers; nil if untiered
 	CreatedAt        time.Time      `json:"CreatedAt" gorm:"column:created_at"`
 	UpdatedAt        time.Time      `json:"UpdatedAt" gorm:"column:updated_at"`
 	DeletedAt        gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
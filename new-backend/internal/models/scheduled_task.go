@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+)
+
+// ScheduledTask is the stored form of a scheduler task (utils.ScheduledTask). Every registered task is
+// written here so the scheduler can reload whatever is still PENDING after a restart. A task that fails
+// is retried with backoff; once it runs out of attempts it is kept as DEAD_LETTER with its last error,
+// under an ID of its own so a later task registered under the same task ID doesn't overwrite it.
+type ScheduledTask struct {
+	ID          string                    `gorm:"type:varchar(255);primaryKey;column:id" json:"ID"`     // the task ID, or a unique ID for a dead letter
+	TaskID      string                    `gorm:"type:varchar(255);index;column:task_id" json:"TaskID"` // same as utils.ScheduledTask.ID
+	Type        string                    `gorm:"type:varchar(50);not null;column:type" json:"Type"`    // utils.TaskType.String()
+	LeagueID    uuid.UUID                 `gorm:"type:uuid;index;column:league_id" json:"LeagueID"`     // the league the task acts on
+	Payload     string                    `gorm:"type:jsonb;not null;column:payload" json:"Payload"`
+	ExecuteAt   time.Time                 `gorm:"type:timestamp with time zone;not null;index;column:execute_at" json:"ExecuteAt"`
+	Status      enums.ScheduledTaskStatus `gorm:"type:varchar(20);not null;default:'PENDING';index;column:status" json:"Status"`
+	Attempts    int                       `gorm:"default:0;not null;column:attempts" json:"Attempts"` // failed runs so far
+	LastError   *string                   `gorm:"type:text;column:last_error" json:"LastError"`
+	CompletedAt *time.Time                `gorm:"type:timestamp with time zone;column:completed_at" json:"CompletedAt"` // when it ran, was cancelled or was dead-lettered
+	CreatedAt   time.Time                 `gorm:"column:created_at" json:"CreatedAt"`
+	UpdatedAt   time.Time                 `gorm:"column:updated_at" json:"UpdatedAt"`
+}
+
+// SchedulerTaskID is the utils.ScheduledTask.ID of the task the record is for. Records written before
+// TaskID existed only have it as their ID.
+func (t *ScheduledTask) SchedulerTaskID() string {
+	if t.TaskID != "" {
+		return t.TaskID
+	}
+	return t.ID
+}
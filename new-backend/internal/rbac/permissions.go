@@ -52,6 +52,10 @@ const (
 	PermissionStartTransferPeriod Permission = "start:transfer_period"
 	PermissionEndTransferPeriod   Permission = "end:transfer_period"
 
+	// Scheduled Task Permissions
+	PermissionReadScheduledTask   Permission = "read:scheduled_task"
+	PermissionUpdateScheduledTask Permission = "update:scheduled_task"
+
 	// LeaguePokemon Permissions
 	PermissionCreateLeaguePokemon Permission = "create:league_pokemon"
 	PermissionReadLeaguePokemon   Permission = "read:league_pokemon"
@@ -136,6 +140,8 @@ func init() {
 		PermissionStartTransferPeriod,
 		PermissionEndTransferPeriod,
 		PermissionFinalizeGame,
+		PermissionReadScheduledTask,
+		PermissionUpdateScheduledTask,
 
 		PermissionCreatePoolEntry,
 		PermissionUpdatePoolEntry,
@@ -13,6 +13,7 @@ type ClaimRepository interface {
 	GetByID(id uuid.UUID) (*models.Claim, error)
 	GetActiveByPlayerAndSpecies(playerID uuid.UUID, speciesID int64) (*models.Claim, error)
 	GetActiveByPlayer(playerID uuid.UUID) ([]models.Claim, error)
+	GetHeldByPlayerInWeek(playerID uuid.UUID, week int) ([]models.Claim, error)
 	GetActiveByLeague(leagueID uuid.UUID) ([]models.Claim, error)
 	GetReleasedByLeague(leagueID uuid.UUID) ([]models.Claim, error)
 	GetActiveCountByPlayer(playerID uuid.UUID) (int64, error)
@@ -76,6 +77,20 @@ func (r *claimRepositoryImpl) GetActiveByPlayer(playerID uuid.UUID) ([]models.Cl
 	return claims, nil
 }
 
+// GetHeldByPlayerInWeek returns the claims playerID held during week, released since or not: acquired
+// by then, and not released by then.
+func (r *claimRepositoryImpl) GetHeldByPlayerInWeek(playerID uuid.UUID, week int) ([]models.Claim, error) {
+	var claims []models.Claim
+	err := r.db.Preload("PokemonSpecies").
+		Where("player_id = ? AND acquired_week <= ? AND (released_week IS NULL OR released_week > ?)", playerID, week, week).
+		Order("created_at ASC").
+		Find(&claims).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: ClaimRepo.GetHeldByPlayerInWeek) - failed: %w", err)
+	}
+	return claims, nil
+}
+
 func (r *claimRepositoryImpl) GetActiveByLeague(leagueID uuid.UUID) ([]models.Claim, error) {
 	var claims []models.Claim
 	err := r.db.Preload("Player").
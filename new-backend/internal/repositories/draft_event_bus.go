@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+const (
+	// DraftEventIDSequence hands out draft event IDs to every instance. Created at startup (cmd/main.go).
+	DraftEventIDSequence = "draft_event_id_seq"
+	// draftEventChannel is the LISTEN/NOTIFY channel draft events travel on.
+	draftEventChannel = "draft_events"
+	// draftEventPublishLockKey serialises publishers so events are notified in ID order.
+	draftEventPublishLockKey int64 = 0x4556454e54 // "EVENT"
+	// MaxDraftEventSize is the largest encoded event NOTIFY accepts (its payload limit is 8000 bytes).
+	MaxDraftEventSize = 7999
+
+	draftEventRelistenDelay = 5 * time.Second
+)
+
+// DraftEventBus carries draft events between backend instances, so a client streaming a league's events
+// from any instance sees the events every instance publishes, under IDs they all agree on.
+type DraftEventBus interface {
+	// takes the next event ID from the shared sequence, encodes the event under it and sends it to every
+	// listening instance, this one included. Events are sent in ID order.
+	Publish(encode func(id uint64) ([]byte, error)) (uint64, error)
+	// passes every event published on any instance to handle, until ctx is done. Whenever listening
+	// (re)starts, onListen gets the last ID handed out by then: events published while not listening are
+	// lost, so anything before that ID can't be trusted to be complete.
+	Listen(ctx context.Context, onListen func(lastID uint64), handle func(payload []byte))
+}
+
+// postgresDraftEventBus is Postgres LISTEN/NOTIFY. Listening holds one connection set aside from the
+// pool for as long as the instance runs.
+type postgresDraftEventBus struct {
+	db *gorm.DB
+}
+
+func NewPostgresDraftEventBus(db *gorm.DB) DraftEventBus {
+	return &postgresDraftEventBus{db: db}
+}
+
+// Publish draws the ID and notifies in one transaction under an advisory lock. Notifications go out when
+// their transaction commits, so without the lock two publishers could commit out of ID order.
+func (b *postgresDraftEventBus) Publish(encode func(id uint64) ([]byte, error)) (uint64, error) {
+	var id uint64
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", draftEventPublishLockKey).Error; err != nil {
+			return err
+		}
+		if err := tx.Raw("SELECT nextval(?)", DraftEventIDSequence).Scan(&id).Error; err != nil {
+			return err
+		}
+		payload, err := encode(id)
+		if err != nil {
+			return err
+		}
+		return tx.Exec("SELECT pg_notify(?, ?)", draftEventChannel, string(payload)).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("(Error: DraftEventBus.Publish) - failed to publish draft event: %w", err)
+	}
+	return id, nil
+}
+
+func (b *postgresDraftEventBus) Listen(ctx context.Context, onListen func(lastID uint64), handle func(payload []byte)) {
+	for {
+		err := b.listen(ctx, onListen, handle)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("ERROR: (DraftEventBus: Listen) - stopped listening for draft events: %v. Retrying in %s\n", err, draftEventRelistenDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(draftEventRelistenDelay):
+		}
+	}
+}
+
+// listen runs one LISTEN session until it fails or ctx is done.
+func (b *postgresDraftEventBus) listen(ctx context.Context, onListen func(lastID uint64), handle func(payload []byte)) error {
+	sqlDB, err := b.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get connection pool: %w", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a connection: %w", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		// the session stays subscribed to the channel; ErrBadConn keeps it from going back to the pool
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.Join(fmt.Errorf("unexpected driver connection %T", driverConn), driver.ErrBadConn)
+		}
+		pgConn := stdlibConn.Conn()
+		if _, err := pgConn.Exec(ctx, "LISTEN "+draftEventChannel); err != nil {
+			return errors.Join(err, driver.ErrBadConn)
+		}
+		// read after LISTEN, so every event after this ID reaches handle
+		var lastID int64
+		if err := pgConn.QueryRow(ctx, "SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM "+DraftEventIDSequence).Scan(&lastID); err != nil {
+			return errors.Join(err, driver.ErrBadConn)
+		}
+		onListen(uint64(lastID))
+
+		for {
+			notification, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				return errors.Join(err, driver.ErrBadConn)
+			}
+			handle([]byte(notification.Payload))
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -15,6 +16,8 @@ type DraftPickRepository interface {
 	GetByDraft(draftID uuid.UUID) ([]models.DraftPick, error)
 	GetByPlayer(playerID uuid.UUID) ([]models.DraftPick, error)
 	GetCountByDraft(draftID uuid.UUID) (int64, error)
+	GetLatestByDraft(draftID uuid.UUID) (*models.DraftPick, error)
+	UndoPick(pick *models.DraftPick, draft *models.Draft, league *models.League) (int, error)
 }
 
 type draftPickRepositoryImpl struct {
@@ -49,6 +52,7 @@ func (r *draftPickRepositoryImpl) GetByID(id uuid.UUID) (*models.DraftPick, erro
 		Preload("Player.User").
 		Preload("PoolEntry").
 		Preload("PoolEntry.PokemonSpecies").
+		Preload("OriginalOwner").
 		First(&pick, "id = ?", id).Error
 	if err != nil {
 		return nil, fmt.Errorf("(Error: DraftPickRepo.GetByID) - failed to get draft pick: %w", err)
@@ -62,6 +66,7 @@ func (r *draftPickRepositoryImpl) GetByDraft(draftID uuid.UUID) ([]models.DraftP
 		Preload("Player.User").
 		Preload("PoolEntry").
 		Preload("PoolEntry.PokemonSpecies").
+		Preload("OriginalOwner").
 		Where("draft_id = ?", draftID).
 		Order("pick_number ASC").
 		Find(&picks).Error
@@ -76,6 +81,7 @@ func (r *draftPickRepositoryImpl) GetByPlayer(playerID uuid.UUID) ([]models.Draf
 	err := r.db.Preload("Draft").
 		Preload("PoolEntry").
 		Preload("PoolEntry.PokemonSpecies").
+		Preload("OriginalOwner").
 		Where("player_id = ?", playerID).
 		Order("pick_number ASC").
 		Find(&picks).Error
@@ -95,3 +101,82 @@ func (r *draftPickRepositoryImpl) GetCountByDraft(draftID uuid.UUID) (int64, err
 	}
 	return count, nil
 }
+
+// GetLatestByDraft returns the most recently made pick in a draft.
+// Picks made in the same batch share a timestamp, so ties go to the higher pick number.
+func (r *draftPickRepositoryImpl) GetLatestByDraft(draftID uuid.UUID) (*models.DraftPick, error) {
+	var pick models.DraftPick
+	err := r.db.Where("draft_id = ?", draftID).
+		Order("created_at DESC").
+		Order("pick_number DESC").
+		First(&pick).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftPickRepo.GetLatestByDraft) - failed to get latest draft pick: %w", err)
+	}
+	return &pick, nil
+}
+
+// UndoPick reverts a draft pick within a single transaction. It deletes the DraftPick,
+// deactivates the Claim it created, refunds the cost paid to the member, makes the
+// PoolEntry available again and saves the already rewound draft (and league) state.
+// Returns the number of draft points refunded.
+func (r *draftPickRepositoryImpl) UndoPick(pick *models.DraftPick, draft *models.Draft, league *models.League) (int, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to begin transaction: %w", tx.Error)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p) // re-throw panic after Rollback
+		}
+	}()
+
+	var claim models.Claim
+	if err := tx.Where("source = ? AND source_id = ? AND is_active = ?", enums.ClaimSourceDraft, pick.ID, true).
+		First(&claim).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to find claim for pick %s: %w", pick.ID, err)
+	}
+
+	releasedWeek := 0 // pre-season draft week
+	if err := tx.Model(&claim).Updates(map[string]any{
+		"is_active":     false,
+		"released_week": releasedWeek,
+	}).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to deactivate claim: %w", err)
+	}
+
+	if err := tx.Model(&models.LeagueMember{}).Where("id = ?", pick.PlayerID).
+		Update("draft_points", gorm.Expr("draft_points + ?", claim.CostPaid)).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to refund draft points: %w", err)
+	}
+
+	if err := tx.Model(&models.PoolEntry{}).Where("id = ?", pick.PoolEntryID).
+		Update("is_available", true).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to update pool entry: %w", err)
+	}
+
+	if err := tx.Delete(&models.DraftPick{}, "id = ?", pick.ID).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to delete draft pick: %w", err)
+	}
+
+	if err := tx.Save(draft).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to save draft: %w", err)
+	}
+
+	if err := tx.Model(league).Update("status", league.Status).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to update league status: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, fmt.Errorf("(Error: DraftPickRepo.UndoPick) - failed to commit: %w", err)
+	}
+	return claim.CostPaid, nil
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DraftQueueRepository interface {
+	GetByPlayer(playerID uuid.UUID) ([]models.DraftQueueEntry, error)
+	ReplaceForPlayer(playerID uuid.UUID, entries []models.DraftQueueEntry) error
+	DeleteByPlayerAndPoolEntry(playerID, poolEntryID uuid.UUID) error
+}
+
+type draftQueueRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDraftQueueRepository(db *gorm.DB) DraftQueueRepository {
+	return &draftQueueRepositoryImpl{db: db}
+}
+
+// GetByPlayer returns a member's queue ordered from highest (rank 1) to lowest priority.
+func (r *draftQueueRepositoryImpl) GetByPlayer(playerID uuid.UUID) ([]models.DraftQueueEntry, error) {
+	var entries []models.DraftQueueEntry
+	err := r.db.Preload("PoolEntry").
+		Preload("PoolEntry.PokemonSpecies").
+		Where("player_id = ?", playerID).
+		Order("rank ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftQueueRepo.GetByPlayer) - failed to get draft queue: %w", err)
+	}
+	return entries, nil
+}
+
+// ReplaceForPlayer overwrites a member's whole queue in a single transaction.
+func (r *draftQueueRepositoryImpl) ReplaceForPlayer(playerID uuid.UUID, entries []models.DraftQueueEntry) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("player_id = ?", playerID).Delete(&models.DraftQueueEntry{}).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(entries, 100).Error
+	})
+	if err != nil {
+		return fmt.Errorf("(Error: DraftQueueRepo.ReplaceForPlayer) - failed to replace draft queue: %w", err)
+	}
+	return nil
+}
+
+func (r *draftQueueRepositoryImpl) DeleteByPlayerAndPoolEntry(playerID, poolEntryID uuid.UUID) error {
+	err := r.db.Where("player_id = ? AND pool_entry_id = ?", playerID, poolEntryID).
+		Delete(&models.DraftQueueEntry{}).Error
+	if err != nil {
+		return fmt.Errorf("(Error: DraftQueueRepo.DeleteByPlayerAndPoolEntry) - failed to delete draft queue entry: %w", err)
+	}
+	return nil
+}
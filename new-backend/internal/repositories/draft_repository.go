@@ -1,11 +1,14 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
 )
 
 // TODO: prolly needs more safe db transactions
@@ -22,6 +25,21 @@ type DraftRepository interface {
 	UpdateDraft(draft *models.Draft) (*models.Draft, error)
 	// retrieves all drafts with a specific status.
 	GetAllDraftsByStatus(status enums.DraftStatus) ([]models.Draft, error)
+
+	// The auction methods below each change the draft row in one conditional UPDATE, and return
+	// types.ErrConflict if its condition no longer held: another instance got there first.
+
+	// opens a lot, unless one is already open or nominatorID is no longer on the clock.
+	OpenAuctionLot(draftID, nominatorID, poolEntryID uuid.UUID, openingBid int, lotEndsAt time.Time) error
+	// raises the open lot's high bid, unless the lot has closed, moved on or been outbid at amount or more.
+	RaiseAuctionBid(draftID, poolEntryID, bidderID uuid.UUID, amount int, now, lotEndsAt time.Time) error
+	// takes a lot whose countdown ran out off the clock, along with its nominator, so it can be closed;
+	// unless it has been bid on or closed since. A lot already taken off the clock can be taken again,
+	// so a close that failed halfway can be retried.
+	TakeAuctionLotForClose(draftID, poolEntryID, highBidderID uuid.UUID, highBid int, now time.Time) error
+	// takes memberID's nomination turn off the clock so it can pass on, counting the pass (Draft.AuctionPasses),
+	// unless a lot is open or the turn has moved on.
+	TakeAuctionNominationForPass(draftID, memberID uuid.UUID) error
 }
 
 type draftRepositoryImpl struct {
@@ -72,3 +90,54 @@ func (r *draftRepositoryImpl) GetAllDraftsByStatus(status enums.DraftStatus) ([]
 	}
 	return drafts, nil
 }
+
+// opens a lot, unless one is already open or nominatorID is no longer on the clock.
+func (r *draftRepositoryImpl) OpenAuctionLot(draftID, nominatorID, poolEntryID uuid.UUID, openingBid int, lotEndsAt time.Time) error {
+	return r.updateIf(draftID, map[string]interface{}{
+		"auction_pool_entry_id":  poolEntryID,
+		"auction_high_bid":       openingBid,
+		"auction_high_bidder_id": nominatorID,
+		"auction_lot_ends_at":    lotEndsAt,
+		"auction_passes":         0,
+	}, "status = ? AND auction_pool_entry_id IS NULL AND current_turn_player_id = ?", enums.DraftStatusOngoing, nominatorID)
+}
+
+// raises the open lot's high bid, unless the lot has closed, moved on or been outbid at amount or more.
+func (r *draftRepositoryImpl) RaiseAuctionBid(draftID, poolEntryID, bidderID uuid.UUID, amount int, now, lotEndsAt time.Time) error {
+	return r.updateIf(draftID, map[string]interface{}{
+		"auction_high_bid":       amount,
+		"auction_high_bidder_id": bidderID,
+		"auction_lot_ends_at":    lotEndsAt,
+	}, "status = ? AND auction_pool_entry_id = ? AND auction_high_bid < ? AND auction_lot_ends_at > ?",
+		enums.DraftStatusOngoing, poolEntryID, amount, now)
+}
+
+// takes a lot whose countdown ran out off the clock, along with its nominator, so it can be closed.
+func (r *draftRepositoryImpl) TakeAuctionLotForClose(draftID, poolEntryID, highBidderID uuid.UUID, highBid int, now time.Time) error {
+	return r.updateIf(draftID, map[string]interface{}{
+		"auction_lot_ends_at":    nil,
+		"current_turn_player_id": nil,
+	}, "status = ? AND auction_pool_entry_id = ? AND auction_high_bidder_id = ? AND auction_high_bid = ? AND (auction_lot_ends_at IS NULL OR auction_lot_ends_at <= ?)",
+		enums.DraftStatusOngoing, poolEntryID, highBidderID, highBid, now)
+}
+
+// takes memberID's nomination turn off the clock so it can pass on, counting the pass.
+func (r *draftRepositoryImpl) TakeAuctionNominationForPass(draftID, memberID uuid.UUID) error {
+	return r.updateIf(draftID, map[string]interface{}{
+		"current_turn_player_id": nil,
+		"auction_passes":         gorm.Expr("auction_passes + 1"),
+	}, "status = ? AND auction_pool_entry_id IS NULL AND current_turn_player_id = ?", enums.DraftStatusOngoing, memberID)
+}
+
+// updateIf applies updates to the draft only if it still matches the condition, and returns
+// types.ErrConflict if it didn't.
+func (r *draftRepositoryImpl) updateIf(draftID uuid.UUID, updates map[string]interface{}, condition string, args ...interface{}) error {
+	result := r.db.Model(&models.Draft{}).Where("id = ?", draftID).Where(condition, args...).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return types.ErrConflict
+	}
+	return nil
+}
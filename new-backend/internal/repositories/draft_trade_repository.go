@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DraftTradeRepository interface {
+	CreateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error)
+	GetTradeByID(id uuid.UUID) (*models.DraftPickTrade, error)
+	GetTradesByLeague(leagueID uuid.UUID) ([]models.DraftPickTrade, error)
+	UpdateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error)
+	GetSlot(originalOwnerID uuid.UUID, roundNumber int) (*models.DraftPickSlot, error)
+	GetSlotsByLeague(leagueID uuid.UUID) ([]models.DraftPickSlot, error)
+	ApplyTrade(trade *models.DraftPickTrade, slots []models.DraftPickSlot, proposerPointsDelta, recipientPointsDelta int) error
+}
+
+type draftTradeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDraftTradeRepository(db *gorm.DB) DraftTradeRepository {
+	return &draftTradeRepositoryImpl{db: db}
+}
+
+func (r *draftTradeRepositoryImpl) CreateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error) {
+	if err := r.db.Create(trade).Error; err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.CreateTrade) - failed to create draft pick trade: %w", err)
+	}
+	return trade, nil
+}
+
+func (r *draftTradeRepositoryImpl) GetTradeByID(id uuid.UUID) (*models.DraftPickTrade, error) {
+	var trade models.DraftPickTrade
+	err := r.db.Preload("Proposer").
+		Preload("Recipient").
+		First(&trade, "id = ?", id).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.GetTradeByID) - failed to get draft pick trade: %w", err)
+	}
+	return &trade, nil
+}
+
+func (r *draftTradeRepositoryImpl) GetTradesByLeague(leagueID uuid.UUID) ([]models.DraftPickTrade, error) {
+	var trades []models.DraftPickTrade
+	err := r.db.Preload("Proposer").
+		Preload("Recipient").
+		Where("league_id = ?", leagueID).
+		Order("created_at DESC").
+		Find(&trades).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.GetTradesByLeague) - failed to get draft pick trades: %w", err)
+	}
+	return trades, nil
+}
+
+func (r *draftTradeRepositoryImpl) UpdateTrade(trade *models.DraftPickTrade) (*models.DraftPickTrade, error) {
+	if err := r.db.Save(trade).Error; err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.UpdateTrade) - failed to update draft pick trade: %w", err)
+	}
+	return trade, nil
+}
+
+// GetSlot returns the ownership record of a traded slot. gorm.ErrRecordNotFound means the slot
+// was never traded and still belongs to its original owner.
+func (r *draftTradeRepositoryImpl) GetSlot(originalOwnerID uuid.UUID, roundNumber int) (*models.DraftPickSlot, error) {
+	var slot models.DraftPickSlot
+	err := r.db.Where("original_owner_id = ? AND round_number = ?", originalOwnerID, roundNumber).
+		First(&slot).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.GetSlot) - failed to get draft pick slot: %w", err)
+	}
+	return &slot, nil
+}
+
+func (r *draftTradeRepositoryImpl) GetSlotsByLeague(leagueID uuid.UUID) ([]models.DraftPickSlot, error) {
+	var slots []models.DraftPickSlot
+	err := r.db.Preload("OriginalOwner").
+		Preload("Owner").
+		Where("league_id = ?", leagueID).
+		Order("round_number ASC").
+		Find(&slots).Error
+	if err != nil {
+		return nil, fmt.Errorf("(Error: DraftTradeRepo.GetSlotsByLeague) - failed to get draft pick slots: %w", err)
+	}
+	return slots, nil
+}
+
+// ApplyTrade moves slot ownership and draft points for a trade and saves the trade's new status,
+// all within a single transaction. Slots are upserted on (original owner, round). A member whose points
+// would go negative fails the whole trade with types.ErrInsufficientDraftPoints.
+func (r *draftTradeRepositoryImpl) ApplyTrade(
+	trade *models.DraftPickTrade,
+	slots []models.DraftPickSlot,
+	proposerPointsDelta int,
+	recipientPointsDelta int,
+) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - failed to begin transaction: %w", tx.Error)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p) // re-throw panic after Rollback
+		}
+	}()
+
+	if len(slots) > 0 {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "original_owner_id"}, {Name: "round_number"}},
+			DoUpdates: clause.AssignmentColumns([]string{"owner_id", "updated_at"}),
+		}).Create(&slots).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - failed to save draft pick slots: %w", err)
+		}
+	}
+
+	pointUpdates := map[uuid.UUID]int{trade.ProposerID: proposerPointsDelta, trade.RecipientID: recipientPointsDelta}
+	for memberID, delta := range pointUpdates {
+		if delta == 0 {
+			continue
+		}
+		result := tx.Model(&models.LeagueMember{}).Where("id = ? AND draft_points + ? >= 0", memberID, delta).
+			Update("draft_points", gorm.Expr("draft_points + ?", delta))
+		if result.Error != nil {
+			tx.Rollback()
+			return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - failed to update draft points for member %s: %w", memberID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - member %s: %w", memberID, types.ErrInsufficientDraftPoints)
+		}
+	}
+
+	if err := tx.Save(trade).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - failed to save draft pick trade: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("(Error: DraftTradeRepo.ApplyTrade) - failed to commit: %w", err)
+	}
+	return nil
+}
@@ -2,12 +2,15 @@ package repositories
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GameRepository interface {
@@ -21,8 +24,10 @@ type GameRepository interface {
 	GetGamesByPlayer(playerID uuid.UUID) ([]models.Game, error)
 	// gets games by round number (regular season) in a league
 	GetGamesByLeagueAndRound(leagueID uuid.UUID, roundNumber int) ([]models.Game, error)
-	// marks a game as disputed
-	DisputeGame(gameID uuid.UUID, reporterID uuid.UUID) error
+	// marks a game as disputed, recording the dispute in its history
+	DisputeGame(dispute *models.GameReport) error
+	// adds an entry to a game's result history
+	AddGameReport(report *models.GameReport) error
 	// gets head-to-head record between two players
 	GetHeadToHeadRecord(player1ID, player2ID uuid.UUID) ([]models.Game, error)
 	// gets player's win-loss record in a specific league
@@ -42,9 +47,28 @@ type GameRepository interface {
 	// checks if games of a specific type exist for a given league.
 	HasGames(leagueID uuid.UUID, gameType enums.GameType) (bool, error)
 
-	UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO) error
+	// records a reported result, awaiting approval, along with the stats its battle logs gave, if any
+	UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO, stats []models.ClaimGameStat) error
+	// gets the claim stats of a league's completed games
+	GetClaimGameStatsByLeague(leagueID uuid.UUID) ([]models.ClaimGameStat, error)
+	// completes a game and updates both players' records; report is the history entry that finalized it.
+	// types.ErrConflict if the game's status or result changed since game was read
+	FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport) error
+	// finalizes a playoff game as FinalizeGameAndUpdateStats does, then hands its league and playoff games
+	// to replay and saves the BracketUpdate it returns (if any), all in one transaction under the league's
+	// row lock: concurrent finalizations in one bracket run one after the other, and none is left unreplayed
+	FinalizeGameAndUpdateBracket(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport,
+		replay func(league *models.League, games []models.Game) (*BracketUpdate, error)) error
+}
 
-	FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error
+// BracketUpdate is what replaying a league's playoff bracket changed.
+type BracketUpdate struct {
+	Games   []*models.Game // saved, or created
+	Voided  []models.Game  // completed games whose results come off their players' records, and whose claim stats are dropped
+	Removed []uuid.UUID    // soft deleted
+	// the league moves to LeagueStatus with EndDate, unless LeagueStatus is empty
+	LeagueStatus enums.LeagueStatus
+	EndDate      *time.Time
 }
 
 type gameRepositoryImpl struct {
@@ -70,12 +94,14 @@ func (r *gameRepositoryImpl) CreateGame(game *models.Game) (*models.Game, error)
 func (r *gameRepositoryImpl) GetGameByID(id uuid.UUID) (models.Game, error) {
 	var game models.Game
 	err := r.db.
-		Preload("Player1").
-		Preload("Player2").
+		Preload("Player1.User").
+		Preload("Player2.User").
 		Preload("Winner").
 		Preload("Loser").
 		Preload("ReportingPlayer").
 		Preload("ApproverPlayer").
+		Preload("Reports", orderReports).
+		Preload("Reports.Member").
 		First(&game, "id = ?", id).Error
 	if err != nil {
 		return game, fmt.Errorf("(Error: GetGameByID) - failed to get game: %w", err)
@@ -180,6 +206,8 @@ func (r *gameRepositoryImpl) GetDisputedGamesByLeague(leagueID uuid.UUID) ([]mod
 	err := r.db.Preload("Player1").
 		Preload("Player2").
 		Preload("ReportingPlayer").
+		Preload("Reports", orderReports).
+		Preload("Reports.Member").
 		Where("league_id = ? AND status = ?", leagueID, enums.GameStatusDisputed).
 		Order("updated_at DESC").
 		Find(&games).Error
@@ -201,22 +229,35 @@ func (r *gameRepositoryImpl) HasGames(leagueID uuid.UUID, gameType enums.GameTyp
 	return count > 0, nil
 }
 
-// marks a game as disputed
-func (r *gameRepositoryImpl) DisputeGame(gameID uuid.UUID, reporterID uuid.UUID) error {
-	updates := map[string]any{
-		"status":              enums.GameStatusDisputed,
-		"reporting_player_id": reporterID,
-	}
+// marks a game as disputed, recording the dispute in its history
+func (r *gameRepositoryImpl) DisputeGame(dispute *models.GameReport) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Game{}).
+			Where("id = ?", dispute.GameID).
+			Update("status", enums.GameStatusDisputed).Error
+		if err != nil {
+			return fmt.Errorf("(Repository: DisputeGame) - failed to dispute game: %w", err)
+		}
+		if err := tx.Create(dispute).Error; err != nil {
+			return fmt.Errorf("(Repository: DisputeGame) - failed to record dispute: %w", err)
+		}
+		return nil
+	})
+}
 
-	err := r.db.Model(&models.Game{}).
-		Where("id = ?", gameID).
-		Updates(updates).Error
-	if err != nil {
-		return fmt.Errorf("(Repository: DisputeGame) - failed to dispute game: %w", err)
+// adds an entry to a game's result history
+func (r *gameRepositoryImpl) AddGameReport(report *models.GameReport) error {
+	if err := r.db.Create(report).Error; err != nil {
+		return fmt.Errorf("(Repository: AddGameReport) - failed to add game report: %w", err)
 	}
 	return nil
 }
 
+// orderReports preloads a game's history oldest first.
+func orderReports(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at ASC")
+}
+
 // gets head-to-head record between two players
 func (r *gameRepositoryImpl) GetHeadToHeadRecord(player1ID, player2ID uuid.UUID) ([]models.Game, error) {
 	var games []models.Game
@@ -288,7 +329,7 @@ func (r *gameRepositoryImpl) DeleteGame(gameID uuid.UUID) error {
 	return nil
 }
 
-func (r *gameRepositoryImpl) UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO) error {
+func (r *gameRepositoryImpl) UpdateGameReport(gameID uuid.UUID, loserID uuid.UUID, dto *requests.ReportGameRequestDTO, stats []models.ClaimGameStat) error {
 	updates := map[string]any{
 		"winner_id":             dto.WinnerID,
 		"loser_id":              loserID,
@@ -300,15 +341,53 @@ func (r *gameRepositoryImpl) UpdateGameReport(gameID uuid.UUID, loserID uuid.UUI
 		"approver_id":           nil, // Clear approver when a new report comes in
 	}
 
-	err := r.db.Model(&models.Game{}).Where("id = ?", gameID).Updates(updates).Error
+	winnerID := dto.WinnerID
+	report := &models.GameReport{
+		GameID:      gameID,
+		MemberID:    dto.ReporterID,
+		Action:      enums.GameReportActionReport,
+		WinnerID:    &winnerID,
+		Player1Wins: dto.Player1Wins,
+		Player2Wins: dto.Player2Wins,
+		ReplayLinks: dto.ReplayLinks,
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Game{}).Where("id = ?", gameID).Updates(updates).Error
+		if err != nil {
+			return fmt.Errorf("(Repository: UpdateGameReport) - failed to update game with report: %w", err)
+		}
+		if err := tx.Create(report).Error; err != nil {
+			return fmt.Errorf("(Repository: UpdateGameReport) - failed to record report: %w", err)
+		}
+		if len(stats) > 0 {
+			if err := tx.Where("game_id = ?", gameID).Delete(&models.ClaimGameStat{}).Error; err != nil {
+				return fmt.Errorf("(Repository: UpdateGameReport) - failed to clear old claim stats: %w", err)
+			}
+			if err := tx.Create(&stats).Error; err != nil {
+				return fmt.Errorf("(Repository: UpdateGameReport) - failed to record claim stats: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// gets the claim stats of a league's completed games
+func (r *gameRepositoryImpl) GetClaimGameStatsByLeague(leagueID uuid.UUID) ([]models.ClaimGameStat, error) {
+	var stats []models.ClaimGameStat
+	err := r.db.Preload("Claim.PokemonSpecies").
+		Preload("Claim.Player").
+		Joins("JOIN games ON games.id = claim_game_stats.game_id AND games.deleted_at IS NULL").
+		Where("claim_game_stats.league_id = ? AND games.status = ?", leagueID, enums.GameStatusCompleted).
+		Find(&stats).Error
 	if err != nil {
-		return fmt.Errorf("(Repository: UpdateGameReport) - failed to update game with report: %w", err)
+		return nil, fmt.Errorf("(Error: GetClaimGameStatsByLeague) - failed to get claim stats: %w", err)
 	}
-	return nil
+	return stats, nil
 }
 
 // FinalizeGameAndUpdateStats handles the entire process of finalizing a game within a single transaction.
-func (r *gameRepositoryImpl) FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error {
+func (r *gameRepositoryImpl) FinalizeGameAndUpdateStats(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport) error {
 	tx := r.db.Begin()
 	if tx.Error != nil {
 		return fmt.Errorf("(Repository: FinalizeGameAndUpdateStats) - failed to begin transaction: %w", tx.Error)
@@ -320,31 +399,44 @@ func (r *gameRepositoryImpl) FinalizeGameAndUpdateStats(game *models.Game, loser
 		}
 	}()
 
+	if err := r.applyFinalization(tx, game, loserID, dto, report); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// applyFinalization completes game with the result in dto within a transaction, moves both players'
+// records from the game's old result (if any) to the new one, and records report.
+func (r *gameRepositoryImpl) applyFinalization(tx *gorm.DB, game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport) error {
+	// Update the game record with the final results
+	if err := r.finalizeGame(tx, game, loserID, dto); err != nil {
+		return fmt.Errorf("applyFinalization: failed to finalize game %s: %w", game.ID, err)
+	}
+
 	// If game was already completed, revert old player stats
 	if game.Status == enums.GameStatusCompleted && game.WinnerID != nil && game.LoserID != nil {
 		if err := r.decrementPlayerStats(tx, *game.WinnerID, *game.LoserID); err != nil {
-			tx.Rollback()
-			return fmt.Errorf("FinalizeGameAndUpdateStats: failed to decrement old player stats for game %s: %w", game.ID, err)
+			return fmt.Errorf("applyFinalization: failed to decrement old player stats for game %s: %w", game.ID, err)
 		}
 	}
 
-	// Update the game record with the final results
-	if err := r.finalizeGame(tx, game.ID, loserID, dto); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("FinalizeGameAndUpdateStats: failed to finalize game %s: %w", game.ID, err)
-	}
-
 	// Apply new player stats
 	if err := r.incrementPlayerStats(tx, dto.WinnerID, loserID); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("FinalizeGameAndUpdateStats: failed to increment new player stats for game %s: %w", game.ID, err)
+		return fmt.Errorf("applyFinalization: failed to increment new player stats for game %s: %w", game.ID, err)
 	}
 
-	return tx.Commit().Error
+	if err := tx.Create(report).Error; err != nil {
+		return fmt.Errorf("applyFinalization: failed to record the history of game %s: %w", game.ID, err)
+	}
+	return nil
 }
 
-// finalizeGame is a private helper to update the game record within a transaction.
-func (r *gameRepositoryImpl) finalizeGame(tx *gorm.DB, gameID uuid.UUID, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error {
+// finalizeGame is a private helper to update the game record within a transaction. The update only
+// applies while the game still has the status (and, once completed, the result) game was read with:
+// the player stats reverted around it come from that read. Otherwise it returns types.ErrConflict.
+func (r *gameRepositoryImpl) finalizeGame(tx *gorm.DB, game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error {
 	updates := map[string]any{
 		"winner_id":             dto.WinnerID,
 		"loser_id":              loserID,
@@ -355,9 +447,16 @@ func (r *gameRepositoryImpl) finalizeGame(tx *gorm.DB, gameID uuid.UUID, loserID
 		"status":                enums.GameStatusCompleted,
 	}
 
-	err := tx.Model(&models.Game{}).Where("id = ?", gameID).Updates(updates).Error
-	if err != nil {
-		return fmt.Errorf("(Repository: finalizeGame) - failed to finalize game: %w", err)
+	query := tx.Model(&models.Game{}).Where("id = ? AND status = ?", game.ID, game.Status)
+	if game.Status == enums.GameStatusCompleted && game.WinnerID != nil && game.LoserID != nil {
+		query = query.Where("winner_id = ? AND loser_id = ?", *game.WinnerID, *game.LoserID)
+	}
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("(Repository: finalizeGame) - failed to finalize game: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("(Repository: finalizeGame) - game %s was changed by someone else: %w", game.ID, types.ErrConflict)
 	}
 	return nil
 }
@@ -383,3 +482,73 @@ func (r *gameRepositoryImpl) decrementPlayerStats(tx *gorm.DB, winnerID, loserID
 	}
 	return nil
 }
+
+// FinalizeGameAndUpdateBracket finalizes a playoff game and replays its bracket under row locks. The
+// league row is locked before the game is touched, so finalizations of two games of one bracket can't
+// each replay a stale snapshot and overwrite the other, and take their locks in the same order.
+func (r *gameRepositoryImpl) FinalizeGameAndUpdateBracket(
+	game *models.Game,
+	loserID uuid.UUID,
+	dto *requests.FinalizeGameRequestDTO,
+	report *models.GameReport,
+	replay func(league *models.League, games []models.Game) (*BracketUpdate, error),
+) error {
+	leagueID := game.LeagueID
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var league models.League
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&league, "id = ?", leagueID).Error; err != nil {
+			return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to lock league %s: %w", leagueID, err)
+		}
+		if err := r.applyFinalization(tx, game, loserID, dto, report); err != nil {
+			return err
+		}
+
+		var games []models.Game
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("league_id = ? AND game_type <> ?", leagueID, enums.GameTypeRegularSeason).
+			Order("round_number ASC, created_at ASC").
+			Find(&games).Error
+		if err != nil {
+			return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to get playoff games: %w", err)
+		}
+
+		update, err := replay(&league, games)
+		if err != nil {
+			return err
+		}
+		if update == nil {
+			return nil
+		}
+
+		for _, game := range update.Voided {
+			if err := r.decrementPlayerStats(tx, *game.WinnerID, *game.LoserID); err != nil {
+				return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to void the result of game %s: %w", game.ID, err)
+			}
+			// the battles behind the result no longer count; a replay reports its own
+			if err := tx.Where("game_id = ?", game.ID).Delete(&models.ClaimGameStat{}).Error; err != nil {
+				return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to drop the claim stats of game %s: %w", game.ID, err)
+			}
+		}
+		if len(update.Removed) > 0 {
+			if err := tx.Delete(&models.Game{}, "id IN ?", update.Removed).Error; err != nil {
+				return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to remove games: %w", err)
+			}
+		}
+		for _, game := range update.Games {
+			if err := tx.Omit(clause.Associations).Save(game).Error; err != nil {
+				return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to save game %s: %w", game.ID, err)
+			}
+		}
+		if update.LeagueStatus != "" {
+			// a map, so a nil EndDate clears the column
+			err := tx.Model(&models.League{}).Where("id = ?", leagueID).Updates(map[string]interface{}{
+				"status":   update.LeagueStatus,
+				"end_date": update.EndDate,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("(Repository: FinalizeGameAndUpdateBracket) - failed to move league %s to %s: %w", leagueID, update.LeagueStatus, err)
+			}
+		}
+		return nil
+	})
+}
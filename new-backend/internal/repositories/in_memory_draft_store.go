@@ -0,0 +1,741 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/rbac"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var errInMemoryUnsupported = errors.New("operation not supported by the in-memory draft store")
+
+// InMemoryDraftStore holds a self-contained copy of one league's draft data: the league, its members,
+// its pool, and the draft, picks and claims made against it. It backs a DraftService for mock drafts,
+// so nothing it records ever reaches the database.
+//
+// Only the repository methods a draft needs are implemented. Everything else returns an error.
+// Like the database, the store hands out copies; callers persist changes through the Update methods.
+type InMemoryDraftStore struct {
+	mu          sync.RWMutex
+	league      models.League
+	members     []models.LeagueMember
+	poolEntries []models.PoolEntry
+	draft       *models.Draft
+	picks       []models.DraftPick
+	claims      []models.Claim
+}
+
+func NewInMemoryDraftStore(league *models.League, members []models.LeagueMember, poolEntries []models.PoolEntry) *InMemoryDraftStore {
+	return &InMemoryDraftStore{
+		league:      cloneLeague(league),
+		members:     slices.Clone(members),
+		poolEntries: slices.Clone(poolEntries),
+	}
+}
+
+func (s *InMemoryDraftStore) LeagueRepository() LeagueRepository {
+	return &inMemoryLeagueRepository{store: s}
+}
+
+func (s *InMemoryDraftStore) DraftRepository() DraftRepository {
+	return &inMemoryDraftRepository{store: s}
+}
+
+func (s *InMemoryDraftStore) LeagueMemberRepository() LeagueMemberRepository {
+	return &inMemoryLeagueMemberRepository{store: s}
+}
+
+func (s *InMemoryDraftStore) PoolEntryRepository() PoolEntryRepository {
+	return &inMemoryPoolEntryRepository{store: s}
+}
+
+func (s *InMemoryDraftStore) DraftPickRepository() DraftPickRepository {
+	return &inMemoryDraftPickRepository{store: s}
+}
+
+func (s *InMemoryDraftStore) ClaimRepository() ClaimRepository {
+	return &inMemoryClaimRepository{store: s}
+}
+
+func cloneLeague(league *models.League) models.League {
+	clone := *league
+	if league.Format != nil {
+		format := *league.Format
+		clone.Format = &format
+	}
+	return clone
+}
+
+func cloneDraft(draft *models.Draft) *models.Draft {
+	clone := *draft
+	clone.PlayersWithAccumulatedPicks = make(models.PlayerAccumulatedPicks, len(draft.PlayersWithAccumulatedPicks))
+	for memberID, picks := range draft.PlayersWithAccumulatedPicks {
+		clone.PlayersWithAccumulatedPicks[memberID] = slices.Clone(picks)
+	}
+	if draft.Lottery != nil {
+		lottery := *draft.Lottery
+		clone.Lottery = &lottery
+	}
+	if draft.Clock != nil {
+		clock := *draft.Clock
+		clone.Clock = &clock
+	}
+	return &clone
+}
+
+func notFound(method string) error {
+	return fmt.Errorf("(Error: InMemoryDraftStore.%s) - record not found: %w", method, gorm.ErrRecordNotFound)
+}
+
+func unsupported(method string) error {
+	return fmt.Errorf("(Error: InMemoryDraftStore.%s): %w", method, errInMemoryUnsupported)
+}
+
+// --- League ---
+
+type inMemoryLeagueRepository struct {
+	store *InMemoryDraftStore
+}
+
+func (r *inMemoryLeagueRepository) GetLeagueByID(leagueID uuid.UUID) (*models.League, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	if r.store.league.ID != leagueID {
+		return nil, notFound("GetLeagueByID")
+	}
+	league := cloneLeague(&r.store.league)
+	return &league, nil
+}
+
+func (r *inMemoryLeagueRepository) GetLeagueWithFullDetails(id uuid.UUID) (*models.League, error) {
+	return r.GetLeagueByID(id)
+}
+
+func (r *inMemoryLeagueRepository) UpdateLeague(league *models.League) (*models.League, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if r.store.league.ID != league.ID {
+		return nil, notFound("UpdateLeague")
+	}
+	r.store.league = cloneLeague(league)
+	return league, nil
+}
+
+func (r *inMemoryLeagueRepository) ClearScheduledDraftStart(leagueID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if r.store.league.ID != leagueID {
+		return notFound("ClearScheduledDraftStart")
+	}
+	r.store.league.ScheduledDraftStart = nil
+	r.store.league.ScheduledDraftTurnTimeLimit = 0
+	return nil
+}
+
+func (r *inMemoryLeagueRepository) GetLeagueStatus(leagueID uuid.UUID) (enums.LeagueStatus, error) {
+	league, err := r.GetLeagueByID(leagueID)
+	if err != nil {
+		return "", err
+	}
+	return league.Status, nil
+}
+
+func (r *inMemoryLeagueRepository) IsUserPlayerInLeague(userID, leagueID uuid.UUID) (bool, error) {
+	return (&inMemoryLeagueMemberRepository{store: r.store}).IsUserInLeague(userID, leagueID)
+}
+
+func (r *inMemoryLeagueRepository) CreateLeague(league *models.League) (*models.League, error) {
+	return nil, unsupported("CreateLeague")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesByOwner(userID uuid.UUID) ([]models.League, error) {
+	return nil, unsupported("GetLeaguesByOwner")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesCountWhereOwner(userID uuid.UUID) (int64, error) {
+	return 0, unsupported("GetLeaguesCountWhereOwner")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesByUser(userID uuid.UUID) ([]models.League, error) {
+	return nil, unsupported("GetLeaguesByUser")
+}
+
+func (r *inMemoryLeagueRepository) DeleteLeague(leagueId uuid.UUID) error {
+	return unsupported("DeleteLeague")
+}
+
+func (r *inMemoryLeagueRepository) IsUserOwner(userID, leagueID uuid.UUID) (bool, error) {
+	return false, unsupported("IsUserOwner")
+}
+
+func (r *inMemoryLeagueRepository) GetAllLeaguesByStatus(status enums.LeagueStatus) ([]models.League, error) {
+	return nil, unsupported("GetAllLeaguesByStatus")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesByStatuses(statuses []enums.LeagueStatus) ([]models.League, error) {
+	return nil, unsupported("GetLeaguesByStatuses")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesThatAllowTransfers() ([]models.League, error) {
+	return nil, unsupported("GetLeaguesThatAllowTransfers")
+}
+
+func (r *inMemoryLeagueRepository) GetLeaguesWithScheduledDraftStart() ([]models.League, error) {
+	return nil, unsupported("GetLeaguesWithScheduledDraftStart")
+}
+
+// --- Draft ---
+
+type inMemoryDraftRepository struct {
+	store *InMemoryDraftStore
+}
+
+func (r *inMemoryDraftRepository) GetDraftByLeagueID(leagueID uuid.UUID) (*models.Draft, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	if r.store.draft == nil || r.store.draft.LeagueID != leagueID {
+		return nil, notFound("GetDraftByLeagueID")
+	}
+	return cloneDraft(r.store.draft), nil
+}
+
+func (r *inMemoryDraftRepository) GetDraftByID(draftID uuid.UUID) (*models.Draft, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	if r.store.draft == nil || r.store.draft.ID != draftID {
+		return nil, notFound("GetDraftByID")
+	}
+	return cloneDraft(r.store.draft), nil
+}
+
+func (r *inMemoryDraftRepository) CreateDraft(draft *models.Draft) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if r.store.draft != nil {
+		return fmt.Errorf("(Error: InMemoryDraftStore.CreateDraft) - draft already exists: %w", gorm.ErrDuplicatedKey)
+	}
+	if draft.ID == uuid.Nil {
+		draft.ID = uuid.New()
+	}
+	draft.CreatedAt = time.Now()
+	draft.UpdatedAt = draft.CreatedAt
+	r.store.draft = cloneDraft(draft)
+	return nil
+}
+
+func (r *inMemoryDraftRepository) UpdateDraft(draft *models.Draft) (*models.Draft, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if r.store.draft == nil || r.store.draft.ID != draft.ID {
+		return nil, notFound("UpdateDraft")
+	}
+	draft.UpdatedAt = time.Now()
+	r.store.draft = cloneDraft(draft)
+	return draft, nil
+}
+
+func (r *inMemoryDraftRepository) GetAllDraftsByStatus(status enums.DraftStatus) ([]models.Draft, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	if r.store.draft == nil || r.store.draft.Status != status {
+		return []models.Draft{}, nil
+	}
+	return []models.Draft{*cloneDraft(r.store.draft)}, nil
+}
+
+// mock drafts don't run auctions (see MockDraftService.CreateMockDraft)
+
+func (r *inMemoryDraftRepository) OpenAuctionLot(draftID, nominatorID, poolEntryID uuid.UUID, openingBid int, lotEndsAt time.Time) error {
+	return unsupported("OpenAuctionLot")
+}
+
+func (r *inMemoryDraftRepository) RaiseAuctionBid(draftID, poolEntryID, bidderID uuid.UUID, amount int, now, lotEndsAt time.Time) error {
+	return unsupported("RaiseAuctionBid")
+}
+
+func (r *inMemoryDraftRepository) TakeAuctionLotForClose(draftID, poolEntryID, highBidderID uuid.UUID, highBid int, now time.Time) error {
+	return unsupported("TakeAuctionLotForClose")
+}
+
+func (r *inMemoryDraftRepository) TakeAuctionNominationForPass(draftID, memberID uuid.UUID) error {
+	return unsupported("TakeAuctionNominationForPass")
+}
+
+// --- League members ---
+
+type inMemoryLeagueMemberRepository struct {
+	store *InMemoryDraftStore
+}
+
+// find returns the index of the first member matching fn, or -1. Callers must hold the lock.
+func (r *inMemoryLeagueMemberRepository) find(fn func(m *models.LeagueMember) bool) int {
+	return slices.IndexFunc(r.store.members, func(m models.LeagueMember) bool { return fn(&m) })
+}
+
+func (r *inMemoryLeagueMemberRepository) GetByID(id uuid.UUID) (*models.LeagueMember, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	i := r.find(func(m *models.LeagueMember) bool { return m.ID == id })
+	if i == -1 {
+		return nil, notFound("GetByID")
+	}
+	member := r.store.members[i]
+	return &member, nil
+}
+
+func (r *inMemoryLeagueMemberRepository) GetByUserAndLeague(userID, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	i := r.find(func(m *models.LeagueMember) bool { return m.UserID == userID && m.LeagueID == leagueID })
+	if i == -1 {
+		return nil, notFound("GetByUserAndLeague")
+	}
+	member := r.store.members[i]
+	return &member, nil
+}
+
+func (r *inMemoryLeagueMemberRepository) FindByUserAndLeague(userID, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	return r.GetByUserAndLeague(userID, leagueID)
+}
+
+func (r *inMemoryLeagueMemberRepository) GetWithFullRoster(memberID uuid.UUID) (*models.LeagueMember, error) {
+	return r.GetByID(memberID)
+}
+
+// GetByLeague returns members sorted by draft position, like the database repository.
+func (r *inMemoryLeagueMemberRepository) GetByLeague(leagueID uuid.UUID) ([]models.LeagueMember, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	members := make([]models.LeagueMember, 0, len(r.store.members))
+	for _, m := range r.store.members {
+		if m.LeagueID == leagueID {
+			members = append(members, m)
+		}
+	}
+	slices.SortStableFunc(members, func(a, b models.LeagueMember) int { return a.DraftPosition - b.DraftPosition })
+	return members, nil
+}
+
+func (r *inMemoryLeagueMemberRepository) GetCountByLeague(leagueID uuid.UUID) (int64, error) {
+	members, err := r.GetByLeague(leagueID)
+	return int64(len(members)), err
+}
+
+func (r *inMemoryLeagueMemberRepository) IsUserInLeague(userID, leagueID uuid.UUID) (bool, error) {
+	_, err := r.GetByUserAndLeague(userID, leagueID)
+	if err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r *inMemoryLeagueMemberRepository) Update(member *models.LeagueMember) (*models.LeagueMember, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	i := r.find(func(m *models.LeagueMember) bool { return m.ID == member.ID })
+	if i == -1 {
+		return nil, notFound("Update")
+	}
+	member.UpdatedAt = time.Now()
+	r.store.members[i] = *member
+	return member, nil
+}
+
+func (r *inMemoryLeagueMemberRepository) update(method string, memberID uuid.UUID, fn func(m *models.LeagueMember)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	i := r.find(func(m *models.LeagueMember) bool { return m.ID == memberID })
+	if i == -1 {
+		return notFound(method)
+	}
+	fn(&r.store.members[i])
+	r.store.members[i].UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryLeagueMemberRepository) UpdateDraftPoints(memberID uuid.UUID, points int) error {
+	return r.update("UpdateDraftPoints", memberID, func(m *models.LeagueMember) { m.DraftPoints = points })
+}
+
+func (r *inMemoryLeagueMemberRepository) UpdateDraftPosition(memberID uuid.UUID, position int) error {
+	return r.update("UpdateDraftPosition", memberID, func(m *models.LeagueMember) { m.DraftPosition = position })
+}
+
+func (r *inMemoryLeagueMemberRepository) UpdateRecord(memberID uuid.UUID, wins, losses int) error {
+	return r.update("UpdateRecord", memberID, func(m *models.LeagueMember) { m.Wins, m.Losses = wins, losses })
+}
+
+func (r *inMemoryLeagueMemberRepository) Create(member *models.LeagueMember) (*models.LeagueMember, error) {
+	return nil, unsupported("Create")
+}
+
+func (r *inMemoryLeagueMemberRepository) GetByLeagueAndGroup(leagueID uuid.UUID, groupNumber int) ([]models.LeagueMember, error) {
+	return nil, unsupported("GetByLeagueAndGroup")
+}
+
+func (r *inMemoryLeagueMemberRepository) GetByUser(userID uuid.UUID) ([]models.LeagueMember, error) {
+	return nil, unsupported("GetByUser")
+}
+
+func (r *inMemoryLeagueMemberRepository) UpdateRole(memberID uuid.UUID, role rbac.MemberRole) error {
+	return unsupported("UpdateRole")
+}
+
+func (r *inMemoryLeagueMemberRepository) Delete(memberID uuid.UUID) error {
+	return unsupported("Delete")
+}
+
+func (r *inMemoryLeagueMemberRepository) FindByInLeagueName(name string, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	return nil, unsupported("FindByInLeagueName")
+}
+
+func (r *inMemoryLeagueMemberRepository) FindByTeamName(name string, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	return nil, unsupported("FindByTeamName")
+}
+
+// --- Pool entries ---
+
+type inMemoryPoolEntryRepository struct {
+	store *InMemoryDraftStore
+}
+
+func (r *inMemoryPoolEntryRepository) filter(fn func(e *models.PoolEntry) bool) []models.PoolEntry {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	entries := make([]models.PoolEntry, 0)
+	for _, e := range r.store.poolEntries {
+		if fn(&e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func (r *inMemoryPoolEntryRepository) GetByID(id uuid.UUID) (*models.PoolEntry, error) {
+	entries := r.filter(func(e *models.PoolEntry) bool { return e.ID == id })
+	if len(entries) == 0 {
+		return nil, notFound("GetByID")
+	}
+	return &entries[0], nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetByLeague(leagueID uuid.UUID) ([]models.PoolEntry, error) {
+	return r.filter(func(e *models.PoolEntry) bool { return e.LeagueID == leagueID }), nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetAvailableByLeague(leagueID uuid.UUID) ([]models.PoolEntry, error) {
+	entries := r.filter(func(e *models.PoolEntry) bool { return e.LeagueID == leagueID && e.IsAvailable })
+	slices.SortStableFunc(entries, func(a, b models.PoolEntry) int { return *b.Cost - *a.Cost })
+	return entries, nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetByIDs(leagueID uuid.UUID, ids []uuid.UUID) ([]models.PoolEntry, error) {
+	return r.filter(func(e *models.PoolEntry) bool { return e.LeagueID == leagueID && slices.Contains(ids, e.ID) }), nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetBySpecies(leagueID uuid.UUID, speciesID int64) (*models.PoolEntry, error) {
+	entries := r.filter(func(e *models.PoolEntry) bool { return e.LeagueID == leagueID && e.PokemonSpeciesID == speciesID })
+	if len(entries) == 0 {
+		return nil, notFound("GetBySpecies")
+	}
+	return &entries[0], nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetByCostRange(leagueID uuid.UUID, minCost, maxCost int) ([]models.PoolEntry, error) {
+	return r.filter(func(e *models.PoolEntry) bool {
+		return e.LeagueID == leagueID && *e.Cost >= minCost && *e.Cost <= maxCost
+	}), nil
+}
+
+func (r *inMemoryPoolEntryRepository) IsAvailable(leagueID uuid.UUID, speciesID int64) (bool, error) {
+	entry, err := r.GetBySpecies(leagueID, speciesID)
+	if err != nil {
+		return false, err
+	}
+	return entry.IsAvailable, nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetCost(leagueID uuid.UUID, speciesID int64) (*int, error) {
+	entry, err := r.GetBySpecies(leagueID, speciesID)
+	if err != nil {
+		return nil, err
+	}
+	return entry.Cost, nil
+}
+
+func (r *inMemoryPoolEntryRepository) GetAvailableCount(leagueID uuid.UUID) (int64, error) {
+	entries, err := r.GetAvailableByLeague(leagueID)
+	return int64(len(entries)), err
+}
+
+func (r *inMemoryPoolEntryRepository) setAvailable(method string, id uuid.UUID, available bool) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	i := slices.IndexFunc(r.store.poolEntries, func(e models.PoolEntry) bool { return e.ID == id })
+	if i == -1 {
+		return notFound(method)
+	}
+	r.store.poolEntries[i].IsAvailable = available
+	return nil
+}
+
+// MarkUnavailable ignores tx; the store has no transactions.
+func (r *inMemoryPoolEntryRepository) MarkUnavailable(tx *gorm.DB, id uuid.UUID) error {
+	return r.setAvailable("MarkUnavailable", id, false)
+}
+
+// MarkAvailable ignores tx; the store has no transactions.
+func (r *inMemoryPoolEntryRepository) MarkAvailable(tx *gorm.DB, id uuid.UUID) error {
+	return r.setAvailable("MarkAvailable", id, true)
+}
+
+func (r *inMemoryPoolEntryRepository) Create(entry *models.PoolEntry) (*models.PoolEntry, error) {
+	return nil, unsupported("Create")
+}
+
+func (r *inMemoryPoolEntryRepository) CreateBatch(entries []models.PoolEntry) ([]models.PoolEntry, error) {
+	return nil, unsupported("CreateBatch")
+}
+
+func (r *inMemoryPoolEntryRepository) Update(entry *models.PoolEntry) (*models.PoolEntry, error) {
+	return nil, unsupported("Update")
+}
+
+func (r *inMemoryPoolEntryRepository) Delete(leagueID uuid.UUID, speciesID int64) error {
+	return unsupported("Delete")
+}
+
+func (r *inMemoryPoolEntryRepository) DeleteAllByLeague(leagueID uuid.UUID) error {
+	return unsupported("DeleteAllByLeague")
+}
+
+// --- Draft picks ---
+
+type inMemoryDraftPickRepository struct {
+	store *InMemoryDraftStore
+}
+
+// withPoolEntry attaches the pick's pool entry, as the database repository preloads it. Callers must hold the lock.
+func (r *inMemoryDraftPickRepository) withPoolEntry(pick models.DraftPick) models.DraftPick {
+	if i := slices.IndexFunc(r.store.poolEntries, func(e models.PoolEntry) bool { return e.ID == pick.PoolEntryID }); i != -1 {
+		entry := r.store.poolEntries[i]
+		pick.PoolEntry = &entry
+	}
+	return pick
+}
+
+func (r *inMemoryDraftPickRepository) Create(pick *models.DraftPick) (*models.DraftPick, error) {
+	picks := []models.DraftPick{*pick}
+	if err := r.CreateBatch(picks); err != nil {
+		return nil, err
+	}
+	*pick = picks[0]
+	return pick, nil
+}
+
+// CreateBatch assigns IDs in place, like gorm does, so callers can reference the new picks afterwards.
+func (r *inMemoryDraftPickRepository) CreateBatch(picks []models.DraftPick) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	now := time.Now()
+	for i := range picks {
+		if picks[i].ID == uuid.Nil {
+			picks[i].ID = uuid.New()
+		}
+		picks[i].CreatedAt = now
+		picks[i].UpdatedAt = now
+		r.store.picks = append(r.store.picks, picks[i])
+	}
+	return nil
+}
+
+func (r *inMemoryDraftPickRepository) GetByID(id uuid.UUID) (*models.DraftPick, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	i := slices.IndexFunc(r.store.picks, func(p models.DraftPick) bool { return p.ID == id })
+	if i == -1 {
+		return nil, notFound("GetByID")
+	}
+	pick := r.withPoolEntry(r.store.picks[i])
+	return &pick, nil
+}
+
+func (r *inMemoryDraftPickRepository) GetByDraft(draftID uuid.UUID) ([]models.DraftPick, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	picks := make([]models.DraftPick, 0, len(r.store.picks))
+	for _, p := range r.store.picks {
+		if p.DraftID == draftID {
+			picks = append(picks, r.withPoolEntry(p))
+		}
+	}
+	slices.SortStableFunc(picks, func(a, b models.DraftPick) int { return a.PickNumber - b.PickNumber })
+	return picks, nil
+}
+
+func (r *inMemoryDraftPickRepository) GetByPlayer(playerID uuid.UUID) ([]models.DraftPick, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	picks := make([]models.DraftPick, 0)
+	for _, p := range r.store.picks {
+		if p.PlayerID == playerID {
+			picks = append(picks, r.withPoolEntry(p))
+		}
+	}
+	return picks, nil
+}
+
+func (r *inMemoryDraftPickRepository) GetCountByDraft(draftID uuid.UUID) (int64, error) {
+	picks, err := r.GetByDraft(draftID)
+	return int64(len(picks)), err
+}
+
+// GetLatestByDraft returns the most recently made pick, which is not necessarily the highest pick number
+// when accumulated picks are used late.
+func (r *inMemoryDraftPickRepository) GetLatestByDraft(draftID uuid.UUID) (*models.DraftPick, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	for i := len(r.store.picks) - 1; i >= 0; i-- {
+		if r.store.picks[i].DraftID == draftID {
+			pick := r.withPoolEntry(r.store.picks[i])
+			return &pick, nil
+		}
+	}
+	return nil, notFound("GetLatestByDraft")
+}
+
+// UndoPick mirrors the database repository: deactivate the pick's claim, refund its cost, return the
+// pool entry, delete the pick and save the rewound draft and league status.
+func (r *inMemoryDraftPickRepository) UndoPick(pick *models.DraftPick, draft *models.Draft, league *models.League) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	claimIdx := slices.IndexFunc(r.store.claims, func(c models.Claim) bool {
+		return c.Source == enums.ClaimSourceDraft && c.SourceID != nil && *c.SourceID == pick.ID && c.IsActive
+	})
+	if claimIdx == -1 {
+		return 0, notFound("UndoPick")
+	}
+	claim := &r.store.claims[claimIdx]
+	releasedWeek := 0 // pre-season draft week
+	claim.IsActive = false
+	claim.ReleasedWeek = &releasedWeek
+
+	if i := slices.IndexFunc(r.store.members, func(m models.LeagueMember) bool { return m.ID == pick.PlayerID }); i != -1 {
+		r.store.members[i].DraftPoints += claim.CostPaid
+	}
+	if i := slices.IndexFunc(r.store.poolEntries, func(e models.PoolEntry) bool { return e.ID == pick.PoolEntryID }); i != -1 {
+		r.store.poolEntries[i].IsAvailable = true
+	}
+	r.store.picks = slices.DeleteFunc(r.store.picks, func(p models.DraftPick) bool { return p.ID == pick.ID })
+	r.store.draft = cloneDraft(draft)
+	r.store.league.Status = league.Status
+
+	return claim.CostPaid, nil
+}
+
+// --- Claims ---
+
+type inMemoryClaimRepository struct {
+	store *InMemoryDraftStore
+}
+
+func (r *inMemoryClaimRepository) filter(fn func(c *models.Claim) bool) []models.Claim {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	claims := make([]models.Claim, 0)
+	for _, c := range r.store.claims {
+		if fn(&c) {
+			claims = append(claims, c)
+		}
+	}
+	return claims
+}
+
+func (r *inMemoryClaimRepository) Create(claim *models.Claim) (*models.Claim, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if claim.ID == uuid.Nil {
+		claim.ID = uuid.New()
+	}
+	claim.CreatedAt = time.Now()
+	claim.UpdatedAt = claim.CreatedAt
+	r.store.claims = append(r.store.claims, *claim)
+	return claim, nil
+}
+
+func (r *inMemoryClaimRepository) GetByID(id uuid.UUID) (*models.Claim, error) {
+	claims := r.filter(func(c *models.Claim) bool { return c.ID == id })
+	if len(claims) == 0 {
+		return nil, notFound("GetByID")
+	}
+	return &claims[0], nil
+}
+
+func (r *inMemoryClaimRepository) GetActiveByPlayerAndSpecies(playerID uuid.UUID, speciesID int64) (*models.Claim, error) {
+	claims := r.filter(func(c *models.Claim) bool { return c.PlayerID == playerID && c.SpeciesID == speciesID && c.IsActive })
+	if len(claims) == 0 {
+		return nil, notFound("GetActiveByPlayerAndSpecies")
+	}
+	return &claims[0], nil
+}
+
+func (r *inMemoryClaimRepository) GetActiveByPlayer(playerID uuid.UUID) ([]models.Claim, error) {
+	return r.filter(func(c *models.Claim) bool { return c.PlayerID == playerID && c.IsActive }), nil
+}
+
+func (r *inMemoryClaimRepository) GetHeldByPlayerInWeek(playerID uuid.UUID, week int) ([]models.Claim, error) {
+	return r.filter(func(c *models.Claim) bool {
+		return c.PlayerID == playerID && c.AcquiredWeek <= week && (c.ReleasedWeek == nil || *c.ReleasedWeek > week)
+	}), nil
+}
+
+func (r *inMemoryClaimRepository) GetActiveByLeague(leagueID uuid.UUID) ([]models.Claim, error) {
+	return r.filter(func(c *models.Claim) bool { return c.LeagueID == leagueID && c.IsActive }), nil
+}
+
+func (r *inMemoryClaimRepository) GetReleasedByLeague(leagueID uuid.UUID) ([]models.Claim, error) {
+	return r.filter(func(c *models.Claim) bool { return c.LeagueID == leagueID && !c.IsActive }), nil
+}
+
+func (r *inMemoryClaimRepository) GetActiveCountByPlayer(playerID uuid.UUID) (int64, error) {
+	claims, err := r.GetActiveByPlayer(playerID)
+	return int64(len(claims)), err
+}
+
+func (r *inMemoryClaimRepository) GetActiveCountByLeague(leagueID uuid.UUID) (int64, error) {
+	claims, err := r.GetActiveByLeague(leagueID)
+	return int64(len(claims)), err
+}
+
+func (r *inMemoryClaimRepository) IsSpeciesClaimedInLeague(leagueID uuid.UUID, speciesID int64) (bool, error) {
+	claims := r.filter(func(c *models.Claim) bool { return c.LeagueID == leagueID && c.SpeciesID == speciesID && c.IsActive })
+	return len(claims) > 0, nil
+}
+
+func (r *inMemoryClaimRepository) Update(claim *models.Claim) (*models.Claim, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	i := slices.IndexFunc(r.store.claims, func(c models.Claim) bool { return c.ID == claim.ID })
+	if i == -1 {
+		return nil, notFound("Update")
+	}
+	claim.UpdatedAt = time.Now()
+	r.store.claims[i] = *claim
+	return claim, nil
+}
+
+func (r *inMemoryClaimRepository) ReleaseTx(tx *gorm.DB, claim *models.Claim, member *models.LeagueMember, dropCost int, releasedWeek int, poolEntryID uuid.UUID) error {
+	return unsupported("ReleaseTx")
+}
+
+func (r *inMemoryClaimRepository) PickupFreeAgentTx(tx *gorm.DB, member *models.LeagueMember, newClaim *models.Claim, poolEntry *models.PoolEntry, pickupCost int) error {
+	return unsupported("PickupFreeAgentTx")
+}
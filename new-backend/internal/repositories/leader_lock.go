@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SchedulerLeaderLockKey is the advisory lock key of the instance that runs scheduled tasks.
+const SchedulerLeaderLockKey int64 = 0x5343484544 // "SCHED"
+
+// LeaderLock is held by at most one backend instance at a time. It elects the instance that does work
+// that must not run twice, like executing scheduled tasks.
+type LeaderLock interface {
+	// takes the lock if it is free, without waiting, and reports whether this instance holds it.
+	// Called again while held, it checks the lock was not lost.
+	TryAcquire() (bool, error)
+	// gives the lock up so another instance can take it straight away
+	Release() error
+}
+
+// advisoryLeaderLock is a Postgres session-level advisory lock. It is held on one connection set aside
+// from the pool; if the instance dies, Postgres ends the session and the lock is freed.
+type advisoryLeaderLock struct {
+	db   *gorm.DB
+	key  int64
+	mu   sync.Mutex
+	conn *sql.Conn // the session holding the lock; nil while not held
+}
+
+func NewAdvisoryLeaderLock(db *gorm.DB, key int64) LeaderLock {
+	return &advisoryLeaderLock{db: db, key: key}
+}
+
+func (l *advisoryLeaderLock) TryAcquire() (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err != nil {
+			// the session is gone and the lock with it
+			l.conn.Close()
+			l.conn = nil
+			return false, fmt.Errorf("(Error: LeaderLock.TryAcquire) - lost the session holding lock %d: %w", l.key, err)
+		}
+		return true, nil
+	}
+
+	sqlDB, err := l.db.DB()
+	if err != nil {
+		return false, fmt.Errorf("(Error: LeaderLock.TryAcquire) - failed to get connection pool: %w", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("(Error: LeaderLock.TryAcquire) - failed to get a connection: %w", err)
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("(Error: LeaderLock.TryAcquire) - failed to try lock %d: %w", l.key, err)
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+func (l *advisoryLeaderLock) Release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// unlock before the connection goes back to the pool, where its session lives on
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	l.conn.Close()
+	l.conn = nil
+	if err != nil {
+		return fmt.Errorf("(Error: LeaderLock.Release) - failed to release lock %d: %w", l.key, err)
+	}
+	return nil
+}
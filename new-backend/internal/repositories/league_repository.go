@@ -28,6 +28,8 @@ type LeagueRepository interface {
 	GetLeaguesByUser(userID uuid.UUID) ([]models.League, error)
 	// updates a league (name, start_date, ruleset_id, status, max_pokemon_per_player, free_agents)
 	UpdateLeague(league *models.League) (*models.League, error)
+	// clears a league's scheduled draft start and its turn time limit
+	ClearScheduledDraftStart(leagueID uuid.UUID) error
 	// soft deletes a league and all associated data
 	DeleteLeague(leagueId uuid.UUID) error
 	// Public helper to check if a user's player is the owner
@@ -40,6 +42,8 @@ type LeagueRepository interface {
 	GetLeaguesByStatuses(statuses []enums.LeagueStatus) ([]models.League, error)
 	// retrieves all leagues that allow transfer credits.
 	GetLeaguesThatAllowTransfers() ([]models.League, error)
+	// retrieves all leagues with a draft start scheduled.
+	GetLeaguesWithScheduledDraftStart() ([]models.League, error)
 }
 
 type leagueRepositoryImpl struct {
@@ -157,6 +161,19 @@ func (r *leagueRepositoryImpl) UpdateLeague(league *models.League) (*models.Leag
 	return r.GetLeagueByID(league.ID)
 }
 
+// clears a league's scheduled draft start and its turn time limit.
+// UpdateLeague can't do this: it skips zero-valued fields.
+func (r *leagueRepositoryImpl) ClearScheduledDraftStart(leagueID uuid.UUID) error {
+	err := r.db.Model(&models.League{}).Where("id = ?", leagueID).Updates(map[string]interface{}{
+		"scheduled_draft_start":           nil,
+		"scheduled_draft_turn_time_limit": 0,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("(Error: ClearScheduledDraftStart) - failed to clear scheduled draft start: %w", err)
+	}
+	return nil
+}
+
 // soft deletes a league and all associated data
 func (r *leagueRepositoryImpl) DeleteLeague(leagueId uuid.UUID) error {
 	tx := r.db.Begin()
@@ -239,3 +256,12 @@ func (r *leagueRepositoryImpl) GetLeaguesThatAllowTransfers() ([]models.League,
 	}
 	return leagues, nil
 }
+
+// retrieves all leagues with a draft start scheduled.
+func (r *leagueRepositoryImpl) GetLeaguesWithScheduledDraftStart() ([]models.League, error) {
+	var leagues []models.League
+	if err := r.db.Where("scheduled_draft_start IS NOT NULL").Find(&leagues).Error; err != nil {
+		return nil, err
+	}
+	return leagues, nil
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ScheduledTaskRepository interface {
+	// creates the task, or replaces the stored task with the same ID (tasks are re-registered under the same ID)
+	Save(task *models.ScheduledTask) error
+	// replaces the stored task with its dead letter (which has an ID of its own) in one transaction
+	DeadLetter(record *models.ScheduledTask) error
+	// sets the status of a task that is still PENDING and stamps CompletedAt; a task that has already
+	// ended (e.g. cancelled by another instance) is left alone
+	UpdateStatus(id string, status enums.ScheduledTaskStatus) error
+	// retrieves all tasks with a status, earliest due first
+	GetByStatus(status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error)
+	// retrieves every task written since a time, whatever its status, oldest write first
+	GetUpdatedSince(since time.Time) ([]models.ScheduledTask, error)
+	// retrieves a league's tasks with a status, earliest due first
+	GetByLeagueAndStatus(leagueID uuid.UUID, status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error)
+	// counts every stored task, whatever its status
+	Count() (int64, error)
+}
+
+type scheduledTaskRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewScheduledTaskRepository(db *gorm.DB) ScheduledTaskRepository {
+	return &scheduledTaskRepositoryImpl{db: db}
+}
+
+func (r *scheduledTaskRepositoryImpl) Save(task *models.ScheduledTask) error {
+	if err := r.db.Save(task).Error; err != nil {
+		return fmt.Errorf("(Error: ScheduledTaskRepo.Save) - failed to save scheduled task %s: %w", task.ID, err)
+	}
+	return nil
+}
+
+func (r *scheduledTaskRepositoryImpl) DeadLetter(record *models.ScheduledTask) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.ScheduledTask{}, "id = ?", record.TaskID).Error; err != nil {
+			return err
+		}
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return fmt.Errorf("(Error: ScheduledTaskRepo.DeadLetter) - failed to dead-letter scheduled task %s: %w", record.TaskID, err)
+	}
+	return nil
+}
+
+func (r *scheduledTaskRepositoryImpl) UpdateStatus(id string, status enums.ScheduledTaskStatus) error {
+	updates := map[string]any{"status": status, "completed_at": time.Now()}
+	if err := r.db.Model(&models.ScheduledTask{}).
+		Where("id = ? AND status = ?", id, enums.ScheduledTaskStatusPending).
+		Updates(updates).Error; err != nil {
+		return fmt.Errorf("(Error: ScheduledTaskRepo.UpdateStatus) - failed to set scheduled task %s to %s: %w", id, status, err)
+	}
+	return nil
+}
+
+func (r *scheduledTaskRepositoryImpl) GetByStatus(status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error) {
+	var tasks []models.ScheduledTask
+	if err := r.db.Where("status = ?", status).Order("execute_at ASC").Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("(Error: ScheduledTaskRepo.GetByStatus) - failed to get %s scheduled tasks: %w", status, err)
+	}
+	return tasks, nil
+}
+
+func (r *scheduledTaskRepositoryImpl) GetByLeagueAndStatus(leagueID uuid.UUID, status enums.ScheduledTaskStatus) ([]models.ScheduledTask, error) {
+	var tasks []models.ScheduledTask
+	if err := r.db.Where("league_id = ? AND status = ?", leagueID, status).Order("execute_at ASC").Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("(Error: ScheduledTaskRepo.GetByLeagueAndStatus) - failed to get %s scheduled tasks for league %s: %w", status, leagueID, err)
+	}
+	return tasks, nil
+}
+
+func (r *scheduledTaskRepositoryImpl) GetUpdatedSince(since time.Time) ([]models.ScheduledTask, error) {
+	var tasks []models.ScheduledTask
+	if err := r.db.Where("updated_at >= ?", since).Order("updated_at ASC").Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("(Error: ScheduledTaskRepo.GetUpdatedSince) - failed to get scheduled tasks updated since %s: %w", since, err)
+	}
+	return tasks, nil
+}
+
+func (r *scheduledTaskRepositoryImpl) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.ScheduledTask{}).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("(Error: ScheduledTaskRepo.Count) - failed to count scheduled tasks: %w", err)
+	}
+	return count, nil
+}
@@ -100,6 +100,10 @@ func RegisterRoutes(
 					"",
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadGame),
 					controllers.GameController.GetGamesByLeague)
+				games.GET(
+					"/disputed",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionFinalizeGame), // staff queue of games to rule on
+					controllers.GameController.GetDisputedGames)
 				games.GET(
 					"/:gameId",
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadGame),
@@ -112,11 +116,35 @@ func RegisterRoutes(
 					"/report/:gameId",
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReportGame),
 					controllers.GameController.ReportGame)
+				games.POST(
+					"/read-logs/:gameId",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReportGame),
+					controllers.GameController.ReadBattleLogs)
+				games.PUT(
+					"/confirm/:gameId",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReportGame),
+					controllers.GameController.ConfirmGame)
+				games.PUT(
+					"/dispute/:gameId",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReportGame),
+					controllers.GameController.DisputeGame)
 				games.PUT(
 					"/finalize/:gameId",
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionFinalizeGame), // Requires staff permissions
 					controllers.GameController.FinalizeGame)
 			}
+			leagues.GET(
+				"/:leagueId/standings",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadGame),
+				controllers.StandingsController.GetStandings)
+			leagues.GET(
+				"/:leagueId/stats/pokemon",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadGame),
+				controllers.StatsController.GetPokemonLeaderboard)
+			leagues.GET(
+				"/:leagueId/stats/coaches",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadGame),
+				controllers.StatsController.GetCoachStats)
 
 			// not implmented yet
 			// leagues.DELETE("/:id/leave", playerController.LeaveLeague)
@@ -131,11 +159,23 @@ func RegisterRoutes(
 				draft.GET("/",
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
 					controllers.DraftController.GetDraftByLeagueID)
+				draft.GET("/schedule",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
+					controllers.DraftController.GetPickSchedule)
+				draft.GET("/events",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
+					controllers.DraftController.StreamDraftEvents)
 				draft.POST("/start",
 
 					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraft),
 
 					controllers.DraftController.StartDraft)
+				draft.POST("/start/schedule",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraft),
+					controllers.DraftController.ScheduleDraftStart)
+				draft.DELETE("/start/schedule",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraft),
+					controllers.DraftController.CancelScheduledDraftStart)
 
 			draft.POST("pick",
 
@@ -149,6 +189,88 @@ func RegisterRoutes(
 
 				controllers.DraftController.SkipPick)
 
+			// league staff controls
+			draft.POST("/pause",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftController.PauseDraft)
+			draft.POST("/resume",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftController.ResumeDraft)
+			draft.POST("/force-pick",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftController.ForcePick)
+			draft.POST("/undo",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftController.UndoLastPick)
+			draft.PUT("/order",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftController.SetCustomDraftOrder)
+
+			// auction drafts: the member on the clock nominates, everyone bids
+			draft.POST("/auction/nominate",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftController.NominateAuctionLot)
+			draft.POST("/auction/bid",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftController.PlaceAuctionBid)
+
+			// a member's own pre-ranked queue; used to auto-pick when their turn times out
+			draft.GET("/queue",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftQueueController.GetQueue)
+			draft.PUT("/queue",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftQueueController.SetQueue)
+			draft.POST("/queue",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftQueueController.AddToQueue)
+			draft.DELETE("/queue/:poolEntryId",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftQueueController.RemoveFromQueue)
+
+			// draft slot trades between members; staff can veto
+			draft.GET("/trades",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
+				controllers.DraftTradeController.GetTrades)
+			draft.GET("/slots",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
+				controllers.DraftTradeController.GetSlots)
+			draft.POST("/trades",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftTradeController.ProposeTrade)
+			draft.POST("/trades/:tradeId/accept",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftTradeController.AcceptTrade)
+			draft.POST("/trades/:tradeId/reject",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftTradeController.RejectTrade)
+			draft.POST("/trades/:tradeId/veto",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateDraft),
+				controllers.DraftTradeController.VetoTrade)
+
+			// mock drafts: a private sandbox copy of the pool with bots in the empty seats
+			draft.POST("/mock",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.MockDraftController.CreateMockDraft)
+			draft.GET("/mock/:mockDraftId",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadDraft),
+				controllers.MockDraftController.GetMockDraft)
+			draft.POST("/mock/:mockDraftId/join",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.MockDraftController.JoinMockDraft)
+			draft.POST("/mock/:mockDraftId/start",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.MockDraftController.StartMockDraft)
+			draft.DELETE("/mock/:mockDraftId",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.MockDraftController.DeleteMockDraft)
+			draft.POST("/mock/:mockDraftId/pick",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftController.MakePick)
+			draft.POST("/mock/:mockDraftId/skip",
+				middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionCreateDraftPick),
+				controllers.DraftController.SkipPick)
+
 			}
 
 			// --- Pool Entry Routes ---
@@ -236,6 +358,45 @@ func RegisterRoutes(
 				controllers.TransferController.PickupFreeAgent)
 			}
 
+			// Scheduler Endpoints (league staff)
+			scheduler := leagues.Group(":leagueId/scheduler")
+			{
+				scheduler.GET("/dead-letters",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadScheduledTask),
+					controllers.SchedulerController.GetDeadLetterTasks)
+				scheduler.GET("/tasks",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionReadScheduledTask),
+					controllers.SchedulerController.ListPendingTasks)
+				scheduler.POST("/tasks/:taskId/reschedule",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateScheduledTask),
+					controllers.SchedulerController.RescheduleTask)
+				scheduler.POST("/tasks/:taskId/run",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateScheduledTask),
+					controllers.SchedulerController.RunTaskNow)
+				scheduler.DELETE("/tasks/:taskId",
+					middleware.LeagueRBACMiddleware(leagueMiddlewareDeps, rbac.PermissionUpdateScheduledTask),
+					controllers.SchedulerController.CancelTask)
+			}
+
+		}
+
+		// --- Admin Routes ---
+		// site admins only; these are not scoped to a league
+		admin := api.Group("/admin")
+		admin.Use(middleware.AdminMiddleware())
+		{
+			adminScheduler := admin.Group("/scheduler")
+			{
+				adminScheduler.GET("/dead-letters", controllers.SchedulerController.GetDeadLetterTasks)
+				adminScheduler.GET("/tasks", controllers.SchedulerController.ListPendingTasks)
+				adminScheduler.POST("/tasks/:taskId/reschedule", controllers.SchedulerController.RescheduleTask)
+				adminScheduler.POST("/tasks/:taskId/run", controllers.SchedulerController.RunTaskNow)
+				adminScheduler.DELETE("/tasks/:taskId", controllers.SchedulerController.CancelTask)
+				if cfg.ENVIRONMENT == "dev" {
+					// time travel, for playing through a season in seconds
+					adminScheduler.POST("/clock/advance", controllers.SchedulerController.AdvanceClock)
+				}
+			}
 		}
 
 		users := api.Group("/users")
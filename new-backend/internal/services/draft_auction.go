@@ -0,0 +1,565 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+)
+
+// used when a league in auction mode doesn't set LeagueFormat.AuctionBidTimeSeconds
+const defaultAuctionBidTimeSeconds = 30
+
+// Auction drafts (LeagueFormat.DraftMode == AUCTION)
+//
+// Instead of picking, the member on the clock nominates a pool entry with an opening bid.
+// Every member can then outbid the current high bid until the lot's countdown runs out; each bid
+// restarts the countdown. The scheduler closes the lot (TaskTypeAuctionLotClose) and the high bidder
+// gets the DraftPick + Claim, paying their bid instead of the pool entry's cost. Nomination then
+// moves on to the next member (in draft position order) who still has room on their roster.
+//
+// The nomination turn uses the regular turn timer; when it runs out the nomination passes on.
+// The draft completes once no member can nominate anything: nobody has both roster room and the points
+// for an entry still in the pool. If every member who could nominate passes in turn instead, the draft
+// pauses for staff rather than going round forever.
+//
+// Several instances may take nominations and bids for the same draft (and the leader closes lots), so
+// each change to the lot is a conditional update of the draft row (see DraftRepository.OpenAuctionLot
+// and friends) rather than a read, check and save: of two racing changes, the loser gets ErrConflict.
+
+// NominateAuctionLot opens a lot for a pool entry. Only the member on the clock may nominate,
+// and their opening bid has to pass the same budget and roster checks as any other bid.
+func (s *draftServiceImpl) NominateAuctionLot(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.DraftAuctionNominateRequestDTO,
+) (*models.Draft, error) {
+	league, draft, member, err := s.fetchAuctionResources(currentUser, leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: NominateAuctionLot) - (user %s) could not fetch auction draft for league %s: %v\n", currentUser.ID, leagueID, err)
+		return nil, err
+	}
+	if draft.AuctionPoolEntryID != nil {
+		return nil, types.ErrAuctionLotOpen
+	}
+	if *draft.CurrentTurnMemberID != member.ID {
+		log.Printf("LOG: (DraftService: NominateAuctionLot) - member %s tried to nominate when it isn't their turn. Current Turn: Member %s\n", member.ID, *draft.CurrentTurnMemberID)
+		return nil, types.ErrUnauthorized
+	}
+
+	poolEntry, err := s.fetchAuctionPoolEntry(league.ID, input.PoolEntryID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: NominateAuctionLot) - (member %s) pool entry %s cannot be nominated: %v\n", member.ID, input.PoolEntryID, err)
+		return nil, err
+	}
+
+	if err := s.validateAuctionBid(league, member, poolEntry, input.OpeningBid); err != nil {
+		log.Printf("LOG: (DraftService: NominateAuctionLot) - (member %s) opening bid %d rejected: %v\n", member.ID, input.OpeningBid, err)
+		return nil, err
+	}
+
+	lotEndsAt := s.clock.Now().Add(auctionBidTime(league))
+	if err := s.draftRepo.OpenAuctionLot(draft.ID, member.ID, poolEntry.ID, input.OpeningBid, lotEndsAt); err != nil {
+		if errors.Is(err, types.ErrConflict) {
+			log.Printf("LOG: (DraftService: NominateAuctionLot) - member %s's nomination in league %s lost a race: the turn moved on or a lot opened\n", member.ID, league.ID)
+			return nil, types.ErrConflict
+		}
+		log.Printf("ERROR: (DraftService: NominateAuctionLot) - Failed to open lot for league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	draft.AuctionPoolEntryID = &poolEntry.ID
+	draft.AuctionHighBid = input.OpeningBid
+	draft.AuctionHighBidderID = &member.ID
+	draft.AuctionLotEndsAt = &lotEndsAt
+	draft.AuctionPasses = 0
+
+	// the nomination turn is over; the lot's countdown takes over
+	s.cancelTurnTimeout(draft)
+	s.scheduleAuctionLotClose(draft)
+
+	log.Printf("LOG: (DraftService: NominateAuctionLot) - Member %s nominated pool entry %s in league %s for %d\n", member.ID, poolEntry.ID, league.ID, input.OpeningBid)
+	s.publishAuctionLot(draft, types.DraftEventAuctionLotOpen)
+	return draft, nil
+}
+
+// PlaceAuctionBid raises the high bid on the open lot and restarts its countdown.
+func (s *draftServiceImpl) PlaceAuctionBid(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.DraftAuctionBidRequestDTO,
+) (*models.Draft, error) {
+	league, draft, member, err := s.fetchAuctionResources(currentUser, leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: PlaceAuctionBid) - (user %s) could not fetch auction draft for league %s: %v\n", currentUser.ID, leagueID, err)
+		return nil, err
+	}
+	// a lot past its deadline is only waiting on the scheduler to close it
+	if draft.AuctionPoolEntryID == nil || draft.AuctionLotEndsAt == nil || !s.clock.Now().Before(*draft.AuctionLotEndsAt) {
+		return nil, types.ErrNoAuctionLotOpen
+	}
+	if draft.AuctionHighBidderID != nil && *draft.AuctionHighBidderID == member.ID {
+		log.Printf("LOG: (DraftService: PlaceAuctionBid) - member %s is already the high bidder in league %s\n", member.ID, league.ID)
+		return nil, types.ErrConflict
+	}
+	if input.Amount <= draft.AuctionHighBid {
+		return nil, types.ErrBidTooLow
+	}
+
+	poolEntry, err := s.fetchAuctionPoolEntry(league.ID, *draft.AuctionPoolEntryID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: PlaceAuctionBid) - (member %s) pool entry %s cannot be bid on: %v\n", member.ID, *draft.AuctionPoolEntryID, err)
+		return nil, err
+	}
+	if err := s.validateAuctionBid(league, member, poolEntry, input.Amount); err != nil {
+		log.Printf("LOG: (DraftService: PlaceAuctionBid) - (member %s) bid %d rejected: %v\n", member.ID, input.Amount, err)
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	lotEndsAt := now.Add(auctionBidTime(league))
+	if err := s.draftRepo.RaiseAuctionBid(draft.ID, *draft.AuctionPoolEntryID, member.ID, input.Amount, now, lotEndsAt); err != nil {
+		if errors.Is(err, types.ErrConflict) {
+			// outbid, or the lot ran out, since the draft was read
+			log.Printf("LOG: (DraftService: PlaceAuctionBid) - member %s's bid of %d in league %s lost a race\n", member.ID, input.Amount, league.ID)
+			return nil, s.lostBidError(league.ID)
+		}
+		log.Printf("ERROR: (DraftService: PlaceAuctionBid) - Failed to record bid for league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	draft.AuctionHighBid = input.Amount
+	draft.AuctionHighBidderID = &member.ID
+	draft.AuctionLotEndsAt = &lotEndsAt
+
+	taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeAuctionLotClose, league.ID)
+	s.schedulerService.DeregisterTask(taskIDToDeregister)
+	s.scheduleAuctionLotClose(draft)
+
+	s.publishAuctionLot(draft, types.DraftEventAuctionBid)
+	return draft, nil
+}
+
+// CloseAuctionLot is called by the SchedulerService when a lot's countdown runs out.
+// The high bidder is re-validated (budget and roster) and, if still eligible, drafts the pool entry
+// at their bid. Otherwise the lot goes unsold and the entry stays available.
+// Either way nomination moves on to the next member, or the draft completes if nobody can nominate.
+func (s *draftServiceImpl) CloseAuctionLot(leagueID, poolEntryID uuid.UUID) error {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - could not find league %s: %v\n", leagueID, err)
+		return types.ErrLeagueNotFound
+	}
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - could not fetch draft for league %s: %v\n", leagueID, err)
+		return err
+	}
+	if draft.Status != enums.DraftStatusOngoing || draft.AuctionPoolEntryID == nil || *draft.AuctionPoolEntryID != poolEntryID {
+		log.Printf("WARN: (DraftService: CloseAuctionLot) - lot for pool entry %s in league %s is no longer open. Ignoring.\n", poolEntryID, leagueID)
+		return nil
+	}
+
+	memberCount, err := s.memberRepo.GetCountByLeague(league.ID)
+	if err != nil || memberCount == 0 {
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - failed to get member count for league %s: %v\n", league.ID, err)
+		return types.ErrInternalService
+	}
+
+	winningBid := draft.AuctionHighBid
+	// no bid can land once the lot is off the clock; one that landed since it was read keeps it open
+	err = s.draftRepo.TakeAuctionLotForClose(draft.ID, poolEntryID, *draft.AuctionHighBidderID, winningBid, s.clock.Now())
+	if err != nil {
+		if errors.Is(err, types.ErrConflict) {
+			log.Printf("WARN: (DraftService: CloseAuctionLot) - lot for pool entry %s in league %s was bid on or closed meanwhile. Ignoring.\n", poolEntryID, leagueID)
+			return nil
+		}
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - could not take the lot in league %s off the clock: %v\n", leagueID, err)
+		return types.ErrInternalService
+	}
+
+	winner, err := s.memberRepo.GetByID(*draft.AuctionHighBidderID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - could not fetch high bidder %s: %v\n", *draft.AuctionHighBidderID, err)
+		return types.ErrInternalService
+	}
+
+	sold := false
+	poolEntry, err := s.fetchAuctionPoolEntry(league.ID, poolEntryID)
+	if err == nil {
+		err = s.validateAuctionBid(league, winner, poolEntry, winningBid)
+	}
+	if err != nil {
+		// e.g. staff changed the roster or points mid-lot; don't hand out a pick that breaks the rules
+		log.Printf("WARN: (DraftService: CloseAuctionLot) - lot for pool entry %s in league %s goes unsold: %v\n", poolEntryID, league.ID, err)
+	} else {
+		if err := s.executeAuctionWinTransaction(draft, league, winner, poolEntry, winningBid, memberCount); err != nil {
+			log.Printf("ERROR: (DraftService: CloseAuctionLot) - (member %s; league %s) transaction unsuccessful: %v\n", winner.ID, league.ID, err)
+			return err
+		}
+		sold = true
+		log.Printf("LOG: (DraftService: CloseAuctionLot) - Member %s won pool entry %s in league %s for %d\n", winner.ID, poolEntryID, league.ID, winningBid)
+	}
+
+	draft.AuctionPoolEntryID = nil
+	draft.AuctionHighBid = 0
+	draft.AuctionHighBidderID = nil
+	draft.AuctionLotEndsAt = nil
+	if sold {
+		draft.CurrentPickOnClock++ // pick numbers only count sold lots
+	}
+
+	draft, err = s.advanceAuctionNomination(draft, league, int(memberCount))
+	if err != nil {
+		log.Printf("ERROR: (DraftService: CloseAuctionLot) - could not advance draft for league %s: %v\n", league.ID, err)
+		return err
+	}
+
+	closedPayload := types.DraftEventAuctionLotClosedPayload{PoolEntryID: poolEntryID, Sold: sold}
+	if sold {
+		closedPayload.WinnerID = &winner.ID
+		closedPayload.WinningBid = winningBid
+		s.publishEvent(league.ID, types.DraftEventPickMade, types.DraftEventPickMadePayload{
+			MemberID:        winner.ID,
+			PoolEntryID:     poolEntryID,
+			DraftPickNumber: draft.CurrentPickOnClock - 1,
+			Cost:            winningBid,
+		})
+	}
+	s.publishEvent(league.ID, types.DraftEventAuctionLotClose, closedPayload)
+	s.publishNominationTurn(draft)
+
+	return nil
+}
+
+// passAuctionNomination hands the nomination from memberID to the next member without opening a lot.
+// Used when the member on the clock skips or their turn times out; no skip is consumed.
+// The draft is re-read here since the state may have moved on, and the turn is then taken off the clock
+// conditionally, so a skip racing the timeout passes the nomination only once; the loser gets ErrConflict.
+func (s *draftServiceImpl) passAuctionNomination(league *models.League, memberID uuid.UUID) (*models.Draft, error) {
+	draft, err := s.fetchDraftResource(league.ID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status != enums.DraftStatusOngoing {
+		return nil, types.ErrInvalidState
+	}
+	if draft.AuctionPoolEntryID != nil {
+		return nil, types.ErrAuctionLotOpen
+	}
+	if draft.CurrentTurnMemberID == nil || *draft.CurrentTurnMemberID != memberID {
+		return nil, types.ErrUnauthorized
+	}
+	if err := s.draftRepo.TakeAuctionNominationForPass(draft.ID, memberID); err != nil {
+		if errors.Is(err, types.ErrConflict) {
+			return nil, types.ErrConflict
+		}
+		log.Printf("ERROR: (DraftService: passAuctionNomination) - could not take the nomination of member %s off the clock: %v\n", memberID, err)
+		return nil, types.ErrInternalService
+	}
+	draft.AuctionPasses++
+
+	memberCount, err := s.memberRepo.GetCountByLeague(league.ID)
+	if err != nil || memberCount == 0 {
+		log.Printf("ERROR: (DraftService: passAuctionNomination) - failed to get member count for league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+
+	draft, err = s.advanceAuctionNomination(draft, league, int(memberCount))
+	if err != nil {
+		return nil, err
+	}
+	s.publishNominationTurn(draft)
+	return draft, nil
+}
+
+// advanceAuctionNomination puts the next member who can nominate on the clock and persists the draft.
+// The draft completes instead if no member can nominate anything (see canNominate), and pauses, with
+// that member on the clock, once a full lap of them has passed in a row.
+// The caller publishes the resulting events (see publishNominationTurn).
+func (s *draftServiceImpl) advanceAuctionNomination(draft *models.Draft, league *models.League, memberCount int) (*models.Draft, error) {
+	allMembers, err := s.memberRepo.GetByLeague(league.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: advanceAuctionNomination) - Could not get all members in league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	available, err := s.poolEntryRepo.GetAvailableByLeague(league.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: advanceAuctionNomination) - Could not get available pool entries in league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+
+	currentMemberIdx := 0
+	for i, m := range allMembers {
+		if draft.CurrentTurnMemberID != nil && m.ID == *draft.CurrentTurnMemberID {
+			currentMemberIdx = i
+			break
+		}
+	}
+
+	var nextNominator *models.LeagueMember
+	nominators := 0
+	for offset := 1; offset <= len(allMembers); offset++ {
+		candidate := &allMembers[(currentMemberIdx+offset)%len(allMembers)]
+		ok, err := s.canNominate(league, candidate, available)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		nominators++
+		if nextNominator == nil {
+			nextNominator = candidate
+		}
+	}
+
+	if nextNominator == nil {
+		return s.completeDraft(draft, league)
+	}
+
+	draft.CurrentRound = ((draft.CurrentPickOnClock - 1) / memberCount) + 1
+	draft.CurrentPickInRound = ((draft.CurrentPickOnClock - 1) % memberCount) + 1
+	draft.CurrentTurnMemberID = &nextNominator.ID
+	draft.CurrentTurnStartTime = func() *time.Time { t := s.clock.Now(); return &t }()
+	stalled := draft.AuctionPasses >= nominators
+	if stalled {
+		// ResumeDraft starts the next lap afresh
+		log.Printf("LOG: (DraftService: advanceAuctionNomination) - all %d members who can nominate in league %s passed; pausing the draft\n", nominators, league.ID)
+		draft.Status = enums.DraftStatusPaused
+	}
+
+	draft, err = s.draftRepo.UpdateDraft(draft)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: advanceAuctionNomination) - Failed to update draft: %v\n", err)
+		return nil, types.ErrInternalService
+	}
+	if !stalled {
+		s.scheduleTurnTimeout(draft)
+	}
+	return draft, nil
+}
+
+// canNominate reports whether member could open a lot: whether any of the available pool entries
+// would take an opening bid of 1 from them under validateAuctionBid's rules. The roster and tiers
+// are read once for all the entries.
+func (s *draftServiceImpl) canNominate(league *models.League, member *models.LeagueMember, available []models.PoolEntry) (bool, error) {
+	if member.DraftPoints < 1 || len(available) == 0 {
+		return false, nil
+	}
+	rosterSize, err := s.claimRepo.GetActiveCountByPlayer(member.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: canNominate) - Failed to get roster count for member %s: %v\n", member.ID, err)
+		return false, types.ErrInternalService
+	}
+	if rosterSize >= int64(league.MaxPokemonPerPlayer) {
+		return false, nil
+	}
+	if slotsStillNeeded := league.MinPokemonPerPlayer - int(rosterSize) - 1; slotsStillNeeded > 0 && member.DraftPoints-1 < slotsStillNeeded {
+		return false, nil
+	}
+	if !league.Format.HasTiers() {
+		return true, nil
+	}
+	roster, err := loadRosterTiers(s.claimRepo, s.poolEntryRepo, league, member.ID)
+	if err != nil {
+		return false, err
+	}
+	for i := range available {
+		if roster.check([]*models.PoolEntry{&available[i]}, nil) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// executeAuctionWinTransaction records a won lot: the DraftPick, the pool entry marked unavailable,
+// the winner's points reduced by their bid and a Claim with CostPaid equal to the bid.
+func (s *draftServiceImpl) executeAuctionWinTransaction(
+	draft *models.Draft,
+	league *models.League,
+	winner *models.LeagueMember,
+	poolEntry *models.PoolEntry,
+	winningBid int,
+	memberCount int64,
+) error {
+	draftPicks := []models.DraftPick{{
+		DraftID:     draft.ID,
+		PlayerID:    winner.ID,
+		PoolEntryID: poolEntry.ID,
+		RoundNumber: ((draft.CurrentPickOnClock - 1) / int(memberCount)) + 1,
+		PickNumber:  draft.CurrentPickOnClock,
+	}}
+
+	return s.executeWithTransaction(func(txRepo *transactionalRepositories) error {
+		if err := txRepo.draftPickRepo.CreateBatch(draftPicks); err != nil {
+			return err
+		}
+		if err := txRepo.poolEntryRepo.MarkUnavailable(nil, poolEntry.ID); err != nil {
+			return err
+		}
+
+		winner.DraftPoints -= winningBid
+		if _, err := txRepo.memberRepo.Update(winner); err != nil {
+			return err
+		}
+
+		claim := &models.Claim{
+			LeagueID:     league.ID,
+			PlayerID:     winner.ID,
+			SpeciesID:    poolEntry.PokemonSpeciesID,
+			Source:       enums.ClaimSourceDraft,
+			SourceID:     &draftPicks[0].ID,
+			CostPaid:     winningBid,
+			AcquiredWeek: 0, // Pre-season draft week
+			IsActive:     true,
+		}
+		if _, err := txRepo.claimRepo.Create(claim); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// validateAuctionBid applies the draft's budget and roster rules to a bid on poolEntry: the member must
+// have room under MaxPokemonPerPlayer, be able to pay the bid, keep at least one point for every slot
+// they still need to reach MinPokemonPerPlayer after winning this one, and stay within the league's
+// tier limits.
+func (s *draftServiceImpl) validateAuctionBid(league *models.League, member *models.LeagueMember, poolEntry *models.PoolEntry, amount int) error {
+	rosterSize, err := s.claimRepo.GetActiveCountByPlayer(member.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: validateAuctionBid) - Failed to get roster count for member %s: %v\n", member.ID, err)
+		return types.ErrInternalService
+	}
+	if rosterSize >= int64(league.MaxPokemonPerPlayer) {
+		return types.ErrAboveMaxPokemon
+	}
+	if amount > member.DraftPoints {
+		return types.ErrInsufficientDraftPoints
+	}
+
+	slotsStillNeeded := league.MinPokemonPerPlayer - int(rosterSize) - 1
+	if slotsStillNeeded > 0 && member.DraftPoints-amount < slotsStillNeeded {
+		return types.ErrInsufficientDraftPoints
+	}
+	return checkRosterTierLimits(s.claimRepo, s.poolEntryRepo, league, member.ID, []*models.PoolEntry{poolEntry}, nil)
+}
+
+// fetchAuctionResources loads the league, draft and member for a nomination or bid and checks that
+// the league runs an ongoing auction draft.
+func (s *draftServiceImpl) fetchAuctionResources(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+) (*models.League, *models.Draft, *models.LeagueMember, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		return nil, nil, nil, types.ErrLeagueNotFound
+	}
+	if !league.Format.IsAuctionDraft() {
+		return nil, nil, nil, types.ErrNotAuctionDraft
+	}
+
+	draft, err := s.fetchDraftResource(league.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if isValidStatus := s.validateLeagueStatusForPick(league.Status, draft.Status); !isValidStatus {
+		return nil, nil, nil, types.ErrInvalidState
+	}
+
+	member, err := s.fetchMemberResource(currentUser.ID, league.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return league, draft, member, nil
+}
+
+// fetchAuctionPoolEntry returns the pool entry if it belongs to the league and is still available.
+func (s *draftServiceImpl) fetchAuctionPoolEntry(leagueID, poolEntryID uuid.UUID) (*models.PoolEntry, error) {
+	poolEntry, err := s.poolEntryRepo.GetByID(poolEntryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPoolEntryNotFound
+		}
+		return nil, types.ErrInternalService
+	}
+	if poolEntry.LeagueID != leagueID {
+		return nil, types.ErrPoolEntryNotFound
+	}
+	if !poolEntry.IsAvailable {
+		return nil, types.ErrConflict
+	}
+	return poolEntry, nil
+}
+
+// lostBidError tells a bidder why their bid didn't land once the draft moved on under it: the lot
+// closed, or someone bid at least as much first.
+func (s *draftServiceImpl) lostBidError(leagueID uuid.UUID) error {
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		return err
+	}
+	if draft.AuctionPoolEntryID == nil || draft.AuctionLotEndsAt == nil || !s.clock.Now().Before(*draft.AuctionLotEndsAt) {
+		return types.ErrNoAuctionLotOpen
+	}
+	return types.ErrBidTooLow
+}
+
+// auctionBidTime is how long a lot stays open after its latest bid.
+func auctionBidTime(league *models.League) time.Duration {
+	seconds := league.Format.AuctionBidTimeSeconds
+	if seconds <= 0 {
+		seconds = defaultAuctionBidTimeSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// scheduleAuctionLotClose registers the task that closes the open lot when its countdown runs out.
+func (s *draftServiceImpl) scheduleAuctionLotClose(draft *models.Draft) {
+	taskType := utils.TaskTypeAuctionLotClose
+	task := &utils.ScheduledTask{
+		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
+		ExecuteAt: *draft.AuctionLotEndsAt,
+		Type:      taskType,
+		Payload: utils.PayloadAuctionLotClose{
+			LeagueID:    draft.LeagueID,
+			PoolEntryID: *draft.AuctionPoolEntryID,
+		},
+	}
+	s.schedulerService.RegisterTask(task)
+}
+
+// publishAuctionLot emits the state of the open lot.
+func (s *draftServiceImpl) publishAuctionLot(draft *models.Draft, eventType types.DraftEventType) {
+	if draft.AuctionPoolEntryID == nil || draft.AuctionHighBidderID == nil || draft.AuctionLotEndsAt == nil {
+		return
+	}
+	s.publishEvent(draft.LeagueID, eventType, types.DraftEventAuctionLotPayload{
+		PoolEntryID:  *draft.AuctionPoolEntryID,
+		HighBid:      draft.AuctionHighBid,
+		HighBidderID: *draft.AuctionHighBidderID,
+		LotEndsAt:    *draft.AuctionLotEndsAt,
+	})
+}
+
+// publishNominationTurn emits DRAFT_COMPLETED, DRAFT_PAUSED or TURN_CHANGED after advanceAuctionNomination.
+func (s *draftServiceImpl) publishNominationTurn(draft *models.Draft) {
+	switch draft.Status {
+	case enums.DraftStatusCompleted:
+		s.publishEvent(draft.LeagueID, types.DraftEventDraftCompleted, types.DraftEventDraftCompletedPayload{EndTime: draft.EndTime})
+	case enums.DraftStatusPaused:
+		s.publishEvent(draft.LeagueID, types.DraftEventDraftPaused, types.DraftEventDraftPausedPayload{
+			MemberID: draft.CurrentTurnMemberID,
+			Reason:   "every member who can nominate passed in a row",
+		})
+	default:
+		s.publishTurnChanged(draft)
+	}
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	// number of past events kept per league so reconnecting clients can resume
+	draftEventBacklogSize = 256
+	// events buffered per subscriber before it is considered too slow and dropped
+	draftEventSubscriberBuffer = 32
+)
+
+// DraftEventService fans out draft state changes to clients listening on a league's event stream.
+// Events only live in memory; a client that reconnects after a restart (or after falling out of the
+// backlog) receives a RESYNC event and is expected to refetch the draft.
+//
+// With several instances, events must travel through a repositories.DraftEventBus (SetEventBus): the
+// draft can change on any of them (the scheduler leader runs turn timeouts, for one) while a client
+// streams from another. Every instance then receives every event, IDs come from the bus so they mean
+// the same on all of them, and a client resumes on whichever instance it reconnects to. Without a bus,
+// events and their IDs stay within the process, which only suits a single instance (and tests).
+type DraftEventService interface {
+	// Publish appends an event to the league's stream and pushes it to every subscriber.
+	Publish(leagueID uuid.UUID, eventType types.DraftEventType, payload any) types.DraftEvent
+	// Subscribe registers a listener for a league. Events after lastEventID that are still buffered
+	// are returned as the backlog; lastEventID 0 means a fresh connection with no replay.
+	// The returned channel is closed when unsubscribe is called or the subscriber falls behind.
+	Subscribe(leagueID uuid.UUID, lastEventID uint64) (backlog []types.DraftEvent, events <-chan types.DraftEvent, unsubscribe func())
+	// Close ends every subscription so open event streams return, e.g. for the server to shut down.
+	// Their clients reconnect, to another instance during a deploy. It also stops listening on the bus.
+	Close()
+	// SetEventBus sends published events through bus and streams the events bus delivers, from this
+	// instance or any other. It starts listening straight away; call it once, before publishing.
+	SetEventBus(bus repositories.DraftEventBus)
+	SetClock(clock utils.Clock)
+}
+
+type leagueDraftEventStream struct {
+	lastID      uint64
+	backlog     []types.DraftEvent // oldest first, capped at draftEventBacklogSize
+	subscribers map[chan types.DraftEvent]struct{}
+	// a client that has seen this ID (or a later one up to lastID) can be replayed from the backlog;
+	// before it, events were evicted or never received
+	replayableFrom uint64
+}
+
+type draftEventServiceImpl struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]*leagueDraftEventStream
+	clock   utils.Clock
+
+	bus        repositories.DraftEventBus
+	stopListen context.CancelFunc
+	// every event after this ID has been received from the bus
+	listeningFrom uint64
+}
+
+func NewDraftEventService() DraftEventService {
+	return &draftEventServiceImpl{
+		streams: make(map[uuid.UUID]*leagueDraftEventStream),
+		clock:   utils.SystemClock,
+	}
+}
+
+// stream returns the stream for a league, creating it if needed. Caller must hold s.mu.
+func (s *draftEventServiceImpl) stream(leagueID uuid.UUID) *leagueDraftEventStream {
+	stream, exists := s.streams[leagueID]
+	if !exists {
+		stream = &leagueDraftEventStream{
+			lastID:         s.listeningFrom,
+			subscribers:    make(map[chan types.DraftEvent]struct{}),
+			replayableFrom: s.listeningFrom,
+		}
+		s.streams[leagueID] = stream
+	}
+	return stream
+}
+
+func (s *draftEventServiceImpl) SetEventBus(bus repositories.DraftEventBus) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mu.Lock()
+	s.bus = bus
+	s.stopListen = cancel
+	s.mu.Unlock()
+	go bus.Listen(ctx, s.startedListening, s.receive)
+}
+
+// SetClock replaces the wall clock that events are timestamped with.
+func (s *draftEventServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
+func (s *draftEventServiceImpl) Publish(leagueID uuid.UUID, eventType types.DraftEventType, payload any) types.DraftEvent {
+	event := types.DraftEvent{
+		LeagueID:  leagueID,
+		Type:      eventType,
+		Payload:   payload,
+		Timestamp: s.clock.Now(),
+	}
+
+	s.mu.Lock()
+	bus := s.bus
+	if bus == nil {
+		defer s.mu.Unlock()
+		event.ID = s.stream(leagueID).lastID + 1
+		s.deliver(event)
+		return event
+	}
+	s.mu.Unlock()
+
+	// the event reaches this instance's subscribers like any other instance's, through receive
+	id, err := bus.Publish(func(id uint64) ([]byte, error) {
+		event.ID = id
+		return encodeBusEvent(event)
+	})
+	if err != nil {
+		// the event has no ID to stream under; clients here are told to refetch instead
+		log.Printf("ERROR: (DraftEventService: Publish) - could not publish %s event for league %s: %v\n", eventType, leagueID, err)
+		s.mu.Lock()
+		s.resyncSubscribers(leagueID)
+		s.mu.Unlock()
+		event.ID = 0
+		return event
+	}
+	event.ID = id
+	return event
+}
+
+// encodeBusEvent encodes an event for the bus. An event too large for it goes as a RESYNC under the
+// same ID, so its clients refetch the draft instead.
+func encodeBusEvent(event types.DraftEvent) ([]byte, error) {
+	encoded, err := json.Marshal(event)
+	if err != nil || len(encoded) <= repositories.MaxDraftEventSize {
+		return encoded, err
+	}
+	log.Printf("WARN: (DraftEventService: encodeBusEvent) - %s event %d for league %s is %d bytes; sending a resync instead\n", event.Type, event.ID, event.LeagueID, len(encoded))
+	event.Type = types.DraftEventResync
+	event.Payload = nil
+	return json.Marshal(event)
+}
+
+// receive handles an event delivered by the bus. Payloads stay encoded, as the stream re-encodes them.
+func (s *draftEventServiceImpl) receive(payload []byte) {
+	var received struct {
+		types.DraftEvent
+		Payload json.RawMessage `json:"Payload,omitempty"`
+	}
+	if err := json.Unmarshal(payload, &received); err != nil {
+		log.Printf("ERROR: (DraftEventService: receive) - could not decode draft event: %v\n", err)
+		return
+	}
+	event := received.DraftEvent
+	if len(received.Payload) > 0 {
+		event.Payload = received.Payload
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.ID <= s.stream(event.LeagueID).lastID {
+		return // already have it
+	}
+	s.deliver(event)
+}
+
+// startedListening is called by the bus whenever it (re)starts listening. Events published while it
+// wasn't are lost, so every stream starts over: subscribers are dropped to reconnect and resync.
+func (s *draftEventServiceImpl) startedListening(lastID uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeningFrom = lastID
+	for leagueID := range s.streams {
+		s.resetStream(leagueID, lastID)
+	}
+	log.Printf("LOG: (DraftEventService: startedListening) - Listening for draft events after event %d\n", lastID)
+}
+
+// resetStream forgets the league's backlog and drops its subscribers, so they reconnect and refetch
+// the draft; nothing up to lastID is replayable anymore. Caller must hold s.mu.
+func (s *draftEventServiceImpl) resetStream(leagueID uuid.UUID, lastID uint64) {
+	stream := s.stream(leagueID)
+	stream.lastID = max(stream.lastID, lastID)
+	stream.replayableFrom = stream.lastID
+	stream.backlog = nil
+	for sub := range stream.subscribers {
+		delete(stream.subscribers, sub)
+		close(sub)
+	}
+}
+
+// resyncSubscribers sends the league's current subscribers a RESYNC under the last ID they saw, without
+// recording it in the stream. Caller must hold s.mu.
+func (s *draftEventServiceImpl) resyncSubscribers(leagueID uuid.UUID) {
+	stream := s.stream(leagueID)
+	resync := types.DraftEvent{ID: stream.lastID, LeagueID: leagueID, Type: types.DraftEventResync, Timestamp: s.clock.Now()}
+	for sub := range stream.subscribers {
+		select {
+		case sub <- resync:
+		default:
+			delete(stream.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+// deliver appends the event to its league's stream and pushes it to every subscriber. Caller must hold s.mu.
+func (s *draftEventServiceImpl) deliver(event types.DraftEvent) {
+	stream := s.stream(event.LeagueID)
+	stream.lastID = event.ID
+	stream.backlog = append(stream.backlog, event)
+	if len(stream.backlog) > draftEventBacklogSize {
+		evicted := len(stream.backlog) - draftEventBacklogSize
+		stream.replayableFrom = stream.backlog[evicted-1].ID
+		stream.backlog = stream.backlog[evicted:]
+	}
+
+	for sub := range stream.subscribers {
+		select {
+		case sub <- event:
+		default:
+			// subscriber is not keeping up; drop it so it reconnects and resumes from the backlog
+			log.Printf("WARN: (DraftEventService: deliver) - Dropping slow subscriber on league %s at event %d\n", event.LeagueID, event.ID)
+			delete(stream.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+func (s *draftEventServiceImpl) Subscribe(leagueID uuid.UUID, lastEventID uint64) ([]types.DraftEvent, <-chan types.DraftEvent, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stream := s.stream(leagueID)
+	sub := make(chan types.DraftEvent, draftEventSubscriberBuffer)
+	stream.subscribers[sub] = struct{}{}
+
+	var backlog []types.DraftEvent
+	if lastEventID != 0 {
+		backlog = s.replayFrom(stream, leagueID, lastEventID)
+	}
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, exists := stream.subscribers[sub]; exists {
+			delete(stream.subscribers, sub)
+			close(sub)
+		}
+	}
+
+	return backlog, sub, unsubscribe
+}
+
+func (s *draftEventServiceImpl) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopListen != nil {
+		s.stopListen()
+	}
+
+	closed := 0
+	for _, stream := range s.streams {
+		for sub := range stream.subscribers {
+			delete(stream.subscribers, sub)
+			close(sub)
+			closed++
+		}
+	}
+	log.Printf("LOG: (DraftEventService: Close) - Closed %d subscription(s)\n", closed)
+}
+
+// replayFrom returns the buffered events after lastEventID, or a single RESYNC event
+// if the requested position is unknown (evicted from the backlog, from before a restart or not
+// received here yet). Caller must hold s.mu.
+func (s *draftEventServiceImpl) replayFrom(stream *leagueDraftEventStream, leagueID uuid.UUID, lastEventID uint64) []types.DraftEvent {
+	if lastEventID == stream.lastID {
+		return nil // already up to date
+	}
+
+	if lastEventID > stream.lastID || lastEventID < stream.replayableFrom {
+		return []types.DraftEvent{{
+			ID:        stream.lastID,
+			LeagueID:  leagueID,
+			Type:      types.DraftEventResync,
+			Timestamp: s.clock.Now(),
+		}}
+	}
+
+	var backlog []types.DraftEvent
+	for _, event := range stream.backlog {
+		if event.ID > lastEventID {
+			backlog = append(backlog, event)
+		}
+	}
+	return backlog
+}
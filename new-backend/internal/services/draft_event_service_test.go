@@ -0,0 +1,245 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDraftEventService_PublishSubscribe(t *testing.T) {
+	t.Run("Subscriber receives published events in order", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		backlog, events, unsubscribe := service.Subscribe(leagueID, 0)
+		defer unsubscribe()
+		assert.Empty(t, backlog)
+
+		service.Publish(leagueID, types.DraftEventPickMade, nil)
+		service.Publish(leagueID, types.DraftEventTurnChanged, nil)
+
+		first := <-events
+		second := <-events
+		assert.Equal(t, uint64(1), first.ID)
+		assert.Equal(t, types.DraftEventPickMade, first.Type)
+		assert.Equal(t, uint64(2), second.ID)
+		assert.Equal(t, types.DraftEventTurnChanged, second.Type)
+	})
+
+	t.Run("Events are scoped to their league", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		_, events, unsubscribe := service.Subscribe(leagueID, 0)
+		defer unsubscribe()
+
+		service.Publish(uuid.New(), types.DraftEventPickMade, nil)
+
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected event from another league: %+v", event)
+		default:
+		}
+	})
+
+	t.Run("Unsubscribe closes the channel", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		_, events, unsubscribe := service.Subscribe(leagueID, 0)
+		unsubscribe()
+		unsubscribe() // safe to call twice
+
+		_, open := <-events
+		assert.False(t, open)
+	})
+
+	t.Run("Close ends every subscription", func(t *testing.T) {
+		service := services.NewDraftEventService()
+
+		_, first, unsubscribeFirst := service.Subscribe(uuid.New(), 0)
+		_, second, unsubscribeSecond := service.Subscribe(uuid.New(), 0)
+		service.Close()
+		unsubscribeFirst() // the streams' deferred unsubscribe still runs after Close
+		unsubscribeSecond()
+
+		_, firstOpen := <-first
+		_, secondOpen := <-second
+		assert.False(t, firstOpen)
+		assert.False(t, secondOpen)
+	})
+}
+
+func TestDraftEventService_Resume(t *testing.T) {
+	t.Run("Replays events after the last seen ID", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		service.Publish(leagueID, types.DraftEventPickMade, nil)
+		service.Publish(leagueID, types.DraftEventPickAccumulated, nil)
+		service.Publish(leagueID, types.DraftEventTurnChanged, nil)
+
+		backlog, _, unsubscribe := service.Subscribe(leagueID, 1)
+		defer unsubscribe()
+
+		assert.Len(t, backlog, 2)
+		assert.Equal(t, uint64(2), backlog[0].ID)
+		assert.Equal(t, uint64(3), backlog[1].ID)
+	})
+
+	t.Run("Up to date client gets no backlog", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		service.Publish(leagueID, types.DraftEventPickMade, nil)
+
+		backlog, _, unsubscribe := service.Subscribe(leagueID, 1)
+		defer unsubscribe()
+		assert.Empty(t, backlog)
+	})
+
+	t.Run("Unknown last event ID gets a resync", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		// e.g. the server restarted and the client still holds an ID from before
+		backlog, _, unsubscribe := service.Subscribe(leagueID, 42)
+		defer unsubscribe()
+
+		assert.Len(t, backlog, 1)
+		assert.Equal(t, types.DraftEventResync, backlog[0].Type)
+	})
+
+	t.Run("Evicted last event ID gets a resync", func(t *testing.T) {
+		service := services.NewDraftEventService()
+		leagueID := uuid.New()
+
+		for range 300 {
+			service.Publish(leagueID, types.DraftEventTurnChanged, nil)
+		}
+
+		backlog, _, unsubscribe := service.Subscribe(leagueID, 2)
+		defer unsubscribe()
+
+		assert.Len(t, backlog, 1)
+		assert.Equal(t, types.DraftEventResync, backlog[0].Type)
+		assert.Equal(t, uint64(300), backlog[0].ID)
+	})
+}
+
+// loopbackEventBus stands in for Postgres LISTEN/NOTIFY between the instances of a test.
+type loopbackEventBus struct {
+	mu       sync.Mutex
+	lastID   uint64
+	handlers map[int]func(payload []byte)
+	nextKey  int
+}
+
+func newLoopbackEventBus() *loopbackEventBus {
+	return &loopbackEventBus{handlers: make(map[int]func(payload []byte))}
+}
+
+func (b *loopbackEventBus) Publish(encode func(id uint64) ([]byte, error)) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastID++
+	payload, err := encode(b.lastID)
+	if err != nil {
+		return 0, err
+	}
+	for _, handle := range b.handlers {
+		handle(payload)
+	}
+	return b.lastID, nil
+}
+
+func (b *loopbackEventBus) Listen(ctx context.Context, onListen func(lastID uint64), handle func(payload []byte)) {
+	b.mu.Lock()
+	key := b.nextKey
+	b.nextKey++
+	b.handlers[key] = handle
+	onListen(b.lastID)
+	b.mu.Unlock()
+
+	<-ctx.Done()
+	b.mu.Lock()
+	delete(b.handlers, key)
+	b.mu.Unlock()
+}
+
+func (b *loopbackEventBus) listeners() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.handlers)
+}
+
+func TestDraftEventService_EventBus(t *testing.T) {
+	// two instances sharing a bus; newInstances waits until both are listening
+	newInstances := func(t *testing.T, bus *loopbackEventBus) (services.DraftEventService, services.DraftEventService) {
+		first, second := services.NewDraftEventService(), services.NewDraftEventService()
+		first.SetEventBus(bus)
+		second.SetEventBus(bus)
+		t.Cleanup(first.Close)
+		t.Cleanup(second.Close)
+		assert.Eventually(t, func() bool { return bus.listeners() == 2 }, time.Second, time.Millisecond)
+		return first, second
+	}
+
+	t.Run("Events published on one instance reach subscribers on another", func(t *testing.T) {
+		bus := newLoopbackEventBus()
+		leagueID := uuid.New()
+		bus.lastID = 41 // IDs carry on from earlier runs
+		first, second := newInstances(t, bus)
+
+		_, events, unsubscribe := second.Subscribe(leagueID, 0)
+		defer unsubscribe()
+
+		published := first.Publish(leagueID, types.DraftEventTurnChanged, types.DraftEventDraftPausedPayload{Reason: "staff"})
+
+		received := <-events
+		assert.Equal(t, uint64(42), published.ID)
+		assert.Equal(t, published.ID, received.ID)
+		assert.Equal(t, types.DraftEventTurnChanged, received.Type)
+		assert.JSONEq(t, `{"Reason":"staff"}`, string(received.Payload.(json.RawMessage)))
+	})
+
+	t.Run("A client resumes on another instance with the IDs it saw", func(t *testing.T) {
+		bus := newLoopbackEventBus()
+		leagueID := uuid.New()
+		first, second := newInstances(t, bus)
+
+		seen := first.Publish(leagueID, types.DraftEventPickMade, nil)
+		first.Publish(uuid.New(), types.DraftEventPickMade, nil) // another league's event takes ID 2
+		missed := first.Publish(leagueID, types.DraftEventTurnChanged, nil)
+
+		backlog, _, unsubscribe := second.Subscribe(leagueID, seen.ID)
+		defer unsubscribe()
+
+		if assert.Len(t, backlog, 1) {
+			assert.Equal(t, missed.ID, backlog[0].ID)
+			assert.Equal(t, types.DraftEventTurnChanged, backlog[0].Type)
+		}
+	})
+
+	t.Run("An instance can't replay events from before it started listening", func(t *testing.T) {
+		bus := newLoopbackEventBus()
+		bus.lastID = 10
+		leagueID := uuid.New()
+		_, second := newInstances(t, bus)
+
+		backlog, _, unsubscribe := second.Subscribe(leagueID, 7)
+		defer unsubscribe()
+
+		if assert.Len(t, backlog, 1) {
+			assert.Equal(t, types.DraftEventResync, backlog[0].Type)
+			assert.Equal(t, uint64(10), backlog[0].ID)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+)
+
+// draftOrderStrategy decides who a pick slot is dealt to within a round. Slots are always dealt one per
+// member per round, so CurrentRound/CurrentPickInRound follow from the overall pick number alone and
+// every strategy keeps that bookkeeping the same; only which member holds each slot changes.
+type draftOrderStrategy interface {
+	// slotIndex returns the index, into members ordered by DraftPosition, of the member dealt
+	// pick pickInRound (1-based) of round (1-based).
+	slotIndex(round, pickInRound, memberCount int) int
+}
+
+type linearDraftOrder struct{}
+
+func (linearDraftOrder) slotIndex(round, pickInRound, memberCount int) int {
+	return pickInRound - 1
+}
+
+type snakeDraftOrder struct{}
+
+func (snakeDraftOrder) slotIndex(round, pickInRound, memberCount int) int {
+	if round%2 == 0 { // Even round (reverse order)
+		return memberCount - pickInRound
+	}
+	return pickInRound - 1
+}
+
+// thirdRoundReversalDraftOrder runs 1..n, n..1, n..1, 1..n, n..1, ... so the member picking last in
+// the first two rounds picks first in the third.
+type thirdRoundReversalDraftOrder struct{}
+
+func (thirdRoundReversalDraftOrder) slotIndex(round, pickInRound, memberCount int) int {
+	reversed := round == 2 || (round >= 3 && round%2 == 1)
+	if reversed {
+		return memberCount - pickInRound
+	}
+	return pickInRound - 1
+}
+
+// customDraftOrder follows the positions league staff set for each round (LeagueFormat.CustomDraftOrder).
+type customDraftOrder struct {
+	rounds [][]int
+}
+
+func (o customDraftOrder) slotIndex(round, pickInRound, memberCount int) int {
+	if round < 1 || round > len(o.rounds) || pickInRound < 1 || pickInRound > len(o.rounds[round-1]) {
+		return -1 // callers treat an out of range index as an invalid order
+	}
+	return o.rounds[round-1][pickInRound-1] - 1
+}
+
+// draftOrderFor returns the strategy for the league's DraftRoundOrder.
+func draftOrderFor(league *models.League) draftOrderStrategy {
+	switch league.Format.RoundOrder() {
+	case enums.DraftRoundOrderSnake:
+		return snakeDraftOrder{}
+	case enums.DraftRoundOrderThirdRoundReversal:
+		return thirdRoundReversalDraftOrder{}
+	case enums.DraftRoundOrderCustom:
+		return customDraftOrder{rounds: league.Format.CustomDraftOrder}
+	default:
+		return linearDraftOrder{}
+	}
+}
+
+// naturalSlotIndex returns the index (into members ordered by DraftPosition) of the member a pick slot
+// was originally dealt to, following the league's round order.
+func naturalSlotIndex(league *models.League, round, pickInRound, memberCount int) int {
+	return draftOrderFor(league).slotIndex(round, pickInRound, memberCount)
+}
+
+// slotPickInRound is the inverse of naturalSlotIndex: where in round the member at draftPosition picks.
+// Returns 0 if the order doesn't give that position a slot in the round.
+func slotPickInRound(league *models.League, round, draftPosition, memberCount int) int {
+	order := draftOrderFor(league)
+	for pickInRound := 1; pickInRound <= memberCount; pickInRound++ {
+		if order.slotIndex(round, pickInRound, memberCount) == draftPosition-1 {
+			return pickInRound
+		}
+	}
+	return 0
+}
+
+// validateCustomDraftOrder checks a staff supplied order: at least one entry per round the draft can
+// run, and each round a permutation of the draft positions 1..memberCount.
+func validateCustomDraftOrder(rounds [][]int, memberCount, roundCount int) error {
+	if len(rounds) < roundCount {
+		return fmt.Errorf("%w: %d rounds given, %d needed", types.ErrIncompleteDraftOrder, len(rounds), roundCount)
+	}
+	for i, positions := range rounds {
+		if len(positions) != memberCount {
+			return fmt.Errorf("%w: round %d has %d picks for %d members", types.ErrIncompleteDraftOrder, i+1, len(positions), memberCount)
+		}
+		seen := make([]bool, memberCount)
+		for _, pos := range positions {
+			if pos < 1 || pos > memberCount {
+				return fmt.Errorf("%w: round %d has position %d", types.ErrInvalidDraftPosition, i+1, pos)
+			}
+			if seen[pos-1] {
+				return fmt.Errorf("%w: round %d has position %d twice", types.ErrDuplicateDraftPosition, i+1, pos)
+			}
+			seen[pos-1] = true
+		}
+	}
+	return nil
+}
+
+// orderByReverseStandings sorts members worst record first, the reverse of playoff seeding.
+func orderByReverseStandings(members []models.LeagueMember) {
+	sortMembers(members)
+	slices.Reverse(members)
+}
+
+// drawDraftLottery weights members by the previous season's final standings and draws the draft order.
+// Returning members are ranked best record first and weighted by that rank, so the previous season's
+// last place has the most tickets and its winner one. Members new to the league get the middle weight.
+// Without a previous season every member has one ticket, i.e. a plain (but still recorded) random draw.
+func (s *draftServiceImpl) drawDraftLottery(league *models.League, members []models.LeagueMember) (*models.DraftLottery, error) {
+	rankByUser := make(map[uuid.UUID]int)
+	if league.PreviousSeasonLeagueID != nil {
+		previousMembers, err := s.memberRepo.GetByLeague(*league.PreviousSeasonLeagueID)
+		if err != nil {
+			log.Printf("LOG: (DraftService: drawDraftLottery) - could not fetch previous season %s of league %s: %v\n", *league.PreviousSeasonLeagueID, league.ID, err)
+			return nil, types.ErrInternalService
+		}
+		sortMembers(previousMembers)
+		for i, m := range previousMembers {
+			rankByUser[m.UserID] = i + 1
+		}
+	}
+	newcomerWeight := (len(rankByUser) + 2) / 2 // the middle rank, rounded up; 1 with no previous season
+
+	lottery := &models.DraftLottery{
+		Seed:    s.clock.Now().UnixNano(),
+		Entries: make([]models.DraftLotteryEntry, 0, len(members)),
+	}
+	for _, m := range members {
+		weight, ok := rankByUser[m.UserID]
+		if !ok {
+			weight = newcomerWeight
+		}
+		lottery.Entries = append(lottery.Entries, models.DraftLotteryEntry{MemberID: m.ID, Weight: weight})
+	}
+	slices.SortFunc(lottery.Entries, func(a, b models.DraftLotteryEntry) int {
+		return strings.Compare(a.MemberID.String(), b.MemberID.String())
+	})
+	lottery.DrawnOrder = lottery.Draw()
+	return lottery, nil
+}
+
+// orderByLottery returns members in the lottery's drawn order.
+func orderByLottery(members []models.LeagueMember, lottery *models.DraftLottery) []models.LeagueMember {
+	byID := make(map[uuid.UUID]models.LeagueMember, len(members))
+	for _, m := range members {
+		byID[m.ID] = m
+	}
+	ordered := make([]models.LeagueMember, 0, len(members))
+	for _, memberID := range lottery.DrawnOrder {
+		ordered = append(ordered, byID[memberID])
+	}
+	return ordered
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"slices"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DraftQueueService manages each league member's private, pre-ranked draft queue.
+// The queue is consumed by DraftService.AutoSkipTurn when the member's turn times out.
+type DraftQueueService interface {
+	GetQueue(currentUser *models.User, leagueID uuid.UUID) ([]models.DraftQueueEntry, error)
+	SetQueue(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftQueueSetRequestDTO) ([]models.DraftQueueEntry, error)
+	AddToQueue(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftQueueAddRequestDTO) ([]models.DraftQueueEntry, error)
+	RemoveFromQueue(currentUser *models.User, leagueID uuid.UUID, poolEntryID uuid.UUID) ([]models.DraftQueueEntry, error)
+}
+
+type draftQueueServiceImpl struct {
+	draftQueueRepo repositories.DraftQueueRepository
+	memberRepo     repositories.LeagueMemberRepository
+	poolEntryRepo  repositories.PoolEntryRepository
+}
+
+func NewDraftQueueService(
+	draftQueueRepo repositories.DraftQueueRepository,
+	memberRepo repositories.LeagueMemberRepository,
+	poolEntryRepo repositories.PoolEntryRepository,
+) DraftQueueService {
+	return &draftQueueServiceImpl{
+		draftQueueRepo: draftQueueRepo,
+		memberRepo:     memberRepo,
+		poolEntryRepo:  poolEntryRepo,
+	}
+}
+
+func (s *draftQueueServiceImpl) GetQueue(currentUser *models.User, leagueID uuid.UUID) ([]models.DraftQueueEntry, error) {
+	member, err := s.fetchMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getQueue(member.ID)
+}
+
+// SetQueue replaces the member's queue with input.PoolEntryIDs, in order.
+// Entries that have already been drafted are allowed so that a client can reorder
+// its existing queue mid-draft; they are simply passed over when auto-picking.
+func (s *draftQueueServiceImpl) SetQueue(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.DraftQueueSetRequestDTO,
+) ([]models.DraftQueueEntry, error) {
+	member, err := s.fetchMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(input.PoolEntryIDs))
+	for _, id := range input.PoolEntryIDs {
+		if seen[id] {
+			log.Printf("LOG: (DraftQueueService: SetQueue) - (user %s) duplicate pool entry %s in queue\n", currentUser.ID, id)
+			return nil, types.ErrInvalidInput
+		}
+		seen[id] = true
+	}
+
+	if len(input.PoolEntryIDs) > 0 {
+		poolEntries, err := s.poolEntryRepo.GetByIDs(leagueID, input.PoolEntryIDs)
+		if err != nil {
+			log.Printf("LOG: (DraftQueueService: SetQueue) - (user %s) failed to fetch pool entries for league %s: %v\n", currentUser.ID, leagueID, err)
+			return nil, types.ErrInternalService
+		}
+		if len(poolEntries) != len(input.PoolEntryIDs) {
+			return nil, types.ErrPoolEntryNotFound
+		}
+	}
+
+	if err := s.replaceQueue(member.ID, input.PoolEntryIDs); err != nil {
+		return nil, err
+	}
+	return s.getQueue(member.ID)
+}
+
+// AddToQueue appends a pool entry to the bottom of the member's queue.
+func (s *draftQueueServiceImpl) AddToQueue(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.DraftQueueAddRequestDTO,
+) ([]models.DraftQueueEntry, error) {
+	member, err := s.fetchMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	poolEntries, err := s.poolEntryRepo.GetByIDs(leagueID, []uuid.UUID{input.PoolEntryID})
+	if err != nil {
+		log.Printf("LOG: (DraftQueueService: AddToQueue) - (user %s) failed to fetch pool entry %s: %v\n", currentUser.ID, input.PoolEntryID, err)
+		return nil, types.ErrInternalService
+	}
+	if len(poolEntries) != 1 {
+		return nil, types.ErrPoolEntryNotFound
+	}
+	if !poolEntries[0].IsAvailable {
+		return nil, types.ErrConflict
+	}
+
+	queue, err := s.getQueue(member.ID)
+	if err != nil {
+		return nil, err
+	}
+	poolEntryIDs := queuedPoolEntryIDs(queue)
+	if slices.Contains(poolEntryIDs, input.PoolEntryID) {
+		return nil, types.ErrConflict
+	}
+
+	if err := s.replaceQueue(member.ID, append(poolEntryIDs, input.PoolEntryID)); err != nil {
+		return nil, err
+	}
+	return s.getQueue(member.ID)
+}
+
+// RemoveFromQueue removes a pool entry from the member's queue and closes the gap in the ranking.
+func (s *draftQueueServiceImpl) RemoveFromQueue(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	poolEntryID uuid.UUID,
+) ([]models.DraftQueueEntry, error) {
+	member, err := s.fetchMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := s.getQueue(member.ID)
+	if err != nil {
+		return nil, err
+	}
+	poolEntryIDs := queuedPoolEntryIDs(queue)
+	index := slices.Index(poolEntryIDs, poolEntryID)
+	if index == -1 {
+		return nil, types.ErrPoolEntryNotFound
+	}
+
+	if err := s.replaceQueue(member.ID, slices.Delete(poolEntryIDs, index, index+1)); err != nil {
+		return nil, err
+	}
+	return s.getQueue(member.ID)
+}
+
+func (s *draftQueueServiceImpl) fetchMember(userID, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	member, err := s.memberRepo.GetByUserAndLeague(userID, leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (DraftQueueService: fetchMember) - (user %s) error fetching member in league %s: %v\n", userID, leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return member, nil
+}
+
+func (s *draftQueueServiceImpl) getQueue(memberID uuid.UUID) ([]models.DraftQueueEntry, error) {
+	queue, err := s.draftQueueRepo.GetByPlayer(memberID)
+	if err != nil {
+		log.Printf("LOG: (DraftQueueService: getQueue) - failed to fetch queue for member %s: %v\n", memberID, err)
+		return nil, types.ErrInternalService
+	}
+	return queue, nil
+}
+
+// replaceQueue stores poolEntryIDs as the member's queue, ranked by their position.
+func (s *draftQueueServiceImpl) replaceQueue(memberID uuid.UUID, poolEntryIDs []uuid.UUID) error {
+	entries := make([]models.DraftQueueEntry, len(poolEntryIDs))
+	for i, id := range poolEntryIDs {
+		entries[i] = models.DraftQueueEntry{
+			PlayerID:    memberID,
+			PoolEntryID: id,
+			Rank:        i + 1,
+		}
+	}
+	if err := s.draftQueueRepo.ReplaceForPlayer(memberID, entries); err != nil {
+		log.Printf("LOG: (DraftQueueService: replaceQueue) - failed to save queue for member %s: %v\n", memberID, err)
+		return types.ErrInternalService
+	}
+	return nil
+}
+
+func queuedPoolEntryIDs(queue []models.DraftQueueEntry) []uuid.UUID {
+	ids := make([]uuid.UUID, len(queue))
+	for i, entry := range queue {
+		ids[i] = entry.PoolEntryID
+	}
+	return ids
+}
@@ -0,0 +1,152 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type draftQueueServiceMocks struct {
+	draftQueueRepo   *mock_repositories.MockDraftQueueRepository
+	leagueMemberRepo *mock_repositories.MockLeagueMemberRepository
+	poolEntryRepo    *mock_repositories.MockPoolEntryRepository
+}
+
+func setupDraftQueueServiceTest() (services.DraftQueueService, draftQueueServiceMocks) {
+	mocks := draftQueueServiceMocks{
+		draftQueueRepo:   new(mock_repositories.MockDraftQueueRepository),
+		leagueMemberRepo: new(mock_repositories.MockLeagueMemberRepository),
+		poolEntryRepo:    new(mock_repositories.MockPoolEntryRepository),
+	}
+	service := services.NewDraftQueueService(mocks.draftQueueRepo, mocks.leagueMemberRepo, mocks.poolEntryRepo)
+	return service, mocks
+}
+
+func TestDraftQueueService_SetQueue(t *testing.T) {
+	leagueID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+	currentUser := &models.User{ID: userID}
+	member := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID}
+
+	t.Run("Success - Ranks entries in request order", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+		first, second := uuid.New(), uuid.New()
+		input := &requests.DraftQueueSetRequestDTO{PoolEntryIDs: []uuid.UUID{second, first}}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, input.PoolEntryIDs).
+			Return([]models.PoolEntry{{ID: first}, {ID: second}}, nil).Once()
+		mocks.draftQueueRepo.On("ReplaceForPlayer", memberID, mock.MatchedBy(func(entries []models.DraftQueueEntry) bool {
+			return len(entries) == 2 &&
+				entries[0].PoolEntryID == second && entries[0].Rank == 1 &&
+				entries[1].PoolEntryID == first && entries[1].Rank == 2
+		})).Return(nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return([]models.DraftQueueEntry{}, nil).Once()
+
+		_, err := service.SetQueue(currentUser, leagueID, input)
+
+		assert.NoError(t, err)
+		mocks.draftQueueRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Duplicate entries", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+		id := uuid.New()
+		input := &requests.DraftQueueSetRequestDTO{PoolEntryIDs: []uuid.UUID{id, id}}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+
+		_, err := service.SetQueue(currentUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+		mocks.draftQueueRepo.AssertNotCalled(t, "ReplaceForPlayer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Pool entry not in league", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+		input := &requests.DraftQueueSetRequestDTO{PoolEntryIDs: []uuid.UUID{uuid.New()}}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, input.PoolEntryIDs).Return([]models.PoolEntry{}, nil).Once()
+
+		_, err := service.SetQueue(currentUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrPoolEntryNotFound)
+		mocks.draftQueueRepo.AssertNotCalled(t, "ReplaceForPlayer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDraftQueueService_AddAndRemove(t *testing.T) {
+	leagueID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+	currentUser := &models.User{ID: userID}
+	member := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID}
+	queuedID := uuid.New()
+	existingQueue := []models.DraftQueueEntry{{PlayerID: memberID, PoolEntryID: queuedID, Rank: 1}}
+
+	t.Run("Success - Add appends to the bottom", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+		newID := uuid.New()
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{newID}).
+			Return([]models.PoolEntry{{ID: newID, IsAvailable: true}}, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(existingQueue, nil).Twice()
+		mocks.draftQueueRepo.On("ReplaceForPlayer", memberID, mock.MatchedBy(func(entries []models.DraftQueueEntry) bool {
+			return len(entries) == 2 && entries[1].PoolEntryID == newID && entries[1].Rank == 2
+		})).Return(nil).Once()
+
+		_, err := service.AddToQueue(currentUser, leagueID, &requests.DraftQueueAddRequestDTO{PoolEntryID: newID})
+
+		assert.NoError(t, err)
+		mocks.draftQueueRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Add already queued entry", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{queuedID}).
+			Return([]models.PoolEntry{{ID: queuedID, IsAvailable: true}}, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(existingQueue, nil).Once()
+
+		_, err := service.AddToQueue(currentUser, leagueID, &requests.DraftQueueAddRequestDTO{PoolEntryID: queuedID})
+
+		assert.ErrorIs(t, err, types.ErrConflict)
+	})
+
+	t.Run("Failure - Add drafted entry", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+		draftedID := uuid.New()
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{draftedID}).
+			Return([]models.PoolEntry{{ID: draftedID, IsAvailable: false}}, nil).Once()
+
+		_, err := service.AddToQueue(currentUser, leagueID, &requests.DraftQueueAddRequestDTO{PoolEntryID: draftedID})
+
+		assert.ErrorIs(t, err, types.ErrConflict)
+	})
+
+	t.Run("Failure - Remove entry not in queue", func(t *testing.T) {
+		service, mocks := setupDraftQueueServiceTest()
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(existingQueue, nil).Once()
+
+		_, err := service.RemoveFromQueue(currentUser, leagueID, uuid.New())
+
+		assert.ErrorIs(t, err, types.ErrPoolEntryNotFound)
+		mocks.draftQueueRepo.AssertNotCalled(t, "ReplaceForPlayer", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// turnReminderTaskID is the ID of the reminder task at percent of a league's current turn clock.
+func turnReminderTaskID(leagueID uuid.UUID, percent int) string {
+	return fmt.Sprintf("%d_%s_%d", utils.TaskTypeDraftTurnReminder, leagueID, percent)
+}
+
+// turnReminderTasks builds the reminder tasks of the turn in progress (Draft.Clock.TurnReminderPercents)
+// that are still due after now. Reminders already past are dropped rather than sent late, e.g. when a
+// paused turn resumes with part of its clock already run.
+func turnReminderTasks(draft *models.Draft, now time.Time) []*utils.ScheduledTask {
+	if draft.CurrentTurnMemberID == nil {
+		return nil
+	}
+	var tasks []*utils.ScheduledTask
+	for _, percent := range draft.Clock.ReminderPercents() {
+		remindAt := draft.CurrentTurnReminderAt(percent)
+		if remindAt == nil || !remindAt.After(now) {
+			continue
+		}
+		tasks = append(tasks, &utils.ScheduledTask{
+			ID:        turnReminderTaskID(draft.LeagueID, percent),
+			ExecuteAt: *remindAt,
+			Type:      utils.TaskTypeDraftTurnReminder,
+			Payload: utils.PayloadDraftTurnReminder{
+				LeagueID:   draft.LeagueID,
+				PlayerID:   *draft.CurrentTurnMemberID,
+				PickNumber: draft.CurrentPickOnClock,
+				Percent:    percent,
+			},
+		})
+	}
+	return tasks
+}
+
+// SendTurnReminder is called by the SchedulerService when a turn reminder is due. It posts a reminder
+// to the league's Discord webhook that memberID is still on the clock for pickNumber. A reminder for a
+// turn that has already ended is ignored, as is a league without a webhook.
+func (s *draftServiceImpl) SendTurnReminder(leagueID, memberID uuid.UUID, pickNumber, percent int) error {
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: SendTurnReminder) - could not fetch draft for league %s: %v\n", leagueID, err)
+		return err
+	}
+	if draft.Status != enums.DraftStatusOngoing || draft.CurrentTurnMemberID == nil ||
+		*draft.CurrentTurnMemberID != memberID || draft.CurrentPickOnClock != pickNumber {
+		log.Printf("WARN: (DraftService: SendTurnReminder) - pick %d of member %s in league %s is no longer on the clock. Ignoring.\n", pickNumber, memberID, leagueID)
+		return nil
+	}
+
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: SendTurnReminder) - could not find league %s: %v\n", leagueID, err)
+		return types.ErrLeagueNotFound
+	}
+	if s.webhookService == nil || league.DiscordWebhookURL == nil || *league.DiscordWebhookURL == "" {
+		return nil
+	}
+
+	member, err := s.memberRepo.GetByID(memberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("ERROR: (DraftService: SendTurnReminder) - member %s not found: %v\n", memberID, err)
+			return types.ErrPlayerNotFound
+		}
+		log.Printf("ERROR: (DraftService: SendTurnReminder) - could not fetch member %s: %v\n", memberID, err)
+		return types.ErrInternalService
+	}
+
+	message := fmt.Sprintf("Reminder: %s is on the clock for pick %d (round %d) with %d%% of their time used. Their turn ends at %s.",
+		memberDisplayName(member), pickNumber, draft.CurrentRound, percent, draft.CurrentTurnEndsAt().UTC().Format("Jan 2 15:04 MST"))
+	if err := s.notifyLeague(league, message); err != nil {
+		return types.ErrInternalService
+	}
+	return nil
+}
+
+// memberDisplayName is how a member is named in league notifications: their in-league name, else their
+// team name.
+func memberDisplayName(member *models.LeagueMember) string {
+	if member.InLeagueName != nil && *member.InLeagueName != "" {
+		return *member.InLeagueName
+	}
+	if member.TeamName != nil {
+		return *member.TeamName
+	}
+	return member.ID.String()
+}
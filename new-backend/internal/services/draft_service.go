@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
@@ -22,12 +23,29 @@ import (
 type DraftService interface {
 	GetDraftByID(draftID uuid.UUID) (*models.Draft, error)
 	GetDraftByLeagueID(leagueID uuid.UUID) (*models.Draft, error)
+	GetPickSchedule(leagueID uuid.UUID) (*responses.DraftScheduleResponse, error)
 	StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*models.Draft, error)
 	MakePick(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftMakePickRequestDTO) error
 	SkipTurn(currentUser *models.User, leagueID uuid.UUID) error
-	AutoSkipTurn(playerID, leagueID uuid.UUID) error
+	AutoSkipTurn(playerID, leagueID uuid.UUID, pickNumber int) error
+	ForcePick(leagueID uuid.UUID, input *requests.DraftMakePickRequestDTO) error
+	PauseDraft(leagueID uuid.UUID) (*models.Draft, error)
+	ResumeDraft(leagueID uuid.UUID) (*models.Draft, error)
+	UndoLastPick(leagueID uuid.UUID) (*models.Draft, error)
+	SetCustomDraftOrder(leagueID uuid.UUID, input *requests.DraftCustomOrderRequestDTO) (*models.League, error)
+	NominateAuctionLot(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftAuctionNominateRequestDTO) (*models.Draft, error)
+	PlaceAuctionBid(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftAuctionBidRequestDTO) (*models.Draft, error)
+	CloseAuctionLot(leagueID, poolEntryID uuid.UUID) error
+	SendTurnReminder(leagueID, memberID uuid.UUID, pickNumber, percent int) error
+	ScheduleDraftStart(leagueID uuid.UUID, input *requests.DraftScheduleStartRequestDTO) (*models.League, error)
+	CancelScheduledDraftStart(leagueID uuid.UUID) (*models.League, error)
+	RunScheduledDraftStart(leagueID uuid.UUID, scheduledFor time.Time) error
 	SetSchedulerService(schedulerService SchedulerService)
+	SetDraftEventService(eventService DraftEventService)
+	SetDraftQueueRepository(draftQueueRepo repositories.DraftQueueRepository)
+	SetDraftTradeRepository(draftTradeRepo repositories.DraftTradeRepository)
 	SetNewRepositories(draftPickRepo repositories.DraftPickRepository, claimRepo repositories.ClaimRepository, poolEntryRepo repositories.PoolEntryRepository)
+	SetClock(clock utils.Clock)
 }
 
 type draftServiceImpl struct {
@@ -36,10 +54,15 @@ type draftServiceImpl struct {
 	memberRepo       repositories.LeagueMemberRepository
 	webhookService   *WebhookService
 	schedulerService SchedulerService
+	eventService     DraftEventService
+	clock            utils.Clock
 
 	draftPickRepo repositories.DraftPickRepository
 	claimRepo     repositories.ClaimRepository
 	poolEntryRepo repositories.PoolEntryRepository
+
+	draftQueueRepo repositories.DraftQueueRepository
+	draftTradeRepo repositories.DraftTradeRepository
 }
 
 func NewDraftService(
@@ -53,6 +76,7 @@ func NewDraftService(
 		leagueRepo:     leagueRepo,
 		memberRepo:     memberRepo,
 		webhookService: webhookService,
+		clock:          utils.SystemClock,
 	}
 }
 
@@ -72,6 +96,29 @@ func (s *draftServiceImpl) SetSchedulerService(schedulerService SchedulerService
 	s.schedulerService = schedulerService
 }
 
+// SetDraftEventService injects the DraftEventService used to push draft changes to
+// clients listening on the league's event stream. Events are skipped if it is never set.
+func (s *draftServiceImpl) SetDraftEventService(eventService DraftEventService) {
+	s.eventService = eventService
+}
+
+// SetClock replaces the wall clock that turn timers and draft times are read from.
+func (s *draftServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
+// SetDraftQueueRepository injects the repository holding members' pre-ranked draft queues.
+// Without it, a turn timeout always falls back to skipping.
+func (s *draftServiceImpl) SetDraftQueueRepository(draftQueueRepo repositories.DraftQueueRepository) {
+	s.draftQueueRepo = draftQueueRepo
+}
+
+// SetDraftTradeRepository injects the repository holding traded draft slot ownership.
+// Without it, every slot belongs to the member it was originally dealt to.
+func (s *draftServiceImpl) SetDraftTradeRepository(draftTradeRepo repositories.DraftTradeRepository) {
+	s.draftTradeRepo = draftTradeRepo
+}
+
 func (s *draftServiceImpl) GetDraftByID(draftID uuid.UUID) (*models.Draft, error) {
 	draft, err := s.draftRepo.GetDraftByID(draftID)
 	if err != nil {
@@ -98,6 +145,104 @@ func (s *draftServiceImpl) GetDraftByLeagueID(leagueID uuid.UUID) (*models.Draft
 	return draft, nil
 }
 
+// GetPickSchedule projects the whole pick order of a league's draft: every overall pick number with its
+// round, the member holding it and when its clock is expected to start. Each pick is resolved with
+// turnForPick, the same code the live draft uses to put members on the clock, so the schedule follows
+// snake/linear order, draft positions and traded slots exactly as the draft will. Traded slots are
+// read once for the whole schedule.
+func (s *draftServiceImpl) GetPickSchedule(leagueID uuid.UUID) (*responses.DraftScheduleResponse, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		log.Printf("LOG: (DraftService: GetPickSchedule) - could not fetch league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	if league.Format.IsAuctionDraft() {
+		// nominations have no fixed order of picks to project
+		return nil, types.ErrInvalidState
+	}
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: GetPickSchedule) - could not fetch draft for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+	allMembers, err := s.memberRepo.GetByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: GetPickSchedule) - could not fetch members for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	owners, err := s.loadSlotOwners(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: GetPickSchedule) - could not fetch traded slots for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	// who holds each skipped pick number that can still be used
+	accumulatedBy := make(map[int]uuid.UUID)
+	for memberID, pickNumbers := range draft.PlayersWithAccumulatedPicks {
+		for _, pickNumber := range pickNumbers {
+			accumulatedBy[pickNumber] = memberID
+		}
+	}
+
+	totalPicks := len(allMembers) * league.MaxPokemonPerPlayer
+	// a paused draft gets a fresh clock on resume, so there is nothing to project from
+	var nextClockStart *time.Time
+	if draft.Status == enums.DraftStatusOngoing {
+		nextClockStart = draft.CurrentTurnStartTime
+	}
+	schedule := &responses.DraftScheduleResponse{
+		DraftID:            draft.ID,
+		Status:             draft.Status,
+		TurnTimeLimit:      draft.TurnTimeLimit,
+		CurrentPickOnClock: draft.CurrentPickOnClock,
+		Picks:              make([]responses.DraftScheduleSlot, 0, totalPicks),
+	}
+	for pickNumber := 1; pickNumber <= totalPicks; pickNumber++ {
+		turn, err := turnForPick(league, allMembers, owners, pickNumber)
+		if err != nil {
+			log.Printf("LOG: (DraftService: GetPickSchedule) - could not resolve pick %d in league %s: %v\n", pickNumber, leagueID, err)
+			return nil, types.ErrInternalService
+		}
+		slot := responses.DraftScheduleSlot{
+			PickNumber:      pickNumber,
+			Round:           turn.Round,
+			PickInRound:     turn.PickInRound,
+			MemberID:        turn.MemberID,
+			OriginalOwnerID: turn.OriginalOwnerID,
+			TurnTimeLimit:   draft.TurnTimeLimitForRound(turn.Round),
+		}
+
+		switch {
+		case draft.Status == enums.DraftStatusCompleted || pickNumber < draft.CurrentPickOnClock:
+			slot.Status = enums.DraftScheduleSlotDone
+			if holderID, ok := accumulatedBy[pickNumber]; ok {
+				slot.Status = enums.DraftScheduleSlotAccumulated
+				slot.MemberID = holderID
+			}
+		case pickNumber == draft.CurrentPickOnClock:
+			slot.Status = enums.DraftScheduleSlotOnClock
+			if draft.CurrentTurnMemberID != nil {
+				slot.MemberID = *draft.CurrentTurnMemberID
+			}
+		default:
+			slot.Status = enums.DraftScheduleSlotUpcoming
+		}
+		if slot.Status != enums.DraftScheduleSlotDone && slot.Status != enums.DraftScheduleSlotAccumulated && nextClockStart != nil {
+			clockStart := *nextClockStart
+			slot.EstimatedClockStart = &clockStart
+			clockEnd := draft.TurnDeadline(clockStart, turn.Round)
+			nextClockStart = &clockEnd
+		}
+
+		schedule.Picks = append(schedule.Picks, slot)
+	}
+
+	return schedule, nil
+}
+
 // StartDraft initializes the draft for a given league. It validates that there are players,
 // sets the draft order (either randomly or by pre-set positions), creates the initial
 // draft state in the database, updates the league status to DRAFTING, and schedules the
@@ -111,6 +256,12 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		return nil, types.ErrLeagueNotFound
 	}
 
+	clock := league.Format.DraftClock()
+	if err := clock.Validate(); err != nil {
+		log.Printf("LOG: (Error: DraftService.StartDraft) - League %s has an invalid draft clock: %v\n", leagueID, err)
+		return nil, types.ErrInvalidLeagueConfiguration
+	}
+
 	// Retrieve members in the league, sorted by draft position
 	members, err := s.memberRepo.GetByLeague(leagueID)
 	if err != nil {
@@ -123,22 +274,36 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		return nil, types.ErrNoPlayerForDraft
 	}
 
+	var lottery *models.DraftLottery
 	switch league.Format.DraftOrderType {
 	case enums.DraftOrderTypeRandom:
-		r := rand.New(rand.NewSource(time.Now().UnixNano())) // set seed
+		seed := s.clock.Now().UnixNano()
+		r := rand.New(rand.NewSource(seed))
 		r.Shuffle(len(members), func(i, j int) {
 			members[i], members[j] = members[j], members[i]
 		})
+		if err := s.saveDraftPositions(members); err != nil {
+			return nil, err
+		}
+		log.Printf("LOG: (DraftService.StartDraft) - Randomized draft order for league %s complete with seed %d.\n", leagueID, seed)
 
-		// Assign new draft positions and update in DB
-		for i := range members {
-			members[i].DraftPosition = i + 1 // Draft positions are 1-based
-			if err := s.memberRepo.UpdateDraftPosition(members[i].ID, members[i].DraftPosition); err != nil {
-				log.Printf("LOG: (Error: DraftService.StartDraft) - Failed to update draft position for member %s: %v\n", members[i].ID, err)
-				return nil, types.ErrInternalService
-			}
+	case enums.DraftOrderTypeReverseStandings:
+		orderByReverseStandings(members)
+		if err := s.saveDraftPositions(members); err != nil {
+			return nil, err
 		}
-		log.Printf("LOG: (DraftService.StartDraft) - Randomized draft order for league %s complete.\n", leagueID)
+		log.Printf("LOG: (DraftService.StartDraft) - Draft order for league %s set by reverse standings.\n", leagueID)
+
+	case enums.DraftOrderTypeWeightedLottery:
+		lottery, err = s.drawDraftLottery(league, members)
+		if err != nil {
+			return nil, err
+		}
+		members = orderByLottery(members, lottery)
+		if err := s.saveDraftPositions(members); err != nil {
+			return nil, err
+		}
+		log.Printf("LOG: (DraftService.StartDraft) - Draft order for league %s drawn by lottery with seed %d.\n", leagueID, lottery.Seed)
 
 	case enums.DraftOrderTypeManual:
 		// Members are already sorted by DraftPosition from GetByLeague.
@@ -164,9 +329,27 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		log.Printf("LOG: (DraftService: StartDraft) - Using manual draft order for league %s.\n", leagueID)
 	}
 
+	if league.Format.RoundOrder() == enums.DraftRoundOrderCustom {
+		// the member count may have changed since staff set the order
+		if err := validateCustomDraftOrder(league.Format.CustomDraftOrder, len(members), league.MaxPokemonPerPlayer); err != nil {
+			log.Printf("ERROR: (DraftService: StartDraft) - Custom draft order for league %s is invalid: %v\n", leagueID, err)
+			return nil, err
+		}
+	}
+
 	// Initialize the Draft model
-	firstMemberID := members[0].ID
-	currTime := time.Now()
+	// the first slot may have been traded away before the draft started
+	owners, err := s.loadSlotOwners(leagueID)
+	if err != nil {
+		log.Printf("LOG: (Error: DraftService.StartDraft) - Could not fetch traded slots for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	firstTurn, err := turnForPick(league, members, owners, 1)
+	if err != nil {
+		log.Printf("LOG: (Error: DraftService.StartDraft) - Could not resolve owner of the first pick in league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	currTime := s.clock.Now()
 
 	draft := &models.Draft{
 		LeagueID:                    leagueID,
@@ -174,11 +357,13 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		CurrentRound:                1,
 		CurrentPickInRound:          1,
 		CurrentPickOnClock:          1, // formula: ((CurrentRound - 1)*PlayerCount + CurrentPickInRound)
-		CurrentTurnMemberID:         &firstMemberID,
+		CurrentTurnMemberID:         &firstTurn.MemberID,
 		CurrentTurnStartTime:        &currTime,
 		TurnTimeLimit:               TurnTimeLimit,
 		PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks), // map[uuid.UUID][]int
-		StartTime:                   time.Now(),
+		StartTime:                   s.clock.Now(),
+		Lottery:                     lottery,
+		Clock:                       clock,
 	}
 
 	// Save the Draft model
@@ -187,6 +372,11 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		return nil, fmt.Errorf("failed to create draft: %w", err)
 	}
 
+	// a draft started by hand before its scheduled start replaces the schedule
+	startWasScheduled := league.ScheduledDraftStart != nil
+	league.ScheduledDraftStart = nil
+	league.ScheduledDraftTurnTimeLimit = 0
+
 	// Update the league status to DRAFTING
 	league.Status = enums.LeagueStatusDrafting
 	if _, err := s.leagueRepo.UpdateLeague(league); err != nil {
@@ -195,29 +385,24 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 		return nil, fmt.Errorf("failed to update league status: %w", err)
 	}
 
-	taskType := utils.TaskTypeDraftTurnTimeout
-	turnTimeLimit := draft.TurnTimeLimit
-	turnStartTime := draft.CurrentTurnStartTime
-	turnEndTime := turnStartTime.Add(time.Duration(turnTimeLimit) * time.Minute)
-
-	task := &utils.ScheduledTask{
-		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
-		ExecuteAt: turnEndTime,
-		Type:      taskType,
-		Payload: utils.PayloadDraftTurnTimeout{
-			LeagueID: draft.LeagueID,
-			PlayerID: *draft.CurrentTurnMemberID,
-		},
+	if startWasScheduled {
+		if err := s.leagueRepo.ClearScheduledDraftStart(leagueID); err != nil {
+			// the draft is already on, and without its task a leftover schedule never fires
+			log.Printf("ERROR: (DraftService: StartDraft) - could not clear scheduled start for league %s: %v\n", leagueID, err)
+		}
+		s.schedulerService.DeregisterTask(draftStartTaskID(leagueID))
 	}
+	s.scheduleTurnTimeout(draft)
+	s.publishTurnChanged(draft)
 
-	s.schedulerService.RegisterTask(task)
-
-	// Send an initial webhook notification
-	// TODO: Implement webhook message creation logic
-	// if err := (*s.webhookService).SendWebhookMessage(league.DiscordWebhookURL, "Draft has started!"); err != nil {
-	// 	log.Printf("(Warning: DraftService.StartDraft) - Failed to send webhook for league %s: %v\n", leagueID, err)
-	// 	// Continue execution, webhook failure shouldn't stop the draft
-	// }
+	// announce the start; webhook failure shouldn't stop the draft
+	firstMemberName := firstTurn.MemberID.String()
+	for i := range members {
+		if members[i].ID == firstTurn.MemberID {
+			firstMemberName = memberDisplayName(&members[i])
+		}
+	}
+	s.notifyLeague(league, fmt.Sprintf("The draft has started! %s is on the clock for pick 1.", firstMemberName))
 
 	return draft, nil
 }
@@ -229,7 +414,7 @@ func (s *draftServiceImpl) StartDraft(leagueID uuid.UUID, TurnTimeLimit int) (*m
 // - Ensures the pick doesn't violate league roster rules (e.g., minimum roster size).
 // If all checks pass, it executes the pick as a transaction and advances the draft state.
 // MakePick makes one or more picks (if accumulated) during drafting phase;
-// Different from ForcePick (not implemented yet),
+// Different from ForcePick (staff picking for whoever is on the clock),
 // MakePick does all the required checks (there's a lot of checks) and validates the input
 //
 // NOTE: This method has been migrated to write DraftPick + Claim records instead of DraftedPokemon.
@@ -270,34 +455,87 @@ func (s *draftServiceImpl) MakePick(
 	// START early checks to prevent a expensive checks later
 	// check if it's the right member's turn
 	if currentTurnMemberID := *draft.CurrentTurnMemberID; currentTurnMemberID != member.ID {
-		log.Printf("LOG: (DraftService: MakePick) - member %s tried to draft when it isn't their turn. Current Turn: Member %s\n", currentTurnMemberID, *draft.CurrentTurnMemberID)
+		log.Printf("LOG: (DraftService: MakePick) - member %s tried to draft when it isn't their turn. Current Turn: Member %s\n", member.ID, currentTurnMemberID)
 		return types.ErrUnauthorized
 	}
 
-	// check if number of requested picks is valid for the member
-	if input.RequestedPickCount > len(draft.PlayersWithAccumulatedPicks[member.ID])+1 {
-		log.Printf("LOG: (DraftService: MakePick) -  (user %s) Member %s requested too many draft picks\n", currentUser.ID, member.ID)
-		return types.ErrTooManyRequestedPicks
-	}
-
 	// check league status
 	if isValidStatus := s.validateLeagueStatusForPick(league.Status, draft.Status); !isValidStatus {
-		log.Printf("LOG: (DraftService: MakePick) - (user %s) league %s is not in drafting status: %v", currentUser.ID, league.ID, err)
+		log.Printf("LOG: (DraftService: MakePick) - (user %s) league %s is not in drafting status\n", currentUser.ID, league.ID)
 		return types.ErrInvalidState
 	}
 	// END early checks
 
+	return s.makePick(draft, league, member, input)
+}
+
+// ForcePick lets league staff make a pick on behalf of the member currently on the clock.
+// It runs the same validations as MakePick (availability, points, pick slots, skips) and also
+// works while the draft is PAUSED, e.g. to unblock a draft paused by AutoSkipTurn.
+// A paused draft stays paused; the next turn's timer starts when staff resume it.
+func (s *draftServiceImpl) ForcePick(leagueID uuid.UUID, input *requests.DraftMakePickRequestDTO) error {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: ForcePick) - could not find league %s: %v\n", leagueID, err)
+		return types.ErrLeagueNotFound
+	}
+
+	draft, err := s.fetchDraftResource(league.ID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: ForcePick) - could not fetch draft for league %s: %v\n", league.ID, err)
+		return err
+	}
+
+	if league.Status != enums.LeagueStatusDrafting ||
+		(draft.Status != enums.DraftStatusOngoing && draft.Status != enums.DraftStatusPaused) {
+		log.Printf("LOG: (DraftService: ForcePick) - league %s (%s) / draft (%s) not in a state to pick\n", league.ID, league.Status, draft.Status)
+		return types.ErrInvalidState
+	}
+
+	member, err := s.memberRepo.GetByID(*draft.CurrentTurnMemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (DraftService: ForcePick) - error fetching member on the clock %s: %v\n", *draft.CurrentTurnMemberID, err)
+		return types.ErrInternalService
+	}
+
+	log.Printf("LOG: (DraftService: ForcePick) - Staff forcing pick for member %s in league %s\n", member.ID, league.ID)
+	return s.makePick(draft, league, member, input)
+}
+
+// makePick validates and executes a pick for member, who must be on the clock, then advances
+// the draft. Shared by MakePick and ForcePick; callers check turn ownership and draft status.
+func (s *draftServiceImpl) makePick(
+	draft *models.Draft,
+	league *models.League,
+	member *models.LeagueMember,
+	input *requests.DraftMakePickRequestDTO,
+) error {
+	// auction drafts acquire pokemon by nominating and bidding instead
+	if league.Format.IsAuctionDraft() {
+		log.Printf("LOG: (DraftService: makePick) - league %s runs an auction draft; picks are not allowed\n", league.ID)
+		return types.ErrInvalidState
+	}
+
+	// check if number of requested picks is valid for the member
+	if input.RequestedPickCount > len(draft.PlayersWithAccumulatedPicks[member.ID])+1 {
+		log.Printf("LOG: (DraftService: makePick) - Member %s requested too many draft picks\n", member.ID)
+		return types.ErrTooManyRequestedPicks
+	}
+
 	// fetch all the pool entries requested
 	// expensive
 	allRequestedPoolEntries, err := s.fetchRequestedPoolEntries(league.ID, input)
 	if err != nil {
 		switch err {
 		case types.ErrPoolEntryNotFound:
-			log.Printf("LOG: (DraftService: MakePick) - (user %s) One or more pool entries were not found: %v\n", currentUser.ID, err)
+			log.Printf("LOG: (DraftService: makePick) - (member %s) One or more pool entries were not found: %v\n", member.ID, err)
 		case types.ErrConflict:
-			log.Printf("LOG: (DraftService: MakePick) - (user %s) One or more pool entries are not available for drafting: %v\n", currentUser.ID, err)
+			log.Printf("LOG: (DraftService: makePick) - (member %s) One or more pool entries are not available for drafting: %v\n", member.ID, err)
 		case types.ErrInternalService:
-			log.Printf("LOG: (DraftService: MakePick) - (user %s) error fetching requested pool entries for league %s: %v\n", currentUser.ID, league.ID, err)
+			log.Printf("LOG: (DraftService: makePick) - (member %s) error fetching requested pool entries for league %s: %v\n", member.ID, league.ID, err)
 		}
 		return err
 	}
@@ -305,76 +543,69 @@ func (s *draftServiceImpl) MakePick(
 	// get member count; needed in multiple places
 	memberCount, err := s.memberRepo.GetCountByLeague(league.ID)
 	if err != nil {
-		log.Printf("DraftService: MakePick - failed to get member count for league %s: %v\n", league.ID, err)
+		log.Printf("DraftService: makePick - failed to get member count for league %s: %v\n", league.ID, err)
 		return types.ErrInternalService
 	}
 	if memberCount == 0 { // this should never happen if the draft has started or if the league even exists
-		log.Printf("DraftService: MakePick - no members in league %d. (Unreachable Code)\n", league.ID)
+		log.Printf("DraftService: makePick - no members in league %s. (Unreachable Code)\n", league.ID)
 		return types.ErrInternalService
 	}
 
 	totalRequestedCost := s.getTotalCostForPoolEntries(allRequestedPoolEntries)
 
 	// perform remaining validation
-	currentPickSlotUsed, err := s.validatePicksAndCheckCurrentPickSlotUsed(draft, member, league, input, totalRequestedCost)
+	currentPickSlotUsed, err := s.validatePicksAndCheckCurrentPickSlotUsed(draft, member, league, input, allRequestedPoolEntries, totalRequestedCost)
 	if err != nil {
 		switch err {
 		case types.ErrInvalidInput:
-			log.Printf("LOG: (DraftService: MakePick): (user %s; league %s) Invalid pick number in request: %v\n", currentUser.ID, league.ID, err)
+			log.Printf("LOG: (DraftService: makePick): (member %s; league %s) Invalid pick number in request: %v\n", member.ID, league.ID, err)
 		case types.ErrInsufficientDraftPoints:
-			log.Printf("LOG: (DraftService: MakePick): (user %s; league %s) Insufficient draft points (%d) for transaction: %v\n", currentUser.ID, league.ID, member.DraftPoints, err)
+			log.Printf("LOG: (DraftService: makePick): (member %s; league %s) Insufficient draft points (%d) for transaction: %v\n", member.ID, league.ID, member.DraftPoints, err)
+		case types.ErrTierLimitExceeded:
+			log.Printf("LOG: (DraftService: makePick): (member %s; league %s) Picks break the league's tier limits: %v\n", member.ID, league.ID, err)
 		}
 		return err
 	}
 
-	// execute picks (new model: creates DraftPick + Claim instead of DraftedPokemon)
-	err = s.executeNewPickTransactions(draft, league, member, allRequestedPoolEntries, input, memberCount, totalRequestedCost)
+	// get all members; needed to find traded slots' original owners and to set the next member's turn
+	allMembers, err := s.memberRepo.GetByLeague(draft.LeagueID)
 	if err != nil {
-		log.Printf("LOG: (DraftService: MakePick): (user %s; league %s) Batch transaction unsucessful: %v\n", currentUser.ID, league.ID, err)
-		return err
+		log.Printf("DraftService: makePick - Could not get all members in league %s: %v\n", league.ID, err)
+		return types.ErrInternalService
 	}
+	originalOwners := s.tradedSlotOriginalOwners(league, member, input, allMembers)
 
-	// get all members to change set the current member's turn for the next one
-	allMembers, err := s.memberRepo.GetByLeague(draft.LeagueID)
+	// execute picks (new model: creates DraftPick + Claim instead of DraftedPokemon)
+	err = s.executeNewPickTransactions(draft, league, member, allRequestedPoolEntries, input, memberCount, totalRequestedCost, originalOwners)
 	if err != nil {
-		log.Printf("DraftService: MakePick - Could not get all members in league %s: %v\n", league.ID, err)
-		return types.ErrInternalService
+		log.Printf("LOG: (DraftService: makePick): (member %s; league %s) Batch transaction unsucessful: %v\n", member.ID, league.ID, err)
+		return err
 	}
+	s.publishPicksMade(league.ID, member.ID, allRequestedPoolEntries, input)
 
 	// advance turn (if CurrentPickSlotUsed) and update draft model
-	draft, err = s.advanceDraftState(draft, league, member, allMembers, int(memberCount), currentPickSlotUsed)
+	draft, err = s.advanceDraftState(draft, league, member, allMembers, currentPickSlotUsed)
 	if err != nil {
-		log.Printf("LOG: (DraftService: MakePick) - Error occured when attempting to advance draft state for league %s: %v\n", league.ID, err)
+		log.Printf("LOG: (DraftService: makePick) - Error occured when attempting to advance draft state for league %s: %v\n", league.ID, err)
 		return err
 	}
 
 	if draft.Status == enums.DraftStatusCompleted {
 		fmt.Printf("INFO: (DraftService: advanceDraftState) - Draft Action (for league %s) was successful and Draft was detected to be COMPLETED. DraftStatus updated to COMPLETED.\n", draft.LeagueID)
-		taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, draft.LeagueID)
-		s.schedulerService.DeregisterTask(taskIDToDeregister)
+		s.cancelTurnTimeout(draft)
 		return nil
 	}
 
 	// deregister previous task before registering new one
-	taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, draft.LeagueID)
-	s.schedulerService.DeregisterTask(taskIDToDeregister)
+	s.cancelTurnTimeout(draft)
 
-	// schedule the timer task for the next player's turn if the draft hasn't completed
-	taskType := utils.TaskTypeDraftTurnTimeout
-	turnTimeLimit := draft.TurnTimeLimit
-	turnStartTime := draft.CurrentTurnStartTime
-	turnEndTime := turnStartTime.Add(time.Duration(turnTimeLimit) * time.Minute)
-	task := &utils.ScheduledTask{
-		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
-		ExecuteAt: turnEndTime,
-		Type:      taskType,
-		Payload: utils.PayloadDraftTurnTimeout{
-			LeagueID: draft.LeagueID,
-			PlayerID: *draft.CurrentTurnMemberID,
-		},
+	// a force pick on a paused draft leaves it paused; ResumeDraft starts the next timer
+	if draft.Status != enums.DraftStatusOngoing {
+		return nil
 	}
 
-	s.schedulerService.RegisterTask(task)
+	// schedule the timer task for the next player's turn if the draft hasn't completed
+	s.scheduleTurnTimeout(draft)
 
 	// TODO: Trigger webhook notification for the pick that just happened as well as the turn change
 
@@ -424,6 +655,19 @@ func (s *draftServiceImpl) SkipTurn(currentUser *models.User, leagueID uuid.UUID
 		return types.ErrUnauthorized
 	}
 
+	// in an auction draft skipping passes the nomination on; it doesn't use up a skip
+	if league.Format.IsAuctionDraft() {
+		if draft.AuctionPoolEntryID != nil {
+			return types.ErrAuctionLotOpen
+		}
+		s.cancelTurnTimeout(draft)
+		if _, err := s.passAuctionNomination(league, member.ID); err != nil {
+			log.Printf("LOG: (DraftService: SkipTurn) - Error passing nomination in league %s: %v\n", league.ID, err)
+			return err
+		}
+		return nil
+	}
+
 	// get all members to change set the current member's turn for the next one
 	allMembers, err := s.memberRepo.GetByLeague(draft.LeagueID)
 	if err != nil {
@@ -453,7 +697,7 @@ func (s *draftServiceImpl) SkipTurn(currentUser *models.User, leagueID uuid.UUID
 	}
 	log.Printf("DEBUG: (DraftService: SkipTurn) - Member %s SkipsLeft AFTER DB re-fetch: %d\n", updatedMember.ID, updatedMember.SkipsLeft)
 
-	draft, err = s.advanceDraftState(draft, league, member, allMembers, len(allMembers), false)
+	draft, err = s.advanceDraftState(draft, league, member, allMembers, false)
 	if err != nil {
 		log.Printf("LOG: (DraftService: SkipTurn) - Error occured when attempting to advance draft state for league %s: %v\n", league.ID, err)
 		return err
@@ -461,38 +705,26 @@ func (s *draftServiceImpl) SkipTurn(currentUser *models.User, leagueID uuid.UUID
 
 	if draft.Status == enums.DraftStatusCompleted {
 		fmt.Printf("INFO: (DraftService: SkipTurn) - Draft Action (for league %s) was successful and Draft was detected to be COMPLETED. DraftStatus updated to COMPLETED.\n", draft.LeagueID)
-		taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, draft.LeagueID)
-		s.schedulerService.DeregisterTask(taskIDToDeregister)
+		s.cancelTurnTimeout(draft)
 		return nil
 	}
 
-	taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, draft.LeagueID)
-	s.schedulerService.DeregisterTask(taskIDToDeregister)
+	s.cancelTurnTimeout(draft)
 
 	// schedule the timer task if the draft hasn't completed
-	taskType := utils.TaskTypeDraftTurnTimeout
-	turnTimeLimit := draft.TurnTimeLimit
-	turnStartTime := draft.CurrentTurnStartTime
-	turnEndTime := turnStartTime.Add(time.Duration(turnTimeLimit) * time.Minute)
-	task := &utils.ScheduledTask{
-		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
-		ExecuteAt: turnEndTime,
-		Type:      taskType,
-		Payload: utils.PayloadDraftTurnTimeout{
-			LeagueID: draft.LeagueID,
-			PlayerID: *draft.CurrentTurnMemberID,
-		},
-	}
-	s.schedulerService.RegisterTask(task)
+	s.scheduleTurnTimeout(draft)
 
 	// successful skip
 	return nil
 }
 
-// AutoSkipTurn is called by the SchedulerService when a player's turn timer expires.
-// It attempts to automatically skip the turn. If the skip is not allowed (e.g., it
-// would violate minimum roster size), the draft is paused for manual intervention.
-func (s *draftServiceImpl) AutoSkipTurn(memberID, leagueID uuid.UUID) error {
+// AutoSkipTurn is called by the SchedulerService when a player's turn timer expires and follows the
+// league's TurnTimeoutPolicy: draft from the member's queue (the default) or the best available entry,
+// skip, or pause. When there's nothing to auto-pick it attempts to automatically skip the turn. If the
+// skip is not allowed (e.g., it would violate minimum roster size), the draft is paused for manual
+// intervention. A timeout for a turn that has since ended (memberID no longer on the clock for
+// pickNumber) is ignored; pickNumber 0 skips the pick check.
+func (s *draftServiceImpl) AutoSkipTurn(memberID, leagueID uuid.UUID, pickNumber int) error {
 	member, err := s.memberRepo.GetByID(memberID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -524,20 +756,49 @@ func (s *draftServiceImpl) AutoSkipTurn(memberID, leagueID uuid.UUID) error {
 			return types.ErrInternalService
 		}
 	}
+	if draft.Status != enums.DraftStatusOngoing {
+		// e.g. staff paused the draft after the timer fired; the timeout no longer applies
+		log.Printf("WARN: (DraftService: AutoSkipTurn) - draft for league %s is %s, not ONGOING. Ignoring timeout for member %s.\n", leagueID, draft.Status, memberID)
+		return nil
+	}
+	if draft.CurrentTurnMemberID == nil || *draft.CurrentTurnMemberID != memberID || (pickNumber != 0 && draft.CurrentPickOnClock != pickNumber) {
+		log.Printf("WARN: (DraftService: AutoSkipTurn) - pick %d of member %s in league %s is no longer on the clock. Ignoring.\n", pickNumber, memberID, leagueID)
+		return nil
+	}
+
+	// a nomination that times out just passes to the next member
+	if league.Format.IsAuctionDraft() {
+		if _, err := s.passAuctionNomination(league, member.ID); err != nil {
+			if errors.Is(err, types.ErrConflict) {
+				log.Printf("WARN: (DraftService: AutoSkipTurn) - nomination of member %s in league %s moved on meanwhile. Ignoring.\n", memberID, leagueID)
+				return nil
+			}
+			log.Printf("ERROR: (DraftService: AutoSkipTurn) - could not pass nomination in league %s: %v\n", leagueID, err)
+			return err
+		}
+		return nil
+	}
+
+	switch league.Format.TimeoutPolicy() {
+	case enums.DraftTimeoutPolicyPause:
+		log.Printf("LOG: (DraftService: AutoSkipTurn) - League %s pauses on timeout. Member %s timed out.\n", leagueID, memberID)
+		return s.pauseForIntervention(draft, member)
+	case enums.DraftTimeoutPolicyAutoPickBestAvailable:
+		if poolEntry := bestAvailableAutoPick(s.claimRepo, s.poolEntryRepo, league, member); poolEntry != nil {
+			return s.autoPick(draft, league, member, poolEntry)
+		}
+	case enums.DraftTimeoutPolicyAutoPickQueue:
+		// try drafting from the member's queue before burning a skip
+		if queuedPoolEntry := s.findQueuedAutoPick(league, member); queuedPoolEntry != nil {
+			return s.autoPick(draft, league, member, queuedPoolEntry)
+		}
+	}
 
 	effectiveSkipsInThisAction := 1
 	allowed, err := s.isSkipAllowed(member, effectiveSkipsInThisAction)
 	if !allowed {
 		log.Printf("ERROR: (DraftService: AutoSkipTurn) - Cannot auto skip for member %s, league %s: %v. Skips left: %d\n", memberID, leagueID, err, member.SkipsLeft)
-		// set Draft to PAUSED status, awaiting manual league staff intervention
-		draft.Status = enums.DraftStatusPaused
-		draft, err = s.draftRepo.UpdateDraft(draft)
-		if err != nil {
-			log.Printf("ERROR: (DraftService: AutoSkipTurn) - Could not update draft %d status to PAUSED: %v\n", draft.ID, err)
-			return types.ErrInternalService
-		}
-		fmt.Printf("INFO: (DraftService: AutoSkipTurn) - Draft for league %s paused. Awaiting Manual Intervention\n", leagueID)
-		return types.ErrDraftPausedForIntervention
+		return s.pauseForIntervention(draft, member)
 	}
 
 	member.SkipsLeft -= effectiveSkipsInThisAction
@@ -561,7 +822,7 @@ func (s *draftServiceImpl) AutoSkipTurn(memberID, leagueID uuid.UUID) error {
 		return types.ErrInternalService
 	}
 
-	draft, err = s.advanceDraftState(draft, league, member, allMembers, len(allMembers), false)
+	draft, err = s.advanceDraftState(draft, league, member, allMembers, false)
 	if err != nil {
 		log.Printf("ERROR: (DraftService: AutoSkipTurn) - could not advance draft")
 		return err
@@ -575,26 +836,252 @@ func (s *draftServiceImpl) AutoSkipTurn(memberID, leagueID uuid.UUID) error {
 	}
 
 	// schedule the timer task if the draft hasn't completed
-	taskType := utils.TaskTypeDraftTurnTimeout
-	turnTimeLimit := draft.TurnTimeLimit
-	turnStartTime := draft.CurrentTurnStartTime
-	turnEndTime := turnStartTime.Add(time.Duration(turnTimeLimit) * time.Minute)
-	task := &utils.ScheduledTask{
-		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
-		ExecuteAt: turnEndTime,
-		Type:      taskType,
-		Payload: utils.PayloadDraftTurnTimeout{
-			LeagueID: draft.LeagueID,
-			PlayerID: *draft.CurrentTurnMemberID,
-		},
-	}
-
-	s.schedulerService.RegisterTask(task)
+	s.scheduleTurnTimeout(draft)
 	fmt.Printf("INFO: (DraftService: AutoSkipTurn) - Success\n")
 	// success
 	return nil
 }
 
+// pauseForIntervention pauses the draft after member's turn timed out and couldn't be resolved
+// automatically, awaiting manual league staff intervention (ForcePick, SkipPick or ResumeDraft).
+func (s *draftServiceImpl) pauseForIntervention(draft *models.Draft, member *models.LeagueMember) error {
+	draft.Status = enums.DraftStatusPaused
+	draft, err := s.draftRepo.UpdateDraft(draft)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: pauseForIntervention) - Could not update draft status to PAUSED for league %s: %v\n", member.LeagueID, err)
+		return types.ErrInternalService
+	}
+	fmt.Printf("INFO: (DraftService: pauseForIntervention) - Draft for league %s paused. Awaiting Manual Intervention\n", draft.LeagueID)
+	s.publishEvent(draft.LeagueID, types.DraftEventDraftPaused, types.DraftEventDraftPausedPayload{
+		MemberID: &member.ID,
+		Reason:   types.ErrDraftPausedForIntervention.Error(),
+	})
+	return types.ErrDraftPausedForIntervention
+}
+
+// PauseDraft stops an ongoing draft and cancels the current turn's timer.
+// Nobody can pick or skip until league staff call ResumeDraft (staff can still ForcePick).
+func (s *draftServiceImpl) PauseDraft(leagueID uuid.UUID) (*models.Draft, error) {
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: PauseDraft) - could not fetch draft for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+	if draft.Status != enums.DraftStatusOngoing {
+		log.Printf("LOG: (DraftService: PauseDraft) - draft for league %s is %s, not ONGOING\n", leagueID, draft.Status)
+		return nil, types.ErrInvalidState
+	}
+
+	draft.Status = enums.DraftStatusPaused
+	draft, err = s.draftRepo.UpdateDraft(draft)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: PauseDraft) - Could not update draft status to PAUSED for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	// an open auction lot is frozen along with the draft; ResumeDraft restarts its countdown
+	if draft.AuctionPoolEntryID != nil {
+		taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeAuctionLotClose, leagueID)
+		s.schedulerService.DeregisterTask(taskIDToDeregister)
+	} else {
+		s.cancelTurnTimeout(draft)
+	}
+
+	s.publishEvent(leagueID, types.DraftEventDraftPaused, types.DraftEventDraftPausedPayload{
+		MemberID: draft.CurrentTurnMemberID,
+		Reason:   "paused by league staff",
+	})
+	return draft, nil
+}
+
+// ResumeDraft restarts a paused draft. The member on the clock gets a fresh turn timer,
+// or in an auction draft with an open lot, the lot gets a fresh bid countdown.
+func (s *draftServiceImpl) ResumeDraft(leagueID uuid.UUID) (*models.Draft, error) {
+	draft, err := s.fetchDraftResource(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: ResumeDraft) - could not fetch draft for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+	if draft.Status != enums.DraftStatusPaused {
+		log.Printf("LOG: (DraftService: ResumeDraft) - draft for league %s is %s, not PAUSED\n", leagueID, draft.Status)
+		return nil, types.ErrInvalidState
+	}
+
+	lotOpen := draft.AuctionPoolEntryID != nil
+	currTime := s.clock.Now()
+	draft.Status = enums.DraftStatusOngoing
+	draft.CurrentTurnStartTime = &currTime
+	draft.AuctionPasses = 0
+	if lotOpen {
+		league, err := s.leagueRepo.GetLeagueByID(leagueID)
+		if err != nil {
+			log.Printf("LOG: (DraftService: ResumeDraft) - could not find league %s: %v\n", leagueID, err)
+			return nil, types.ErrLeagueNotFound
+		}
+		lotEndsAt := currTime.Add(auctionBidTime(league))
+		draft.AuctionLotEndsAt = &lotEndsAt
+	}
+	draft, err = s.draftRepo.UpdateDraft(draft)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: ResumeDraft) - Could not update draft status to ONGOING for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	// clear out any stale timer before starting the fresh one
+	if lotOpen {
+		taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeAuctionLotClose, leagueID)
+		s.schedulerService.DeregisterTask(taskIDToDeregister)
+		s.scheduleAuctionLotClose(draft)
+	} else {
+		s.cancelTurnTimeout(draft)
+		s.scheduleTurnTimeout(draft)
+	}
+
+	s.publishEvent(leagueID, types.DraftEventDraftResumed, nil)
+	if lotOpen {
+		s.publishAuctionLot(draft, types.DraftEventAuctionLotOpen)
+	} else {
+		s.publishTurnChanged(draft)
+	}
+	return draft, nil
+}
+
+// SetCustomDraftOrder switches a league to a staff defined round order: for every round, the draft
+// positions in the order they pick. It can only be set before the draft starts, since slots already
+// dealt (and traded) follow the order in place when the draft began.
+func (s *draftServiceImpl) SetCustomDraftOrder(leagueID uuid.UUID, input *requests.DraftCustomOrderRequestDTO) (*models.League, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		log.Printf("LOG: (DraftService: SetCustomDraftOrder) - could not fetch league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	if league.Format.IsAuctionDraft() {
+		return nil, types.ErrInvalidState
+	}
+	if draft, err := s.draftRepo.GetDraftByLeagueID(leagueID); err == nil && draft.Status != enums.DraftStatusPending {
+		log.Printf("LOG: (DraftService: SetCustomDraftOrder) - draft for league %s is already %s\n", leagueID, draft.Status)
+		return nil, types.ErrInvalidState
+	}
+	memberCount, err := s.memberRepo.GetCountByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: SetCustomDraftOrder) - could not count members of league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	if err := validateCustomDraftOrder(input.Rounds, int(memberCount), league.MaxPokemonPerPlayer); err != nil {
+		log.Printf("LOG: (DraftService: SetCustomDraftOrder) - invalid order for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+
+	format := types.LeagueFormat{}
+	if league.Format != nil {
+		format = *league.Format
+	}
+	format.DraftRoundOrder = enums.DraftRoundOrderCustom
+	format.CustomDraftOrder = input.Rounds
+	league.Format = &format
+	league, err = s.leagueRepo.UpdateLeague(league)
+	if err != nil {
+		log.Printf("LOG: (DraftService: SetCustomDraftOrder) - could not save league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return league, nil
+}
+
+// UndoLastPick reverts the most recent pick of a league's draft. The DraftPick is deleted, its Claim
+// deactivated, the points refunded and the PoolEntry made available again, together with the
+// rewound draft state in one transaction (see DraftPickRepository.UndoPick).
+//
+// If the undone pick is the one right before the pick on the clock, the clock moves back and the
+// member who made it is on the clock again. Otherwise (an accumulated pick, or turns were skipped
+// since) the pick number is handed back to the member as an accumulated pick.
+// Undoing the pick that completed a draft reopens it. Auction drafts don't support undo.
+func (s *draftServiceImpl) UndoLastPick(leagueID uuid.UUID) (*models.Draft, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: UndoLastPick) - could not find league %s: %v\n", leagueID, err)
+		return nil, types.ErrLeagueNotFound
+	}
+
+	draft, err := s.fetchDraftResource(league.ID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: UndoLastPick) - could not fetch draft for league %s: %v\n", league.ID, err)
+		return nil, err
+	}
+	if draft.Status == enums.DraftStatusPending || league.Format.IsAuctionDraft() {
+		return nil, types.ErrInvalidState
+	}
+	if draft.Status == enums.DraftStatusCompleted && league.Status != enums.LeagueStatusPostDraft {
+		// the league has moved on (e.g. the season started); too late to rewrite the draft
+		log.Printf("LOG: (DraftService: UndoLastPick) - league %s is %s; completed draft can no longer be undone\n", league.ID, league.Status)
+		return nil, types.ErrInvalidState
+	}
+
+	pick, err := s.draftPickRepo.GetLatestByDraft(draft.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrDraftPickNotFound
+		}
+		log.Printf("LOG: (DraftService: UndoLastPick) - failed to fetch latest pick for draft %s: %v\n", draft.ID, err)
+		return nil, types.ErrInternalService
+	}
+
+	memberCount, err := s.memberRepo.GetCountByLeague(league.ID)
+	if err != nil || memberCount == 0 {
+		log.Printf("LOG: (DraftService: UndoLastPick) - failed to get member count for league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+
+	// rewind the draft state
+	currTime := s.clock.Now()
+	turnRewound := pick.PickNumber == draft.CurrentPickOnClock-1
+	reopened := draft.Status == enums.DraftStatusCompleted
+	if turnRewound {
+		draft.CurrentPickOnClock = pick.PickNumber
+		draft.CurrentRound = ((pick.PickNumber - 1) / int(memberCount)) + 1
+		draft.CurrentPickInRound = ((pick.PickNumber - 1) % int(memberCount)) + 1
+		draft.CurrentTurnMemberID = &pick.PlayerID
+		draft.CurrentTurnStartTime = &currTime
+	} else {
+		accumulatedPicks := append(draft.PlayersWithAccumulatedPicks[pick.PlayerID], pick.PickNumber)
+		slices.Sort(accumulatedPicks)
+		draft.PlayersWithAccumulatedPicks[pick.PlayerID] = accumulatedPicks
+	}
+	if reopened {
+		draft.Status = enums.DraftStatusOngoing
+		draft.EndTime = time.Time{}
+		draft.CurrentTurnStartTime = &currTime
+		league.Status = enums.LeagueStatusDrafting
+	}
+
+	refund, err := s.draftPickRepo.UndoPick(pick, draft, league)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: UndoLastPick) - Failed to undo pick %s in league %s: %v\n", pick.ID, league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	log.Printf("LOG: (DraftService: UndoLastPick) - Undid pick %d (member %s) in league %s. Refunded %d points.\n", pick.PickNumber, pick.PlayerID, league.ID, refund)
+
+	// the member on the clock keeps their timer unless the turn itself was rewound
+	if turnRewound || reopened {
+		s.cancelTurnTimeout(draft)
+		if draft.Status == enums.DraftStatusOngoing {
+			s.scheduleTurnTimeout(draft)
+		}
+	}
+
+	s.publishEvent(league.ID, types.DraftEventPickUndone, types.DraftEventPickUndonePayload{
+		MemberID:        pick.PlayerID,
+		PoolEntryID:     pick.PoolEntryID,
+		DraftPickNumber: pick.PickNumber,
+		RefundedPoints:  refund,
+	})
+	s.publishTurnChanged(draft)
+
+	return draft, nil
+}
+
 // advanceDraftState moves the draft to the next turn or completes it.
 // It increments the pick counter, checks if the draft's end conditions are met,
 // determines the next player based on the draft order (linear or snake), and updates the draft model.
@@ -603,7 +1090,6 @@ func (s *draftServiceImpl) advanceDraftState(
 	league *models.League,
 	member *models.LeagueMember, // The member whose turn just ended/skipped
 	allMembers []models.LeagueMember, // All members in the league, for turn progression
-	memberCount int,
 	currentPickSlotUsed bool, // true if draft.CurrentPickOnClock was used in the request, false if skipped/implicitly skipped
 ) (*models.Draft, error) {
 	if !currentPickSlotUsed {
@@ -627,30 +1113,19 @@ func (s *draftServiceImpl) advanceDraftState(
 		// If the draft has completed, we update and save the final state and return early
 		// no further turn progression is needed
 
-		draft.Status = enums.DraftStatusCompleted
-		league.Status = enums.LeagueStatusPostDraft
-		draft.EndTime = time.Now()
-
-		draft, err := s.draftRepo.UpdateDraft(draft)
+		draft, err := s.completeDraft(draft, league)
 		if err != nil {
-			log.Printf("LOG: (DraftService: advanceDraftState) - Failed to update draft status to COMPLETED for league %s:%v\n", league.ID, err)
-			return nil, fmt.Errorf("failed to update draft state on completion: %w", err)
+			return nil, err
 		}
-		// save league status updated by checkDraftCompletion
-		if _, err := s.leagueRepo.UpdateLeague(league); err != nil { // pray this never happens type shit
-			// should prolly revert the draft update
-			log.Printf("LOG: (DraftService: advanceDraftState) - Failed to update league status to POST_DRAFT for league %s: %v\n", league.ID, err)
-			return nil, fmt.Errorf("failed to update league status on completion: %w", err)
+
+		if !currentPickSlotUsed {
+			s.publishPickAccumulated(draft, member.ID)
 		}
+		s.publishEvent(draft.LeagueID, types.DraftEventDraftCompleted, types.DraftEventDraftCompletedPayload{EndTime: draft.EndTime})
 
 		return draft, nil // Draft completed, states saved. We're so done
 	}
 
-	// If draft is still ongoing,
-	// Recalculate CurrentRound and CurrentPickInRound based on draft.CurrentPickOnClock
-	draft.CurrentRound = ((draft.CurrentPickOnClock - 1) / int(memberCount)) + 1
-	draft.CurrentPickInRound = ((draft.CurrentPickOnClock - 1) % int(memberCount)) + 1
-
 	currentMemberIdx := -1
 	for i, m := range allMembers { // there is likely some smort mafs you can do here to avoid an O(n) search. im stupid tho
 		if m.ID == member.ID {
@@ -663,31 +1138,21 @@ func (s *draftServiceImpl) advanceDraftState(
 		return nil, types.ErrInternalService
 	}
 
-	var nextMemberIdx int
-	if league.Format.IsSnakeRoundDraft {
-		if draft.CurrentRound%2 != 0 { // Odd round (forward order)
-			nextMemberIdx = draft.CurrentPickInRound - 1
-		} else { // Even round (reverse order)
-			nextMemberIdx = int(memberCount) - draft.CurrentPickInRound
-		}
-	} else { // linear draft
-		nextMemberIdx = currentMemberIdx + 1
+	// If draft is still ongoing, put whoever holds the next pick number on the clock
+	owners, err := s.loadSlotOwners(league.ID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: advanceDraftState) - Could not fetch traded slots for league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
 	}
-
-	if nextMemberIdx >= int(memberCount) || nextMemberIdx < 0 {
-		// The CurrentRound and CurrentPickInRound are already correctly set by recalculation.
-		// We just need to adjust nextMemberIdx for the start of the new round.
-		if league.Format.IsSnakeRoundDraft && draft.CurrentRound%2 == 0 { // if snake round drafting and new round is even
-			nextMemberIdx = int(memberCount) - 1 // last member in reverse order
-		} else {
-			nextMemberIdx = 0 // first member in forward order
-		}
+	nextTurn, err := turnForPick(league, allMembers, owners, draft.CurrentPickOnClock)
+	if err != nil {
+		log.Printf("LOG: (DraftService: advanceDraftState) - Could not resolve pick %d in league %s: %v\n", draft.CurrentPickOnClock, league.ID, err)
+		return nil, types.ErrInternalService
 	}
-
-	// finally set the next turn of member
-	nextTurnMember := allMembers[nextMemberIdx]
-	draft.CurrentTurnMemberID = &nextTurnMember.ID
-	draft.CurrentTurnStartTime = func() *time.Time { t := time.Now(); return &t }()
+	draft.CurrentRound = nextTurn.Round
+	draft.CurrentPickInRound = nextTurn.PickInRound
+	draft.CurrentTurnMemberID = &nextTurn.MemberID
+	draft.CurrentTurnStartTime = func() *time.Time { t := s.clock.Now(); return &t }()
 
 	draft, err = s.draftRepo.UpdateDraft(draft)
 	if err != nil {
@@ -695,6 +1160,31 @@ func (s *draftServiceImpl) advanceDraftState(
 		return nil, types.ErrInternalService
 	}
 
+	if !currentPickSlotUsed {
+		s.publishPickAccumulated(draft, member.ID)
+	}
+	s.publishTurnChanged(draft)
+
+	return draft, nil
+}
+
+// completeDraft moves the draft to COMPLETED and the league to POST_DRAFT and saves both.
+// Callers publish DRAFT_COMPLETED themselves so it goes out after their own events.
+func (s *draftServiceImpl) completeDraft(draft *models.Draft, league *models.League) (*models.Draft, error) {
+	draft.Status = enums.DraftStatusCompleted
+	league.Status = enums.LeagueStatusPostDraft
+	draft.EndTime = s.clock.Now()
+
+	draft, err := s.draftRepo.UpdateDraft(draft)
+	if err != nil {
+		log.Printf("LOG: (DraftService: completeDraft) - Failed to update draft status to COMPLETED for league %s:%v\n", league.ID, err)
+		return nil, fmt.Errorf("failed to update draft state on completion: %w", err)
+	}
+	if _, err := s.leagueRepo.UpdateLeague(league); err != nil { // pray this never happens type shit
+		// should prolly revert the draft update
+		log.Printf("LOG: (DraftService: completeDraft) - Failed to update league status to POST_DRAFT for league %s: %v\n", league.ID, err)
+		return nil, fmt.Errorf("failed to update league status on completion: %w", err)
+	}
 	return draft, nil
 }
 
@@ -709,6 +1199,7 @@ func (s *draftServiceImpl) executeNewPickTransactions(
 	input *requests.DraftMakePickRequestDTO,
 	memberCount int64,
 	totalRequestedCost int,
+	originalOwners map[int]uuid.UUID, // pick number -> member the slot was dealt to, for traded slots
 ) error {
 	var err error
 	// Build draft pick and claim records
@@ -742,6 +1233,9 @@ func (s *draftServiceImpl) executeNewPickTransactions(
 			RoundNumber: draftRoundNumber,
 			PickNumber:  requestedPick.DraftPickNumber,
 		}
+		if originalOwnerID, traded := originalOwners[requestedPick.DraftPickNumber]; traded {
+			draftPick.OriginalOwnerID = &originalOwnerID
+		}
 		draftPicks = append(draftPicks, draftPick)
 
 		// Cache accumulated pick numbers to remove
@@ -843,6 +1337,7 @@ func (s *draftServiceImpl) validatePicksAndCheckCurrentPickSlotUsed(
 	member *models.LeagueMember,
 	league *models.League,
 	input *requests.DraftMakePickRequestDTO,
+	requestedPoolEntries []*models.PoolEntry,
 	totalRequestedCost int,
 ) (bool, error) {
 	memberID := *draft.CurrentTurnMemberID // validated earlier to match currentMember
@@ -887,7 +1382,12 @@ func (s *draftServiceImpl) validatePicksAndCheckCurrentPickSlotUsed(
 		return false, types.ErrInsufficientDraftPoints
 	}
 
-	// 3. "Skips Left" Preventative Validation
+	// 3. Check the batch keeps the roster within the league's tier limits
+	if err := checkRosterTierLimits(s.claimRepo, s.poolEntryRepo, league, member.ID, requestedPoolEntries, nil); err != nil {
+		return false, err
+	}
+
+	// 4. "Skips Left" Preventative Validation
 	// This ensures the member doesn't implicitly skip their current turn's slot
 	// if doing so would prevent them from meeting MinPokemonPerPlayer.
 
@@ -936,6 +1436,18 @@ func (s *draftServiceImpl) getTotalCostForPoolEntries(allRequestedPoolEntries []
 	return sumCost
 }
 
+// saveDraftPositions assigns 1-based draft positions in slice order and saves them.
+func (s *draftServiceImpl) saveDraftPositions(members []models.LeagueMember) error {
+	for i := range members {
+		members[i].DraftPosition = i + 1
+		if err := s.memberRepo.UpdateDraftPosition(members[i].ID, members[i].DraftPosition); err != nil {
+			log.Printf("LOG: (Error: DraftService.saveDraftPositions) - Failed to update draft position for member %s: %v\n", members[i].ID, err)
+			return types.ErrInternalService
+		}
+	}
+	return nil
+}
+
 // fetchDraftResource retrieves the draft for a league, converting a gorm.ErrRecordNotFound
 // into a service-specific error.
 func (s *draftServiceImpl) fetchDraftResource(leagueID uuid.UUID) (*models.Draft, error) {
@@ -1043,6 +1555,399 @@ func (s *draftServiceImpl) checkDraftCompletion(
 	return true, nil
 }
 
+// findQueuedAutoPick returns the highest ranked entry in the member's draft queue that is still
+// available, affordable and would not push the member over the league's roster maximum or tier limits.
+// Like bestAvailableAutoPick, it keeps back enough points to fill the rest of the member's minimum
+// roster at the cheapest cost left.
+// It returns nil if nothing in the queue is valid (or the queue can't be read), in which case
+// the caller falls back to skipping the turn.
+func (s *draftServiceImpl) findQueuedAutoPick(league *models.League, member *models.LeagueMember) *models.PoolEntry {
+	if s.draftQueueRepo == nil {
+		return nil
+	}
+
+	queue, err := s.draftQueueRepo.GetByPlayer(member.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: findQueuedAutoPick) - Could not fetch draft queue for member %s: %v\n", member.ID, err)
+		return nil
+	}
+	if len(queue) == 0 {
+		return nil
+	}
+
+	rosterSize, err := s.claimRepo.GetActiveCountByPlayer(member.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: findQueuedAutoPick) - Could not fetch roster size for member %s: %v\n", member.ID, err)
+		return nil
+	}
+	if rosterSize >= int64(league.MaxPokemonPerPlayer) {
+		log.Printf("LOG: (DraftService: findQueuedAutoPick) - Member %s roster is full (%d). Nothing to auto-pick.\n", member.ID, rosterSize)
+		return nil
+	}
+
+	budget := member.DraftPoints
+	if stillNeeded := max(league.MinPokemonPerPlayer-int(rosterSize)-1, 0); stillNeeded > 0 {
+		available, err := s.poolEntryRepo.GetAvailableByLeague(league.ID)
+		if err != nil {
+			log.Printf("ERROR: (DraftService: findQueuedAutoPick) - Could not fetch available pool entries for league %s: %v\n", league.ID, err)
+			return nil
+		}
+		budget -= stillNeeded * cheapestCost(available)
+	}
+
+	for _, queued := range queue {
+		poolEntry := queued.PoolEntry
+		if poolEntry == nil || poolEntry.LeagueID != league.ID || !poolEntry.IsAvailable {
+			continue
+		}
+		if poolEntry.Cost == nil || *poolEntry.Cost > budget {
+			continue
+		}
+		if checkRosterTierLimits(s.claimRepo, s.poolEntryRepo, league, member.ID, []*models.PoolEntry{poolEntry}, nil) != nil {
+			continue
+		}
+		return poolEntry
+	}
+
+	log.Printf("LOG: (DraftService: findQueuedAutoPick) - No valid entry in draft queue for member %s.\n", member.ID)
+	return nil
+}
+
+// bestAvailableAutoPick returns the available entry with the best base stat total per point that member
+// can afford while keeping enough points to fill the rest of their minimum roster at the cheapest cost
+// left. If the reserve rules everything out it settles for the best entry they can afford at all. Entries
+// the league's tier limits would reject are never considered. Returns nil if there's nothing to pick.
+// Used for the AUTO_PICK_BEST_AVAILABLE timeout policy and by mock draft bots.
+func bestAvailableAutoPick(
+	claimRepo repositories.ClaimRepository,
+	poolEntryRepo repositories.PoolEntryRepository,
+	league *models.League,
+	member *models.LeagueMember,
+) *models.PoolEntry {
+	rosterSize, err := claimRepo.GetActiveCountByPlayer(member.ID)
+	if err != nil || int(rosterSize) >= league.MaxPokemonPerPlayer {
+		return nil
+	}
+	available, err := poolEntryRepo.GetAvailableByLeague(league.ID)
+	if err != nil || len(available) == 0 {
+		return nil
+	}
+	if league.Format.HasTiers() {
+		roster, err := loadRosterTiers(claimRepo, poolEntryRepo, league, member.ID)
+		if err != nil {
+			return nil
+		}
+		available = slices.DeleteFunc(available, func(entry models.PoolEntry) bool {
+			return roster.check([]*models.PoolEntry{&entry}, nil) != nil
+		})
+	}
+
+	stillNeeded := max(league.MinPokemonPerPlayer-int(rosterSize)-1, 0)
+	budget := member.DraftPoints - stillNeeded*cheapestCost(available)
+
+	if best := bestValueEntry(available, budget); best != nil {
+		return best
+	}
+	return bestValueEntry(available, member.DraftPoints)
+}
+
+// cheapestCost returns the lowest cost among entries, or 0 if none has a cost.
+func cheapestCost(entries []models.PoolEntry) int {
+	cheapest := 0
+	for _, entry := range entries {
+		if entry.Cost != nil && (cheapest == 0 || *entry.Cost < cheapest) {
+			cheapest = *entry.Cost
+		}
+	}
+	return cheapest
+}
+
+// bestValueEntry returns the entry costing at most budget with the highest base stat total per point.
+func bestValueEntry(entries []models.PoolEntry, budget int) *models.PoolEntry {
+	var best *models.PoolEntry
+	bestValue := -1.0
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Cost == nil || *entry.Cost > budget {
+			continue
+		}
+		value := float64(baseStatTotal(entry.PokemonSpecies)) / float64(max(*entry.Cost, 1))
+		if value > bestValue {
+			best, bestValue = entry, value
+		}
+	}
+	return best
+}
+
+func baseStatTotal(species *models.PokemonSpecies) int {
+	if species == nil {
+		return 0
+	}
+	st := species.Stats
+	return st.Hp + st.Attack + st.Defense + st.SpecialAttack + st.SpecialDefense + st.Speed
+}
+
+// autoPick drafts poolEntry into the pick slot currently on the clock on the member's behalf after
+// their turn timed out, then advances the draft and schedules the next turn's timeout the same way
+// MakePick does.
+func (s *draftServiceImpl) autoPick(
+	draft *models.Draft,
+	league *models.League,
+	member *models.LeagueMember,
+	poolEntry *models.PoolEntry,
+) error {
+	input := &requests.DraftMakePickRequestDTO{
+		RequestedPickCount: 1,
+		RequestedPicks: []requests.RequestedPickDTO{
+			{PoolEntryID: poolEntry.ID, DraftPickNumber: draft.CurrentPickOnClock},
+		},
+	}
+	allRequestedPoolEntries := []*models.PoolEntry{poolEntry}
+
+	memberCount, err := s.memberRepo.GetCountByLeague(league.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: autoPick) - Failed to get member count for league %s: %v\n", league.ID, err)
+		return types.ErrInternalService
+	}
+
+	allMembers, err := s.memberRepo.GetByLeague(league.ID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: autoPick) - Could not get all members in league %s: %v\n", league.ID, err)
+		return types.ErrInternalService
+	}
+	originalOwners := s.tradedSlotOriginalOwners(league, member, input, allMembers)
+
+	err = s.executeNewPickTransactions(draft, league, member, allRequestedPoolEntries, input, memberCount, *poolEntry.Cost, originalOwners)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: autoPick) - (member %s; league %s) Auto-pick transaction unsuccessful: %v\n", member.ID, league.ID, err)
+		return err
+	}
+	s.publishPicksMade(league.ID, member.ID, allRequestedPoolEntries, input)
+	log.Printf("LOG: (DraftService: autoPick) - Auto-picked pool entry %s for member %s at pick %d\n", poolEntry.ID, member.ID, draft.CurrentPickOnClock)
+
+	// the drafted entry is no use in the queue anymore
+	if s.draftQueueRepo != nil {
+		if err := s.draftQueueRepo.DeleteByPlayerAndPoolEntry(member.ID, poolEntry.ID); err != nil {
+			log.Printf("WARN: (DraftService: autoPick) - Could not remove pool entry %s from member %s's queue: %v\n", poolEntry.ID, member.ID, err)
+		}
+	}
+
+	draft, err = s.advanceDraftState(draft, league, member, allMembers, true)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: autoPick) - could not advance draft for league %s: %v\n", league.ID, err)
+		return err
+	}
+
+	// called from the scheduler, so the expired task is already deregistered
+	if draft.Status == enums.DraftStatusCompleted {
+		fmt.Printf("INFO: (DraftService: autoPick) - Draft Action (for league %s) was successful and Draft was detected to be COMPLETED. DraftStatus updated to COMPLETED.\n", draft.LeagueID)
+		return nil
+	}
+
+	s.scheduleTurnTimeout(draft)
+	return nil
+}
+
+// draftTurn is where an overall pick number falls in the draft and who holds it.
+type draftTurn struct {
+	Round           int
+	PickInRound     int
+	OriginalOwnerID uuid.UUID // the member the slot was dealt to by draft position
+	MemberID        uuid.UUID // who holds the slot now; differs from OriginalOwnerID if it was traded
+}
+
+// turnForPick resolves an overall pick number to its round, position in the round and holder.
+// allMembers must be ordered by DraftPosition and owners come from loadSlotOwners. This is the single
+// place the turn order is worked out: the live draft uses it to put members on the clock and
+// GetPickSchedule to project the whole draft.
+func turnForPick(league *models.League, allMembers []models.LeagueMember, owners slotOwners, pickNumber int) (*draftTurn, error) {
+	memberCount := len(allMembers)
+	if memberCount == 0 || pickNumber < 1 {
+		return nil, fmt.Errorf("no turn for pick %d with %d members", pickNumber, memberCount)
+	}
+	turn := &draftTurn{
+		Round:       ((pickNumber - 1) / memberCount) + 1,
+		PickInRound: ((pickNumber - 1) % memberCount) + 1,
+	}
+
+	// the member the slot was dealt to, by draft position and the league's round order
+	idx := naturalSlotIndex(league, turn.Round, turn.PickInRound, memberCount)
+	if idx < 0 || idx >= memberCount {
+		return nil, fmt.Errorf("slot index %d out of range for %d members", idx, memberCount)
+	}
+	turn.OriginalOwnerID = allMembers[idx].ID
+	turn.MemberID = owners.owner(turn.OriginalOwnerID, turn.Round)
+	return turn, nil
+}
+
+// slotKey identifies a draft slot by the member it was dealt to and its round.
+type slotKey struct {
+	originalOwnerID uuid.UUID
+	round           int
+}
+
+// slotOwners maps each traded slot of a league to its current owner. Slots that never changed hands
+// have no entry.
+type slotOwners map[slotKey]uuid.UUID
+
+// owner returns who currently owns the slot dealt to originalOwnerID in the given round:
+// the trade recipient if the slot changed hands, otherwise the original owner.
+func (o slotOwners) owner(originalOwnerID uuid.UUID, round int) uuid.UUID {
+	if ownerID, ok := o[slotKey{originalOwnerID, round}]; ok {
+		return ownerID
+	}
+	return originalOwnerID
+}
+
+// loadSlotOwners reads the ownership of every traded slot in the league in one query.
+func (s *draftServiceImpl) loadSlotOwners(leagueID uuid.UUID) (slotOwners, error) {
+	owners := make(slotOwners)
+	if s.draftTradeRepo == nil {
+		return owners, nil
+	}
+	slots, err := s.draftTradeRepo.GetSlotsByLeague(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range slots {
+		owners[slotKey{slot.OriginalOwnerID, slot.RoundNumber}] = slot.OwnerID
+	}
+	return owners, nil
+}
+
+// tradedSlotOriginalOwners maps each requested pick number that member is using through a traded slot
+// to the member the slot was originally dealt to. Used to record DraftPick.OriginalOwnerID.
+func (s *draftServiceImpl) tradedSlotOriginalOwners(
+	league *models.League,
+	member *models.LeagueMember,
+	input *requests.DraftMakePickRequestDTO,
+	allMembers []models.LeagueMember,
+) map[int]uuid.UUID {
+	memberCount := len(allMembers)
+	originalOwners := make(map[int]uuid.UUID)
+	if memberCount == 0 {
+		return originalOwners
+	}
+	for _, requestedPick := range input.RequestedPicks {
+		round := ((requestedPick.DraftPickNumber - 1) / memberCount) + 1
+		pickInRound := ((requestedPick.DraftPickNumber - 1) % memberCount) + 1
+		idx := naturalSlotIndex(league, round, pickInRound, memberCount)
+		if idx < 0 || idx >= memberCount {
+			continue
+		}
+		if originalOwnerID := allMembers[idx].ID; originalOwnerID != member.ID {
+			originalOwners[requestedPick.DraftPickNumber] = originalOwnerID
+		}
+	}
+	return originalOwners
+}
+
+// scheduleTurnTimeout registers the timeout task for the member currently on the clock, along with
+// the turn's reminders. The deadline follows the draft's clock: the current round's time limit, frozen
+// through quiet hours.
+func (s *draftServiceImpl) scheduleTurnTimeout(draft *models.Draft) {
+	taskType := utils.TaskTypeDraftTurnTimeout
+	turnEndTime := draft.CurrentTurnEndsAt()
+
+	task := &utils.ScheduledTask{
+		ID:        fmt.Sprintf("%d_%s", taskType, draft.LeagueID),
+		ExecuteAt: *turnEndTime,
+		Type:      taskType,
+		Payload: utils.PayloadDraftTurnTimeout{
+			LeagueID:   draft.LeagueID,
+			PlayerID:   *draft.CurrentTurnMemberID,
+			PickNumber: draft.CurrentPickOnClock,
+		},
+	}
+
+	s.schedulerService.RegisterTask(task)
+	for _, reminder := range turnReminderTasks(draft, s.clock.Now()) {
+		s.schedulerService.RegisterTask(reminder)
+	}
+}
+
+// cancelTurnTimeout deregisters the timeout task of the turn in progress and any of its reminders.
+func (s *draftServiceImpl) cancelTurnTimeout(draft *models.Draft) {
+	taskIDToDeregister := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, draft.LeagueID)
+	s.schedulerService.DeregisterTask(taskIDToDeregister)
+	for _, percent := range draft.Clock.ReminderPercents() {
+		s.schedulerService.DeregisterTask(turnReminderTaskID(draft.LeagueID, percent))
+	}
+}
+
+// notifyLeague posts message to the league's Discord webhook, if it has one. Failures are only logged;
+// notifications never hold up the draft.
+func (s *draftServiceImpl) notifyLeague(league *models.League, message string) error {
+	if s.webhookService == nil || league.DiscordWebhookURL == nil || *league.DiscordWebhookURL == "" {
+		return nil
+	}
+	if err := (*s.webhookService).SendWebhookMessage(*league.DiscordWebhookURL, message); err != nil {
+		log.Printf("WARN: (DraftService: notifyLeague) - failed to send webhook for league %s: %v\n", league.ID, err)
+		return err
+	}
+	return nil
+}
+
+// publishEvent pushes an event onto the league's draft event stream if an event service is configured.
+func (s *draftServiceImpl) publishEvent(leagueID uuid.UUID, eventType types.DraftEventType, payload any) {
+	if s.eventService == nil {
+		return
+	}
+	s.eventService.Publish(leagueID, eventType, payload)
+}
+
+// publishPicksMade emits one PICK_MADE event per pick in a successful MakePick request.
+func (s *draftServiceImpl) publishPicksMade(
+	leagueID uuid.UUID,
+	memberID uuid.UUID,
+	allRequestedPoolEntries []*models.PoolEntry,
+	input *requests.DraftMakePickRequestDTO,
+) {
+	for _, requestedPick := range input.RequestedPicks {
+		for _, entry := range allRequestedPoolEntries {
+			if entry.ID != requestedPick.PoolEntryID {
+				continue
+			}
+			s.publishEvent(leagueID, types.DraftEventPickMade, types.DraftEventPickMadePayload{
+				MemberID:        memberID,
+				PoolEntryID:     entry.ID,
+				DraftPickNumber: requestedPick.DraftPickNumber,
+				Cost:            *entry.Cost,
+			})
+			break
+		}
+	}
+}
+
+// publishPickAccumulated emits a PICK_ACCUMULATED event for the pick the member just skipped.
+func (s *draftServiceImpl) publishPickAccumulated(draft *models.Draft, memberID uuid.UUID) {
+	accumulatedPicks := draft.PlayersWithAccumulatedPicks[memberID]
+	if len(accumulatedPicks) == 0 {
+		return
+	}
+	s.publishEvent(draft.LeagueID, types.DraftEventPickAccumulated, types.DraftEventPickAccumulatedPayload{
+		MemberID:         memberID,
+		DraftPickNumber:  accumulatedPicks[len(accumulatedPicks)-1],
+		AccumulatedPicks: accumulatedPicks,
+	})
+}
+
+// publishTurnChanged emits a TURN_CHANGED event describing who is now on the clock.
+func (s *draftServiceImpl) publishTurnChanged(draft *models.Draft) {
+	if draft.CurrentTurnMemberID == nil {
+		return
+	}
+	turnEndsAt := draft.CurrentTurnEndsAt()
+	s.publishEvent(draft.LeagueID, types.DraftEventTurnChanged, types.DraftEventTurnChangedPayload{
+		CurrentTurnMemberID:  *draft.CurrentTurnMemberID,
+		CurrentRound:         draft.CurrentRound,
+		CurrentPickInRound:   draft.CurrentPickInRound,
+		CurrentPickOnClock:   draft.CurrentPickOnClock,
+		CurrentTurnStartTime: draft.CurrentTurnStartTime,
+		TurnEndsAt:           turnEndsAt,
+	})
+}
+
 // NOTE: Old methods kept for reference during migration. Remove once migration is complete.
 // The following methods were replaced:
 // - executePickTransactions -> executeNewPickTransactions (uses DraftPick + Claim)
@@ -1,7 +1,11 @@
 package services_test
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
@@ -10,9 +14,11 @@ import (
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 type draftServiceMocks struct {
@@ -20,10 +26,13 @@ type draftServiceMocks struct {
 	leagueRepo       *mock_repositories.MockLeagueRepository
 	leagueMemberRepo *mock_repositories.MockLeagueMemberRepository
 	schedulerService *mock_services.MockSchedulerService
+	webhookService   *mock_services.MockWebhookService
 
 	poolEntryRepo *mock_repositories.MockPoolEntryRepository
 	draftPickRepo *mock_repositories.MockDraftPickRepository
 	claimRepo     *mock_repositories.MockClaimRepository
+
+	draftQueueRepo *mock_repositories.MockDraftQueueRepository
 }
 
 func setupDraftServiceTest() (services.DraftService, draftServiceMocks) {
@@ -32,16 +41,19 @@ func setupDraftServiceTest() (services.DraftService, draftServiceMocks) {
 		leagueRepo:       new(mock_repositories.MockLeagueRepository),
 		leagueMemberRepo: new(mock_repositories.MockLeagueMemberRepository),
 		schedulerService: new(mock_services.MockSchedulerService),
+		webhookService:   new(mock_services.MockWebhookService),
 		poolEntryRepo:    new(mock_repositories.MockPoolEntryRepository),
 		draftPickRepo:    new(mock_repositories.MockDraftPickRepository),
 		claimRepo:        new(mock_repositories.MockClaimRepository),
+		draftQueueRepo:   new(mock_repositories.MockDraftQueueRepository),
 	}
 
+	var webhookService services.WebhookService = mocks.webhookService
 	service := services.NewDraftService(
 		mocks.leagueRepo,
 		mocks.draftRepo,
 		mocks.leagueMemberRepo,
-		nil,
+		&webhookService,
 	)
 	service.SetSchedulerService(mocks.schedulerService)
 	service.SetNewRepositories(
@@ -49,6 +61,7 @@ func setupDraftServiceTest() (services.DraftService, draftServiceMocks) {
 		mocks.claimRepo,
 		mocks.poolEntryRepo,
 	)
+	service.SetDraftQueueRepository(mocks.draftQueueRepo)
 
 	return service, mocks
 }
@@ -286,3 +299,1755 @@ ID:               poolEntryID,
 		mocks.poolEntryRepo.AssertExpectations(t)
 	})
 }
+
+func TestDraftService_AutoSkipTurn(t *testing.T) {
+	leagueID := uuid.New()
+	memberID := uuid.New()
+	otherMemberID := uuid.New()
+	cost := func(i int) *int { return &i }
+
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 6,
+			Format:              &types.LeagueFormat{IsSnakeRoundDraft: true},
+		}
+	}
+	newDraft := func() *models.Draft {
+		return &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentRound:                1,
+			CurrentPickInRound:          1,
+			CurrentPickOnClock:          1,
+			CurrentTurnMemberID:         &memberID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+	}
+
+	t.Run("Success - Auto-picks highest valid queued entry", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 60, SkipsLeft: 2}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := newDraft()
+
+		draftedEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(10), IsAvailable: false}
+		expensiveEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(80), IsAvailable: true}
+		validEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(40), IsAvailable: true}
+		queue := []models.DraftQueueEntry{
+			{PlayerID: memberID, PoolEntryID: draftedEntry.ID, Rank: 1, PoolEntry: &draftedEntry},
+			{PlayerID: memberID, PoolEntryID: expensiveEntry.ID, Rank: 2, PoolEntry: &expensiveEntry},
+			{PlayerID: memberID, PoolEntryID: validEntry.ID, Rank: 3, PoolEntry: &validEntry},
+		}
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(queue, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].PoolEntryID == validEntry.ID && picks[0].PickNumber == 1
+		})).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, validEntry.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.draftQueueRepo.On("DeleteByPlayerAndPoolEntry", memberID, validEntry.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, localMember.DraftPoints)
+		assert.Equal(t, 2, localMember.SkipsLeft, "auto-pick should not use up a skip")
+		assert.Empty(t, localDraft.PlayersWithAccumulatedPicks[memberID])
+		assert.Equal(t, otherMemberID, *localDraft.CurrentTurnMemberID)
+		mocks.draftQueueRepo.AssertExpectations(t)
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+		mocks.claimRepo.AssertExpectations(t)
+		mocks.leagueMemberRepo.AssertExpectations(t)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Queued auto-pick keeps back points for the minimum roster", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 50, SkipsLeft: 2}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := newDraft()
+		league := newLeague()
+		league.MinPokemonPerPlayer = 3
+
+		// 40 is affordable, but would leave 10 for the 2 more the member needs at 10 each
+		greedyEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(40), IsAvailable: true}
+		validEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(30), IsAvailable: true}
+		cheapEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(10), IsAvailable: true}
+		queue := []models.DraftQueueEntry{
+			{PlayerID: memberID, PoolEntryID: greedyEntry.ID, Rank: 1, PoolEntry: &greedyEntry},
+			{PlayerID: memberID, PoolEntryID: validEntry.ID, Rank: 2, PoolEntry: &validEntry},
+		}
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(queue, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.poolEntryRepo.On("GetAvailableByLeague", leagueID).Return([]models.PoolEntry{greedyEntry, validEntry, cheapEntry}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].PoolEntryID == validEntry.ID
+		})).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, validEntry.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.draftQueueRepo.On("DeleteByPlayerAndPoolEntry", memberID, validEntry.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, localMember.DraftPoints)
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fallback - Skips when no queued entry is valid", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 30, SkipsLeft: 2}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := newDraft()
+
+		expensiveEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(80), IsAvailable: true}
+		queue := []models.DraftQueueEntry{
+			{PlayerID: memberID, PoolEntryID: expensiveEntry.ID, Rank: 1, PoolEntry: &expensiveEntry},
+		}
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Twice()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(queue, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, localMember.SkipsLeft)
+		assert.Equal(t, []int{1}, localDraft.PlayersWithAccumulatedPicks[memberID])
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+		mocks.draftQueueRepo.AssertExpectations(t)
+		mocks.leagueMemberRepo.AssertExpectations(t)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Fallback - Roster full skips queue", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 0}
+		localDraft := newDraft()
+
+		validEntry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(10), IsAvailable: true}
+		queue := []models.DraftQueueEntry{
+			{PlayerID: memberID, PoolEntryID: validEntry.ID, Rank: 1, PoolEntry: &validEntry},
+		}
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftQueueRepo.On("GetByPlayer", memberID).Return(queue, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(6), nil).Once()
+		// no skips left either, so the draft pauses
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.ErrorIs(t, err, types.ErrDraftPausedForIntervention)
+		assert.Equal(t, enums.DraftStatusPaused, localDraft.Status)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+		mocks.draftQueueRepo.AssertExpectations(t)
+		mocks.claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("Policy PAUSE - Pauses for staff even with skips and a queue", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 3}
+		localDraft := newDraft()
+		league := newLeague()
+		league.Format.TurnTimeoutPolicy = enums.DraftTimeoutPolicyPause
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.ErrorIs(t, err, types.ErrDraftPausedForIntervention)
+		assert.Equal(t, enums.DraftStatusPaused, localDraft.Status)
+		assert.Equal(t, 3, localMember.SkipsLeft)
+		mocks.draftQueueRepo.AssertNotCalled(t, "GetByPlayer", mock.Anything)
+		mocks.draftRepo.AssertExpectations(t)
+	})
+
+	t.Run("Ignores a timeout once the draft is no longer ongoing", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 3}
+		localDraft := newDraft()
+		localDraft.Status = enums.DraftStatusPaused
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, localMember.SkipsLeft)
+		mocks.draftRepo.AssertNotCalled(t, "UpdateDraft", mock.Anything)
+		mocks.leagueMemberRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Ignores a timeout for a pick the member has already made", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 3}
+		localDraft := newDraft()
+		localDraft.CurrentPickOnClock = 2 // still their turn, e.g. an accumulated pick
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, localMember.SkipsLeft)
+		mocks.draftQueueRepo.AssertNotCalled(t, "GetByPlayer", mock.Anything)
+		mocks.draftRepo.AssertNotCalled(t, "UpdateDraft", mock.Anything)
+	})
+
+	t.Run("Policy SKIP - Skips without looking at the queue", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 2}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := newDraft()
+		league := newLeague()
+		league.Format.TurnTimeoutPolicy = enums.DraftTimeoutPolicySkip
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Twice()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, localMember.SkipsLeft)
+		assert.Equal(t, []int{1}, localDraft.PlayersWithAccumulatedPicks[memberID])
+		mocks.draftQueueRepo.AssertNotCalled(t, "GetByPlayer", mock.Anything)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+	})
+
+	t.Run("Policy AUTO_PICK_BEST_AVAILABLE - Drafts the best value the member can afford", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 30, SkipsLeft: 2}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := newDraft()
+		league := newLeague()
+		league.Format.TurnTimeoutPolicy = enums.DraftTimeoutPolicyAutoPickBestAvailable
+		withBST := func(bst int) *models.PokemonSpecies {
+			stat := bst / 6
+			return &models.PokemonSpecies{Stats: models.BaseStats{Hp: stat, Attack: stat, Defense: stat, SpecialAttack: stat, SpecialDefense: stat, Speed: stat}}
+		}
+		tooExpensive := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(40), IsAvailable: true, PokemonSpecies: withBST(600)}
+		bestValue := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(10), IsAvailable: true, PokemonSpecies: withBST(540)}
+		worseValue := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, Cost: cost(20), IsAvailable: true, PokemonSpecies: withBST(600)}
+
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.poolEntryRepo.On("GetAvailableByLeague", leagueID).Return([]models.PoolEntry{tooExpensive, bestValue, worseValue}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].PoolEntryID == bestValue.ID && picks[0].PickNumber == 1
+		})).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, bestValue.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.draftQueueRepo.On("DeleteByPlayerAndPoolEntry", memberID, bestValue.ID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		err := service.AutoSkipTurn(memberID, leagueID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, localMember.DraftPoints)
+		assert.Equal(t, 2, localMember.SkipsLeft)
+		mocks.draftQueueRepo.AssertNotCalled(t, "GetByPlayer", mock.Anything)
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+	})
+}
+
+func TestDraftService_PauseAndResume(t *testing.T) {
+	leagueID := uuid.New()
+	memberID := uuid.New()
+
+	t.Run("Success - Pause cancels the turn timer", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{LeagueID: leagueID, Status: enums.DraftStatusOngoing, CurrentTurnMemberID: &memberID}
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+
+		draft, err := service.PauseDraft(leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusPaused, draft.Status)
+		mocks.schedulerService.AssertExpectations(t)
+		mocks.schedulerService.AssertNotCalled(t, "RegisterTask", mock.Anything)
+	})
+
+	t.Run("Failure - Pause a draft that isn't ongoing", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{LeagueID: leagueID, Status: enums.DraftStatusPaused}
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		_, err := service.PauseDraft(leagueID)
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+		mocks.draftRepo.AssertNotCalled(t, "UpdateDraft", mock.Anything)
+	})
+
+	t.Run("Success - Resume starts a fresh turn timer", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		staleStart := time.Now().Add(-48 * time.Hour)
+		localDraft := &models.Draft{
+			LeagueID:             leagueID,
+			Status:               enums.DraftStatusPaused,
+			CurrentTurnMemberID:  &memberID,
+			CurrentTurnStartTime: &staleStart,
+			TurnTimeLimit:        60,
+		}
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			return task.ExecuteAt.After(time.Now().Add(59 * time.Minute))
+		})).Return().Once()
+
+		draft, err := service.ResumeDraft(leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusOngoing, draft.Status)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Resume a draft that isn't paused", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{LeagueID: leagueID, Status: enums.DraftStatusOngoing}
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		_, err := service.ResumeDraft(leagueID)
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+}
+
+func TestDraftService_ForcePick(t *testing.T) {
+	leagueID := uuid.New()
+	memberID := uuid.New()
+	otherMemberID := uuid.New()
+	poolEntryID := uuid.New()
+
+	t.Run("Success - Force pick on a paused draft keeps it paused", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localLeague := &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 6,
+			Format:              &types.LeagueFormat{IsSnakeRoundDraft: true},
+		}
+		localMember := &models.LeagueMember{ID: memberID, LeagueID: leagueID, DraftPoints: 100}
+		allMembers := []models.LeagueMember{*localMember, {ID: otherMemberID, LeagueID: leagueID}}
+		localDraft := &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusPaused,
+			CurrentPickOnClock:          1,
+			CurrentTurnMemberID:         &memberID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		input := &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 1}},
+		}
+		poolEntry := models.PoolEntry{ID: poolEntryID, LeagueID: leagueID, Cost: func(i int) *int { return &i }(30), IsAvailable: true}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(localMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{poolEntryID}).Return([]models.PoolEntry{poolEntry}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.Anything).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, poolEntryID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(localMember, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+
+		err := service.ForcePick(leagueID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusPaused, localDraft.Status)
+		assert.Equal(t, otherMemberID, *localDraft.CurrentTurnMemberID)
+		assert.Equal(t, 70, localMember.DraftPoints)
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.schedulerService.AssertNotCalled(t, "RegisterTask", mock.Anything)
+	})
+
+	t.Run("Failure - Draft completed", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localLeague := &models.League{ID: leagueID, Status: enums.LeagueStatusPostDraft}
+		localDraft := &models.Draft{LeagueID: leagueID, Status: enums.DraftStatusCompleted, CurrentTurnMemberID: &memberID}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		err := service.ForcePick(leagueID, &requests.DraftMakePickRequestDTO{})
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+}
+
+func TestDraftService_UndoLastPick(t *testing.T) {
+	leagueID := uuid.New()
+	draftID := uuid.New()
+	memberID := uuid.New()
+	otherMemberID := uuid.New()
+
+	newLeague := func(status enums.LeagueStatus) *models.League {
+		return &models.League{ID: leagueID, Status: status}
+	}
+
+	t.Run("Success - Rewinds the clock to the undone pick", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{
+			ID:                          draftID,
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentRound:                2,
+			CurrentPickInRound:          1,
+			CurrentPickOnClock:          3,
+			CurrentTurnMemberID:         &otherMemberID,
+			TurnTimeLimit:               60,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		pick := &models.DraftPick{ID: uuid.New(), DraftID: draftID, PlayerID: memberID, PoolEntryID: uuid.New(), PickNumber: 2}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(enums.LeagueStatusDrafting), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftPickRepo.On("GetLatestByDraft", draftID).Return(pick, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("UndoPick", pick, localDraft, mock.AnythingOfType("*models.League")).Return(40, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		draft, err := service.UndoLastPick(leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, draft.CurrentPickOnClock)
+		assert.Equal(t, 1, draft.CurrentRound)
+		assert.Equal(t, 2, draft.CurrentPickInRound)
+		assert.Equal(t, memberID, *draft.CurrentTurnMemberID)
+		assert.Empty(t, draft.PlayersWithAccumulatedPicks[memberID])
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Accumulated pick is handed back", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{
+			ID:                          draftID,
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentPickOnClock:          6,
+			CurrentTurnMemberID:         &otherMemberID,
+			TurnTimeLimit:               60,
+			PlayersWithAccumulatedPicks: models.PlayerAccumulatedPicks{memberID: {3}},
+		}
+		pick := &models.DraftPick{ID: uuid.New(), DraftID: draftID, PlayerID: memberID, PoolEntryID: uuid.New(), PickNumber: 1}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(enums.LeagueStatusDrafting), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftPickRepo.On("GetLatestByDraft", draftID).Return(pick, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("UndoPick", pick, localDraft, mock.AnythingOfType("*models.League")).Return(40, nil).Once()
+
+		draft, err := service.UndoLastPick(leagueID)
+
+		assert.NoError(t, err)
+		mocks.schedulerService.AssertNotCalled(t, "DeregisterTask", mock.Anything)
+		assert.Equal(t, 6, draft.CurrentPickOnClock)
+		assert.Equal(t, otherMemberID, *draft.CurrentTurnMemberID)
+		assert.Equal(t, []int{1, 3}, draft.PlayersWithAccumulatedPicks[memberID])
+	})
+
+	t.Run("Success - Undoing the final pick reopens the draft", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localLeague := newLeague(enums.LeagueStatusPostDraft)
+		localDraft := &models.Draft{
+			ID:                          draftID,
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusCompleted,
+			CurrentPickOnClock:          5,
+			CurrentTurnMemberID:         &otherMemberID,
+			TurnTimeLimit:               60,
+			EndTime:                     time.Now(),
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		pick := &models.DraftPick{ID: uuid.New(), DraftID: draftID, PlayerID: memberID, PoolEntryID: uuid.New(), PickNumber: 4}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftPickRepo.On("GetLatestByDraft", draftID).Return(pick, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftPickRepo.On("UndoPick", pick, localDraft, localLeague).Return(10, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		draft, err := service.UndoLastPick(leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusOngoing, draft.Status)
+		assert.Equal(t, enums.LeagueStatusDrafting, localLeague.Status)
+		assert.True(t, draft.EndTime.IsZero())
+		assert.Equal(t, memberID, *draft.CurrentTurnMemberID)
+	})
+
+	t.Run("Failure - No picks to undo", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := &models.Draft{ID: draftID, LeagueID: leagueID, Status: enums.DraftStatusOngoing}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(enums.LeagueStatusDrafting), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftPickRepo.On("GetLatestByDraft", draftID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.UndoLastPick(leagueID)
+
+		assert.ErrorIs(t, err, types.ErrDraftPickNotFound)
+		mocks.draftPickRepo.AssertNotCalled(t, "UndoPick", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDraftService_Auction(t *testing.T) {
+	leagueID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+	otherUserID := uuid.New()
+	otherMemberID := uuid.New()
+	poolEntryID := uuid.New()
+	cost := 10
+
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MinPokemonPerPlayer: 3,
+			MaxPokemonPerPlayer: 6,
+			Format:              &types.LeagueFormat{DraftMode: enums.DraftModeAuction, AuctionBidTimeSeconds: 20},
+		}
+	}
+	newDraft := func() *models.Draft {
+		return &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentRound:                1,
+			CurrentPickInRound:          1,
+			CurrentPickOnClock:          1,
+			CurrentTurnMemberID:         &memberID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+	}
+	withOpenLot := func(draft *models.Draft, highBid int, highBidderID uuid.UUID) *models.Draft {
+		endsAt := time.Now().Add(10 * time.Second)
+		draft.AuctionPoolEntryID = &poolEntryID
+		draft.AuctionHighBid = highBid
+		draft.AuctionHighBidderID = &highBidderID
+		draft.AuctionLotEndsAt = &endsAt
+		return draft
+	}
+	poolEntry := &models.PoolEntry{ID: poolEntryID, LeagueID: leagueID, Cost: &cost, IsAvailable: true}
+
+	t.Run("Success - Nominate opens a lot", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localMember := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 100}
+		localDraft := newDraft()
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(localMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("OpenAuctionLot", localDraft.ID, memberID, poolEntryID, 5, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		mocks.schedulerService.On("DeregisterTask", fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, leagueID)).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			payload, ok := task.Payload.(utils.PayloadAuctionLotClose)
+			return task.Type == utils.TaskTypeAuctionLotClose && ok && payload.PoolEntryID == poolEntryID
+		})).Return().Once()
+
+		draft, err := service.NominateAuctionLot(&models.User{ID: userID}, leagueID,
+			&requests.DraftAuctionNominateRequestDTO{PoolEntryID: poolEntryID, OpeningBid: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, poolEntryID, *draft.AuctionPoolEntryID)
+		assert.Equal(t, 5, draft.AuctionHighBid)
+		assert.Equal(t, memberID, *draft.AuctionHighBidderID)
+		assert.WithinDuration(t, time.Now().Add(20*time.Second), *draft.AuctionLotEndsAt, time.Second)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Nominate when it isn't your turn", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 100}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(newDraft(), nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+
+		_, err := service.NominateAuctionLot(&models.User{ID: otherUserID}, leagueID,
+			&requests.DraftAuctionNominateRequestDTO{PoolEntryID: poolEntryID, OpeningBid: 5})
+
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		mocks.draftRepo.AssertNotCalled(t, "OpenAuctionLot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Bid raises the high bid and restarts the countdown", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 50}
+		localDraft := withOpenLot(newDraft(), 5, memberID)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("RaiseAuctionBid", localDraft.ID, poolEntryID, otherMemberID, 12, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil).Once()
+		mocks.schedulerService.On("DeregisterTask", fmt.Sprintf("%d_%s", utils.TaskTypeAuctionLotClose, leagueID)).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		draft, err := service.PlaceAuctionBid(&models.User{ID: otherUserID}, leagueID, &requests.DraftAuctionBidRequestDTO{Amount: 12})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, draft.AuctionHighBid)
+		assert.Equal(t, otherMemberID, *draft.AuctionHighBidderID)
+		assert.WithinDuration(t, time.Now().Add(20*time.Second), *draft.AuctionLotEndsAt, time.Second)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Bid not above the high bid", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 50}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(withOpenLot(newDraft(), 5, memberID), nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+
+		_, err := service.PlaceAuctionBid(&models.User{ID: otherUserID}, leagueID, &requests.DraftAuctionBidRequestDTO{Amount: 5})
+
+		assert.ErrorIs(t, err, types.ErrBidTooLow)
+	})
+
+	t.Run("Failure - Bid outbid on another instance since the draft was read", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 50}
+		localDraft := withOpenLot(newDraft(), 5, memberID)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("RaiseAuctionBid", localDraft.ID, poolEntryID, otherMemberID, 12, mock.Anything, mock.Anything).Return(types.ErrConflict).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(withOpenLot(newDraft(), 15, memberID), nil).Once()
+
+		_, err := service.PlaceAuctionBid(&models.User{ID: otherUserID}, leagueID, &requests.DraftAuctionBidRequestDTO{Amount: 12})
+
+		assert.ErrorIs(t, err, types.ErrBidTooLow)
+		mocks.schedulerService.AssertNotCalled(t, "RegisterTask", mock.Anything)
+	})
+
+	t.Run("Failure - Bid leaves too few points to reach the roster minimum", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		// needs 2 more pokemon after this one, so at most 48 of 50 points can go on this lot
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 50}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(withOpenLot(newDraft(), 5, memberID), nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(0), nil).Once()
+
+		_, err := service.PlaceAuctionBid(&models.User{ID: otherUserID}, leagueID, &requests.DraftAuctionBidRequestDTO{Amount: 49})
+
+		assert.ErrorIs(t, err, types.ErrInsufficientDraftPoints)
+		mocks.draftRepo.AssertNotCalled(t, "RaiseAuctionBid", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure - Bid over a tier's cap", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		otherMember := &models.LeagueMember{ID: otherMemberID, UserID: otherUserID, LeagueID: leagueID, DraftPoints: 50}
+		maxS, tierS := 1, "S"
+		tieredLeague := newLeague()
+		tieredLeague.Format.Tiers = []types.PoolTier{{Name: tierS, MaxPerRoster: &maxS}}
+		tieredEntry := &models.PoolEntry{ID: poolEntryID, LeagueID: leagueID, PokemonSpeciesID: 2, Cost: &cost, Tier: &tierS, IsAvailable: true}
+		heldS := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 1, Cost: &cost, Tier: &tierS}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(tieredLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(withOpenLot(newDraft(), 5, memberID), nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUserID, leagueID).Return(otherMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(tieredEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(1), nil).Once()
+		mocks.claimRepo.On("GetActiveByPlayer", otherMemberID).
+			Return([]models.Claim{{PlayerID: otherMemberID, LeagueID: leagueID, SpeciesID: heldS.PokemonSpeciesID, IsActive: true}}, nil).Once()
+		mocks.poolEntryRepo.On("GetByLeague", leagueID).Return([]models.PoolEntry{heldS, *tieredEntry}, nil).Once()
+
+		_, err := service.PlaceAuctionBid(&models.User{ID: otherUserID}, leagueID, &requests.DraftAuctionBidRequestDTO{Amount: 12})
+
+		assert.ErrorIs(t, err, types.ErrTierLimitExceeded)
+		mocks.draftRepo.AssertNotCalled(t, "RaiseAuctionBid", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Closing a lot drafts it at the winning bid", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		winner := &models.LeagueMember{ID: otherMemberID, LeagueID: leagueID, DraftPoints: 50}
+		allMembers := []models.LeagueMember{
+			{ID: memberID, LeagueID: leagueID, DraftPoints: 100},
+			{ID: otherMemberID, LeagueID: leagueID, DraftPoints: 38},
+		}
+		localDraft := withOpenLot(newDraft(), 12, otherMemberID)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftRepo.On("TakeAuctionLotForClose", localDraft.ID, poolEntryID, otherMemberID, 12, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", otherMemberID).Return(winner, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(0), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].PlayerID == otherMemberID && picks[0].PickNumber == 1
+		})).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, poolEntryID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", winner).Return(winner, nil).Once()
+		mocks.claimRepo.On("Create", mock.MatchedBy(func(claim *models.Claim) bool {
+			return claim.PlayerID == otherMemberID && claim.CostPaid == 12 && claim.Source == enums.ClaimSourceDraft
+		})).Return(&models.Claim{}, nil).Once()
+		// the nominator (memberID) still has room, so nomination passes to the next member in order
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.poolEntryRepo.On("GetAvailableByLeague", leagueID).Return([]models.PoolEntry{{ID: uuid.New(), LeagueID: leagueID, IsAvailable: true}}, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(1), nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(0), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			return task.Type == utils.TaskTypeDraftTurnTimeout
+		})).Return().Once()
+
+		err := service.CloseAuctionLot(leagueID, poolEntryID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 38, winner.DraftPoints)
+		assert.Nil(t, localDraft.AuctionPoolEntryID)
+		assert.Nil(t, localDraft.AuctionHighBidderID)
+		assert.Equal(t, 2, localDraft.CurrentPickOnClock)
+		assert.Equal(t, otherMemberID, *localDraft.CurrentTurnMemberID)
+		mocks.draftPickRepo.AssertExpectations(t)
+		mocks.claimRepo.AssertExpectations(t)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Closing the last lot anyone can afford completes the draft", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		winner := &models.LeagueMember{ID: otherMemberID, LeagueID: leagueID, DraftPoints: 50}
+		allMembers := []models.LeagueMember{
+			{ID: memberID, LeagueID: leagueID, DraftPoints: 100},
+			{ID: otherMemberID, LeagueID: leagueID, DraftPoints: 38},
+		}
+		localDraft := withOpenLot(newDraft(), 12, otherMemberID)
+		localLeague := newLeague()
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftRepo.On("TakeAuctionLotForClose", localDraft.ID, poolEntryID, otherMemberID, 12, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", otherMemberID).Return(winner, nil).Once()
+		mocks.poolEntryRepo.On("GetByID", poolEntryID).Return(poolEntry, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(0), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.Anything).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, poolEntryID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", winner).Return(winner, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		// both members still have points and room, but the pool is empty
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.poolEntryRepo.On("GetAvailableByLeague", leagueID).Return([]models.PoolEntry{}, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", localLeague).Return(localLeague, nil).Once()
+
+		err := service.CloseAuctionLot(leagueID, poolEntryID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusCompleted, localDraft.Status)
+		assert.Equal(t, enums.LeagueStatusPostDraft, localLeague.Status)
+		mocks.schedulerService.AssertNotCalled(t, "RegisterTask", mock.Anything)
+	})
+
+	t.Run("Success - A full lap of passed nominations pauses the draft", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localMember := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 100}
+		allMembers := []models.LeagueMember{
+			*localMember,
+			{ID: otherMemberID, LeagueID: leagueID, DraftPoints: 38},
+		}
+		localDraft := newDraft()
+		localDraft.AuctionPasses = 1 // otherMemberID passed to memberID
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Twice()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(localMember, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, leagueID)).Return().Once()
+		mocks.draftRepo.On("TakeAuctionNominationForPass", localDraft.ID, memberID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(allMembers, nil).Once()
+		mocks.poolEntryRepo.On("GetAvailableByLeague", leagueID).Return([]models.PoolEntry{*poolEntry}, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", otherMemberID).Return(int64(1), nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", memberID).Return(int64(1), nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+
+		err := service.SkipTurn(&models.User{ID: userID}, leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftStatusPaused, localDraft.Status)
+		assert.Equal(t, 2, localDraft.AuctionPasses)
+		assert.Equal(t, otherMemberID, *localDraft.CurrentTurnMemberID)
+		mocks.schedulerService.AssertNotCalled(t, "RegisterTask", mock.Anything)
+	})
+
+	t.Run("Success - Stale lot close is ignored", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(newDraft(), nil).Once()
+
+		err := service.CloseAuctionLot(leagueID, poolEntryID)
+
+		assert.NoError(t, err)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+		mocks.draftRepo.AssertNotCalled(t, "UpdateDraft", mock.Anything)
+	})
+
+	t.Run("Success - Lot close is ignored once a late bid kept the lot open", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := withOpenLot(newDraft(), 12, otherMemberID)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.draftRepo.On("TakeAuctionLotForClose", localDraft.ID, poolEntryID, otherMemberID, 12, mock.Anything).Return(types.ErrConflict).Once()
+
+		err := service.CloseAuctionLot(leagueID, poolEntryID)
+
+		assert.NoError(t, err)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+		mocks.draftRepo.AssertNotCalled(t, "UpdateDraft", mock.Anything)
+	})
+
+	t.Run("Failure - MakePick is not allowed in an auction draft", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localMember := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 100}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(newDraft(), nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(localMember, nil).Once()
+
+		err := service.MakePick(&models.User{ID: userID}, leagueID, &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 1}},
+		})
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+		mocks.poolEntryRepo.AssertNotCalled(t, "GetByIDs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDraftService_TradedSlots(t *testing.T) {
+	leagueID := uuid.New()
+	userA, userB := uuid.New(), uuid.New()
+	memberAID, memberBID := uuid.New(), uuid.New()
+	league := &models.League{
+		ID:                  leagueID,
+		Status:              enums.LeagueStatusDrafting,
+		MinPokemonPerPlayer: 1,
+		MaxPokemonPerPlayer: 6,
+		Format:              &types.LeagueFormat{IsSnakeRoundDraft: true},
+	}
+	newMembers := func() []models.LeagueMember {
+		return []models.LeagueMember{
+			{ID: memberAID, UserID: userA, LeagueID: leagueID, DraftPosition: 1, DraftPoints: 100, SkipsLeft: 5},
+			{ID: memberBID, UserID: userB, LeagueID: leagueID, DraftPosition: 2, DraftPoints: 100, SkipsLeft: 5},
+		}
+	}
+	setup := func(poolEntryID uuid.UUID, member *models.LeagueMember, members []models.LeagueMember, draft *models.Draft) (services.DraftService, draftServiceMocks, *mock_repositories.MockDraftTradeRepository) {
+		service, mocks := setupDraftServiceTest()
+		draftTradeRepo := new(mock_repositories.MockDraftTradeRepository)
+		service.SetDraftTradeRepository(draftTradeRepo)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(draft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", member.UserID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{poolEntryID}).
+			Return([]models.PoolEntry{{ID: poolEntryID, LeagueID: leagueID, PokemonSpeciesID: 1, Cost: func(i int) *int { return &i }(10), IsAvailable: true}}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, poolEntryID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(&models.LeagueMember{}, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(members, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", mock.AnythingOfType("string")).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+		return service, mocks, draftTradeRepo
+	}
+
+	t.Run("Success - Traded slot puts its new owner on the clock", func(t *testing.T) {
+		members := newMembers()
+		poolEntryID := uuid.New()
+		// B is on the clock at pick 2; in snake order B also holds pick 3 (round 2), but traded it to A
+		draft := &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentPickOnClock:          2,
+			CurrentRound:                1,
+			CurrentPickInRound:          2,
+			CurrentTurnMemberID:         &memberBID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		service, mocks, draftTradeRepo := setup(poolEntryID, &members[1], members, draft)
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].OriginalOwnerID == nil
+		})).Return(nil).Once()
+		draftTradeRepo.On("GetSlotsByLeague", leagueID).
+			Return([]models.DraftPickSlot{{LeagueID: leagueID, OriginalOwnerID: memberBID, RoundNumber: 2, OwnerID: memberAID}}, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.MatchedBy(func(d *models.Draft) bool {
+			return d.CurrentPickOnClock == 3 && d.CurrentTurnMemberID != nil && *d.CurrentTurnMemberID == memberAID
+		})).Return(draft, nil).Once()
+
+		err := service.MakePick(&models.User{ID: userB}, leagueID, &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 2}},
+		})
+
+		assert.NoError(t, err)
+		mocks.draftRepo.AssertExpectations(t)
+		mocks.draftPickRepo.AssertExpectations(t)
+		draftTradeRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - Pick made with a traded slot records its original owner", func(t *testing.T) {
+		members := newMembers()
+		poolEntryID := uuid.New()
+		draft := &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentPickOnClock:          3,
+			CurrentRound:                2,
+			CurrentPickInRound:          1,
+			CurrentTurnMemberID:         &memberAID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		service, mocks, draftTradeRepo := setup(poolEntryID, &members[0], members, draft)
+		mocks.draftPickRepo.On("CreateBatch", mock.MatchedBy(func(picks []models.DraftPick) bool {
+			return len(picks) == 1 && picks[0].OriginalOwnerID != nil && *picks[0].OriginalOwnerID == memberBID
+		})).Return(nil).Once()
+		draftTradeRepo.On("GetSlotsByLeague", leagueID).Return([]models.DraftPickSlot{}, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(draft, nil).Once()
+
+		err := service.MakePick(&models.User{ID: userA}, leagueID, &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 3}},
+		})
+
+		assert.NoError(t, err)
+		mocks.draftPickRepo.AssertExpectations(t)
+		draftTradeRepo.AssertExpectations(t)
+	})
+}
+
+func TestDraftService_GetPickSchedule(t *testing.T) {
+	leagueID := uuid.New()
+	memberAID, memberBID, memberCID := uuid.New(), uuid.New(), uuid.New()
+	league := &models.League{
+		ID:                  leagueID,
+		Status:              enums.LeagueStatusDrafting,
+		MinPokemonPerPlayer: 1,
+		MaxPokemonPerPlayer: 2,
+		Format:              &types.LeagueFormat{IsSnakeRoundDraft: true},
+	}
+	members := []models.LeagueMember{
+		{ID: memberAID, LeagueID: leagueID, DraftPosition: 1},
+		{ID: memberBID, LeagueID: leagueID, DraftPosition: 2},
+		{ID: memberCID, LeagueID: leagueID, DraftPosition: 3},
+	}
+	turnStart := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newDraft := func(status enums.DraftStatus) *models.Draft {
+		// A skipped pick 1 and still holds it; C is on the clock at pick 3
+		return &models.Draft{
+			ID:                          uuid.New(),
+			LeagueID:                    leagueID,
+			Status:                      status,
+			CurrentPickOnClock:          3,
+			CurrentRound:                1,
+			CurrentPickInRound:          3,
+			CurrentTurnMemberID:         &memberCID,
+			CurrentTurnStartTime:        &turnStart,
+			TurnTimeLimit:               60,
+			PlayersWithAccumulatedPicks: models.PlayerAccumulatedPicks{memberAID: {1}},
+		}
+	}
+	setup := func(draft *models.Draft) (services.DraftService, *mock_repositories.MockDraftTradeRepository) {
+		service, mocks := setupDraftServiceTest()
+		draftTradeRepo := new(mock_repositories.MockDraftTradeRepository)
+		service.SetDraftTradeRepository(draftTradeRepo)
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(draft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(members, nil).Once()
+		// C traded its round 2 slot (pick 4 in snake order) to A
+		draftTradeRepo.On("GetSlotsByLeague", leagueID).
+			Return([]models.DraftPickSlot{{LeagueID: leagueID, OriginalOwnerID: memberCID, RoundNumber: 2, OwnerID: memberAID}}, nil).Once()
+		return service, draftTradeRepo
+	}
+
+	t.Run("Success - Projects snake order, traded slots and clock times", func(t *testing.T) {
+		service, _ := setup(newDraft(enums.DraftStatusOngoing))
+
+		schedule, err := service.GetPickSchedule(leagueID)
+
+		assert.NoError(t, err)
+		assert.Len(t, schedule.Picks, 6)
+		expected := []struct {
+			memberID, originalOwnerID uuid.UUID
+			round                     int
+			status                    enums.DraftScheduleSlotStatus
+			clockOffset               time.Duration // -1: no estimate
+		}{
+			{memberAID, memberAID, 1, enums.DraftScheduleSlotAccumulated, -1},
+			{memberBID, memberBID, 1, enums.DraftScheduleSlotDone, -1},
+			{memberCID, memberCID, 1, enums.DraftScheduleSlotOnClock, 0},
+			{memberAID, memberCID, 2, enums.DraftScheduleSlotUpcoming, time.Hour},
+			{memberBID, memberBID, 2, enums.DraftScheduleSlotUpcoming, 2 * time.Hour},
+			{memberAID, memberAID, 2, enums.DraftScheduleSlotUpcoming, 3 * time.Hour},
+		}
+		for i, want := range expected {
+			got := schedule.Picks[i]
+			assert.Equal(t, i+1, got.PickNumber)
+			assert.Equal(t, want.round, got.Round, "pick %d", i+1)
+			assert.Equal(t, want.memberID, got.MemberID, "pick %d", i+1)
+			assert.Equal(t, want.originalOwnerID, got.OriginalOwnerID, "pick %d", i+1)
+			assert.Equal(t, want.status, got.Status, "pick %d", i+1)
+			if want.clockOffset < 0 {
+				assert.Nil(t, got.EstimatedClockStart, "pick %d", i+1)
+			} else {
+				assert.Equal(t, turnStart.Add(want.clockOffset), *got.EstimatedClockStart, "pick %d", i+1)
+			}
+		}
+	})
+
+	t.Run("Success - Clock estimates follow round time limits and freeze through quiet hours", func(t *testing.T) {
+		draft := newDraft(enums.DraftStatusOngoing)
+		// 09:00-10:00 in New York is 14:00-15:00 UTC in January; round 2 turns get two hours
+		draft.Clock = &types.DraftClock{
+			Timezone:            "America/New_York",
+			QuietHoursStart:     "09:00",
+			QuietHoursEnd:       "10:00",
+			RoundTurnTimeLimits: []types.RoundTurnTimeLimit{{FromRound: 2, TurnTimeLimit: 120}},
+		}
+		service, _ := setup(draft)
+
+		schedule, err := service.GetPickSchedule(leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 60, schedule.Picks[2].TurnTimeLimit)
+		assert.Equal(t, 120, schedule.Picks[3].TurnTimeLimit)
+		assert.Equal(t, turnStart, *schedule.Picks[2].EstimatedClockStart)
+		assert.Equal(t, turnStart.Add(time.Hour), *schedule.Picks[3].EstimatedClockStart)
+		// pick 4 runs an hour, freezes for the quiet hour, then runs its second hour
+		assert.Equal(t, turnStart.Add(4*time.Hour), *schedule.Picks[4].EstimatedClockStart)
+		assert.Equal(t, turnStart.Add(6*time.Hour), *schedule.Picks[5].EstimatedClockStart)
+	})
+
+	t.Run("Success - Paused draft has no clock estimates", func(t *testing.T) {
+		service, _ := setup(newDraft(enums.DraftStatusPaused))
+
+		schedule, err := service.GetPickSchedule(leagueID)
+
+		assert.NoError(t, err)
+		for _, slot := range schedule.Picks {
+			assert.Nil(t, slot.EstimatedClockStart)
+		}
+		assert.Equal(t, enums.DraftScheduleSlotOnClock, schedule.Picks[2].Status)
+	})
+
+	t.Run("Failure - Draft not started", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.GetPickSchedule(leagueID)
+
+		assert.ErrorIs(t, err, types.ErrDraftNotFound)
+	})
+}
+
+func TestDraftService_DraftOrder(t *testing.T) {
+	leagueID := uuid.New()
+	memberIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	members := []models.LeagueMember{
+		{ID: memberIDs[0], LeagueID: leagueID, DraftPosition: 1, Wins: 5, Losses: 1},
+		{ID: memberIDs[1], LeagueID: leagueID, DraftPosition: 2, Wins: 1, Losses: 5},
+		{ID: memberIDs[2], LeagueID: leagueID, DraftPosition: 3, Wins: 3, Losses: 3},
+	}
+	newLeague := func(format *types.LeagueFormat) *models.League {
+		return &models.League{ID: leagueID, Status: enums.LeagueStatusDrafting, MinPokemonPerPlayer: 1, MaxPokemonPerPlayer: 4, Format: format}
+	}
+	// scheduledPositions runs the league through GetPickSchedule and returns the draft position holding each pick
+	scheduledPositions := func(t *testing.T, league *models.League) []int {
+		service, mocks := setupDraftServiceTest()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(&models.Draft{
+			LeagueID: leagueID, Status: enums.DraftStatusOngoing, CurrentPickOnClock: 1, PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(members, nil).Once()
+
+		schedule, err := service.GetPickSchedule(leagueID)
+		assert.NoError(t, err)
+		positions := make([]int, 0, len(schedule.Picks))
+		for i, slot := range schedule.Picks {
+			// round bookkeeping is the same whatever the order
+			assert.Equal(t, i/3+1, slot.Round)
+			assert.Equal(t, i%3+1, slot.PickInRound)
+			positions = append(positions, slices.Index(memberIDs, slot.MemberID)+1)
+		}
+		return positions
+	}
+
+	t.Run("Success - Round orders", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			format   *types.LeagueFormat
+			expected []int
+		}{
+			{"linear", &types.LeagueFormat{}, []int{1, 2, 3, 1, 2, 3, 1, 2, 3, 1, 2, 3}},
+			{"legacy snake flag", &types.LeagueFormat{IsSnakeRoundDraft: true}, []int{1, 2, 3, 3, 2, 1, 1, 2, 3, 3, 2, 1}},
+			{"third round reversal", &types.LeagueFormat{DraftRoundOrder: enums.DraftRoundOrderThirdRoundReversal}, []int{1, 2, 3, 3, 2, 1, 3, 2, 1, 1, 2, 3}},
+			{"custom", &types.LeagueFormat{
+				DraftRoundOrder:  enums.DraftRoundOrderCustom,
+				CustomDraftOrder: [][]int{{2, 1, 3}, {3, 1, 2}, {1, 2, 3}, {1, 3, 2}},
+			}, []int{2, 1, 3, 3, 1, 2, 1, 2, 3, 1, 3, 2}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, scheduledPositions(t, newLeague(tt.format)))
+			})
+		}
+	})
+
+	t.Run("Success - Reverse standings puts the worst record first", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := newLeague(&types.LeagueFormat{DraftOrderType: enums.DraftOrderTypeReverseStandings})
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(slices.Clone(members), nil).Once()
+		mocks.leagueMemberRepo.On("UpdateDraftPosition", memberIDs[1], 1).Return(nil).Once()
+		mocks.leagueMemberRepo.On("UpdateDraftPosition", memberIDs[2], 2).Return(nil).Once()
+		mocks.leagueMemberRepo.On("UpdateDraftPosition", memberIDs[0], 3).Return(nil).Once()
+		mocks.draftRepo.On("CreateDraft", mock.AnythingOfType("*models.Draft")).Return(nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", league).Return(league, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		draft, err := service.StartDraft(leagueID, 60)
+
+		assert.NoError(t, err)
+		assert.Equal(t, memberIDs[1], *draft.CurrentTurnMemberID)
+		mocks.leagueMemberRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - Staff set a custom order", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := newLeague(&types.LeagueFormat{IsSnakeRoundDraft: true})
+		rounds := [][]int{{2, 1, 3}, {3, 1, 2}, {1, 2, 3}, {1, 3, 2}}
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(3), nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", mock.MatchedBy(func(l *models.League) bool {
+			return l.Format.RoundOrder() == enums.DraftRoundOrderCustom && len(l.Format.CustomDraftOrder) == 4
+		})).Return(league, nil).Once()
+
+		_, err := service.SetCustomDraftOrder(leagueID, &requests.DraftCustomOrderRequestDTO{Rounds: rounds})
+
+		assert.NoError(t, err)
+		mocks.leagueRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Custom order repeats a position", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(&types.LeagueFormat{}), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(3), nil).Once()
+
+		_, err := service.SetCustomDraftOrder(leagueID, &requests.DraftCustomOrderRequestDTO{
+			Rounds: [][]int{{1, 2, 3}, {3, 3, 1}, {1, 2, 3}, {1, 2, 3}},
+		})
+
+		assert.ErrorIs(t, err, types.ErrDuplicateDraftPosition)
+		mocks.leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+	})
+
+	t.Run("Failure - Custom order after the draft started", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(&types.LeagueFormat{}), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(&models.Draft{Status: enums.DraftStatusOngoing}, nil).Once()
+
+		_, err := service.SetCustomDraftOrder(leagueID, &requests.DraftCustomOrderRequestDTO{Rounds: [][]int{{1, 2, 3}}})
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+}
+
+func TestDraftService_WeightedLottery(t *testing.T) {
+	leagueID, previousLeagueID := uuid.New(), uuid.New()
+	champUser, lastUser, newUser := uuid.New(), uuid.New(), uuid.New()
+	members := []models.LeagueMember{
+		{ID: uuid.New(), UserID: champUser, LeagueID: leagueID, DraftPosition: 1},
+		{ID: uuid.New(), UserID: lastUser, LeagueID: leagueID, DraftPosition: 2},
+		{ID: uuid.New(), UserID: newUser, LeagueID: leagueID, DraftPosition: 3},
+	}
+	previousMembers := []models.LeagueMember{
+		{ID: uuid.New(), UserID: lastUser, LeagueID: previousLeagueID, Wins: 0, Losses: 6},
+		{ID: uuid.New(), UserID: uuid.New(), LeagueID: previousLeagueID, Wins: 3, Losses: 3}, // didn't return
+		{ID: uuid.New(), UserID: champUser, LeagueID: previousLeagueID, Wins: 6, Losses: 0},
+	}
+
+	t.Run("Success - Weights come from the previous season and the draw is recorded", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := &models.League{
+			ID:                     leagueID,
+			MaxPokemonPerPlayer:    4,
+			Format:                 &types.LeagueFormat{DraftOrderType: enums.DraftOrderTypeWeightedLottery},
+			PreviousSeasonLeagueID: &previousLeagueID,
+		}
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(slices.Clone(members), nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", previousLeagueID).Return(slices.Clone(previousMembers), nil).Once()
+		savedPositions := make(map[uuid.UUID]int)
+		mocks.leagueMemberRepo.On("UpdateDraftPosition", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("int")).
+			Run(func(args mock.Arguments) { savedPositions[args.Get(0).(uuid.UUID)] = args.Int(1) }).Return(nil).Times(3)
+		mocks.draftRepo.On("CreateDraft", mock.AnythingOfType("*models.Draft")).Return(nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", league).Return(league, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+
+		draft, err := service.StartDraft(leagueID, 60)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, draft.Lottery) {
+			weights := make(map[uuid.UUID]int)
+			for _, e := range draft.Lottery.Entries {
+				weights[e.MemberID] = e.Weight
+			}
+			assert.Equal(t, 1, weights[members[0].ID]) // champion
+			assert.Equal(t, 3, weights[members[1].ID]) // last place
+			assert.Equal(t, 2, weights[members[2].ID]) // newcomer: middle weight
+			// the stored seed reproduces the stored order, which is the order positions were saved in
+			assert.Equal(t, draft.Lottery.DrawnOrder, draft.Lottery.Draw())
+			for i, memberID := range draft.Lottery.DrawnOrder {
+				assert.Equal(t, i+1, savedPositions[memberID])
+			}
+			assert.Equal(t, draft.Lottery.DrawnOrder[0], *draft.CurrentTurnMemberID)
+		}
+		mocks.leagueMemberRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - Heavier weights are drawn first more often", func(t *testing.T) {
+		heavy, light := uuid.New(), uuid.New()
+		heavyFirst := 0
+		for seed := int64(1); seed <= 2000; seed++ {
+			lottery := models.DraftLottery{Seed: seed, Entries: []models.DraftLotteryEntry{{MemberID: light, Weight: 1}, {MemberID: heavy, Weight: 4}}}
+			if lottery.Draw()[0] == heavy {
+				heavyFirst++
+			}
+		}
+		// expected 80%
+		assert.InDelta(t, 1600, heavyFirst, 100)
+	})
+}
+
+func TestDraftService_TierLimits(t *testing.T) {
+	leagueID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+	intPtr := func(i int) *int { return &i }
+	tierPtr := func(s string) *string { return &s }
+
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 3,
+			Format: &types.LeagueFormat{
+				IsSnakeRoundDraft: true,
+				Tiers: []types.PoolTier{
+					{Name: "S", MaxPerRoster: intPtr(1)},
+					{Name: "A"},
+					{Name: "C", MinPerRoster: intPtr(1)},
+				},
+			},
+		}
+	}
+	// the member already holds one S-tier and one A-tier pokemon
+	heldS := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 1, Cost: intPtr(10), Tier: tierPtr("S")}
+	heldA := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 2, Cost: intPtr(5), Tier: tierPtr("A")}
+	roster := []models.Claim{
+		{PlayerID: memberID, LeagueID: leagueID, SpeciesID: heldS.PokemonSpeciesID, IsActive: true},
+		{PlayerID: memberID, LeagueID: leagueID, SpeciesID: heldA.PokemonSpeciesID, IsActive: true},
+	}
+
+	expectPickUpToTierCheck := func(mocks draftServiceMocks, requested models.PoolEntry) {
+		member := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 100, SkipsLeft: 1}
+		draft := &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentPickOnClock:          5,
+			CurrentTurnMemberID:         &memberID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(draft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{requested.ID}).Return([]models.PoolEntry{requested}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+		mocks.claimRepo.On("GetActiveByPlayer", memberID).Return(roster, nil).Once()
+		mocks.poolEntryRepo.On("GetByLeague", leagueID).Return([]models.PoolEntry{heldS, heldA, requested}, nil).Once()
+	}
+	pickInput := func(poolEntryID uuid.UUID) *requests.DraftMakePickRequestDTO {
+		return &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 5}},
+		}
+	}
+
+	t.Run("MakePick - Rejects a pick over a tier's cap", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		secondS := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 3, Cost: intPtr(10), Tier: tierPtr("S"), IsAvailable: true}
+		expectPickUpToTierCheck(mocks, secondS)
+
+		err := service.MakePick(&models.User{ID: userID}, leagueID, pickInput(secondS.ID))
+
+		assert.ErrorIs(t, err, types.ErrTierLimitExceeded)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+		mocks.claimRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+	})
+
+	t.Run("MakePick - Rejects a pick that leaves no room for a tier minimum", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		// the last roster spot has to go to a C-tier
+		secondA := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 3, Cost: intPtr(5), Tier: tierPtr("A"), IsAvailable: true}
+		expectPickUpToTierCheck(mocks, secondA)
+
+		err := service.MakePick(&models.User{ID: userID}, leagueID, pickInput(secondA.ID))
+
+		assert.ErrorIs(t, err, types.ErrTierLimitExceeded)
+		mocks.draftPickRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+	})
+
+	t.Run("MakePick - Untiered leagues skip the roster lookup", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := newLeague()
+		league.Format.Tiers = nil
+		member := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 1}
+		draft := &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentPickOnClock:          5,
+			CurrentTurnMemberID:         &memberID,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+		}
+		entry := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 3, Cost: intPtr(5), IsAvailable: true}
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(draft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(member, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{entry.ID}).Return([]models.PoolEntry{entry}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(2), nil).Once()
+
+		err := service.MakePick(&models.User{ID: userID}, leagueID, pickInput(entry.ID))
+
+		assert.ErrorIs(t, err, types.ErrInsufficientDraftPoints)
+		mocks.claimRepo.AssertNotCalled(t, "GetActiveByPlayer", mock.Anything)
+	})
+}
+
+func TestDraftService_DraftClock(t *testing.T) {
+	leagueID := uuid.New()
+	memberID := uuid.New()
+	members := []models.LeagueMember{{ID: memberID, LeagueID: leagueID, DraftPosition: 1}}
+	newLeague := func(format *types.LeagueFormat) *models.League {
+		format.DraftOrderType = enums.DraftOrderTypeManual
+		return &models.League{ID: leagueID, Status: enums.LeagueStatusSetup, MinPokemonPerPlayer: 1, MaxPokemonPerPlayer: 4, Format: format}
+	}
+
+	t.Run("StartDraft - Times the first turn on the league's clock", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := newLeague(&types.LeagueFormat{
+			Timezone:             "Europe/London",
+			DraftQuietHoursStart: "23:00",
+			DraftQuietHoursEnd:   "07:00",
+			RoundTurnTimeLimits:  []types.RoundTurnTimeLimit{{FromRound: 1, TurnTimeLimit: 240}, {FromRound: 3, TurnTimeLimit: 30}},
+		})
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(slices.Clone(members), nil).Once()
+		mocks.draftRepo.On("CreateDraft", mock.AnythingOfType("*models.Draft")).Return(nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", league).Return(league, nil).Once()
+		var task *utils.ScheduledTask
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).
+			Run(func(args mock.Arguments) { task = args.Get(0).(*utils.ScheduledTask) }).Return().Once()
+
+		draft, err := service.StartDraft(leagueID, 60)
+
+		assert.NoError(t, err)
+		assert.Equal(t, league.Format.DraftClock(), draft.Clock)
+		assert.Equal(t, 240, draft.TurnTimeLimitForRound(2))
+		assert.Equal(t, 30, draft.TurnTimeLimitForRound(5))
+		assert.Equal(t, *draft.CurrentTurnEndsAt(), task.ExecuteAt)
+		assert.False(t, task.ExecuteAt.Before(draft.CurrentTurnStartTime.Add(4*time.Hour)), "the first round's limit applies")
+	})
+
+	t.Run("StartDraft - Rejects an unknown time zone", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(&types.LeagueFormat{Timezone: "Mars/Olympus_Mons"}), nil).Once()
+
+		_, err := service.StartDraft(leagueID, 60)
+
+		assert.ErrorIs(t, err, types.ErrInvalidLeagueConfiguration)
+		mocks.draftRepo.AssertNotCalled(t, "CreateDraft", mock.Anything)
+	})
+
+	t.Run("Deadline - A turn started overnight only starts counting when quiet hours end", func(t *testing.T) {
+		clock := &types.DraftClock{Timezone: "Europe/London", QuietHoursStart: "23:00", QuietHoursEnd: "07:00"}
+		start := time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC) // London is on UTC in January
+
+		assert.Equal(t, time.Date(2025, 1, 2, 8, 0, 0, 0, time.UTC), clock.Deadline(start, time.Hour))
+		// a 10 hour clock started at 20:00 runs 3 hours, sleeps 8, and runs out at 14:00
+		assert.Equal(t, time.Date(2025, 1, 2, 14, 0, 0, 0, time.UTC), clock.Deadline(time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC), 10*time.Hour))
+	})
+}
+
+func TestDraftService_TurnReminders(t *testing.T) {
+	leagueID := uuid.New()
+	userID := uuid.New()
+	memberID := uuid.New()
+	poolEntryID := uuid.New()
+	webhookURL := "https://discord.test/webhook"
+	teamName := "Team Rocket"
+	timeoutTaskID := fmt.Sprintf("%d_%s", utils.TaskTypeDraftTurnTimeout, leagueID)
+	reminderTaskID := func(percent int) string {
+		return fmt.Sprintf("%d_%s_%d", utils.TaskTypeDraftTurnReminder, leagueID, percent)
+	}
+	newDraft := func() *models.Draft {
+		turnStart := time.Now()
+		return &models.Draft{
+			LeagueID:                    leagueID,
+			Status:                      enums.DraftStatusOngoing,
+			CurrentRound:                1,
+			CurrentPickInRound:          1,
+			CurrentPickOnClock:          1,
+			CurrentTurnMemberID:         &memberID,
+			CurrentTurnStartTime:        &turnStart,
+			TurnTimeLimit:               60,
+			PlayersWithAccumulatedPicks: make(models.PlayerAccumulatedPicks),
+			Clock:                       &types.DraftClock{TurnReminderPercents: []int{50, 90}},
+		}
+	}
+	isReminder := func(percent int, dueIn time.Duration) any {
+		return mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			payload, ok := task.Payload.(utils.PayloadDraftTurnReminder)
+			return ok && task.ID == reminderTaskID(percent) && payload.Percent == percent && payload.PlayerID == memberID &&
+				task.ExecuteAt.Sub(time.Now().Add(dueIn)).Abs() < time.Minute
+		})
+	}
+
+	t.Run("Success - A pick cancels the turn's reminders and schedules the next turn's", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localLeague := &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 12,
+			Format:              &types.LeagueFormat{IsSnakeRoundDraft: true, TurnReminderPercents: []int{50, 90}},
+		}
+		localMember := &models.LeagueMember{ID: memberID, UserID: userID, LeagueID: leagueID, DraftPoints: 100}
+		localDraft := newDraft()
+		localPoolEntry := models.PoolEntry{ID: poolEntryID, LeagueID: leagueID, Cost: func(i int) *int { return &i }(10), IsAvailable: true}
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", userID, leagueID).Return(localMember, nil).Once()
+		mocks.poolEntryRepo.On("GetByIDs", leagueID, []uuid.UUID{poolEntryID}).Return([]models.PoolEntry{localPoolEntry}, nil).Once()
+		mocks.leagueMemberRepo.On("GetCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.draftPickRepo.On("CreateBatch", mock.Anything).Return(nil).Once()
+		mocks.poolEntryRepo.On("MarkUnavailable", mock.Anything, poolEntryID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("Update", mock.AnythingOfType("*models.LeagueMember")).Return(&models.LeagueMember{}, nil).Once()
+		mocks.claimRepo.On("Create", mock.AnythingOfType("*models.Claim")).Return(&models.Claim{}, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByLeague", leagueID).Return(int64(1), nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return([]models.LeagueMember{*localMember}, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", timeoutTaskID).Return().Once()
+		mocks.schedulerService.On("DeregisterTask", reminderTaskID(50)).Return().Once()
+		mocks.schedulerService.On("DeregisterTask", reminderTaskID(90)).Return().Once()
+		mocks.schedulerService.On("RegisterTask", mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			return task.ID == timeoutTaskID
+		})).Return().Once()
+		mocks.schedulerService.On("RegisterTask", isReminder(50, 30*time.Minute)).Return().Once()
+		mocks.schedulerService.On("RegisterTask", isReminder(90, 54*time.Minute)).Return().Once()
+
+		err := service.MakePick(&models.User{ID: userID}, leagueID, &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: poolEntryID, DraftPickNumber: 1}},
+		})
+
+		assert.NoError(t, err)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Pausing cancels the turn's reminders", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := newDraft()
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+		mocks.draftRepo.On("UpdateDraft", mock.AnythingOfType("*models.Draft")).Return(localDraft, nil).Once()
+		mocks.schedulerService.On("DeregisterTask", timeoutTaskID).Return().Once()
+		mocks.schedulerService.On("DeregisterTask", reminderTaskID(50)).Return().Once()
+		mocks.schedulerService.On("DeregisterTask", reminderTaskID(90)).Return().Once()
+
+		_, err := service.PauseDraft(leagueID)
+
+		assert.NoError(t, err)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Reminder is posted to the league's webhook", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localLeague := &models.League{ID: leagueID, DiscordWebhookURL: &webhookURL}
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(newDraft(), nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(localLeague, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", memberID).Return(&models.LeagueMember{ID: memberID, TeamName: &teamName}, nil).Once()
+		mocks.webhookService.On("SendWebhookMessage", webhookURL, mock.MatchedBy(func(message string) bool {
+			return strings.Contains(message, teamName) && strings.Contains(message, "50%")
+		})).Return(nil).Once()
+
+		err := service.SendTurnReminder(leagueID, memberID, 1, 50)
+
+		assert.NoError(t, err)
+		mocks.webhookService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Reminder for a turn that has ended is ignored", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		localDraft := newDraft()
+		localDraft.CurrentPickOnClock = 2
+
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(localDraft, nil).Once()
+
+		err := service.SendTurnReminder(leagueID, memberID, 1, 90)
+
+		assert.NoError(t, err)
+		mocks.leagueRepo.AssertNotCalled(t, "GetLeagueByID", mock.Anything)
+		mocks.webhookService.AssertNotCalled(t, "SendWebhookMessage", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDraftService_ScheduledDraftStart(t *testing.T) {
+	leagueID := uuid.New()
+	memberID := uuid.New()
+	webhookURL := "https://discord.test/webhook"
+	teamName := "Team Rocket"
+	startTaskID := fmt.Sprintf("%d_%s", utils.TaskTypeDraftStart, leagueID)
+	newLeague := func(scheduledFor *time.Time) *models.League {
+		return &models.League{
+			ID:                          leagueID,
+			Status:                      enums.LeagueStatusSetup,
+			MinPokemonPerPlayer:         1,
+			MaxPokemonPerPlayer:         4,
+			Format:                      &types.LeagueFormat{DraftOrderType: enums.DraftOrderTypeManual},
+			DiscordWebhookURL:           &webhookURL,
+			ScheduledDraftStart:         scheduledFor,
+			ScheduledDraftTurnTimeLimit: 45,
+		}
+	}
+
+	t.Run("Success - Schedule registers the start task", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		startAt := time.Now().Add(24 * time.Hour)
+		league := newLeague(nil)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mocks.leagueRepo.On("UpdateLeague", league).Return(league, nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.MatchedBy(func(task *utils.ScheduledTask) bool {
+			payload, ok := task.Payload.(utils.PayloadDraftStart)
+			return ok && task.ID == startTaskID && task.ExecuteAt.Equal(startAt) && payload.ScheduledFor.Equal(startAt)
+		})).Return().Once()
+
+		league, err := service.ScheduleDraftStart(leagueID, &requests.DraftScheduleStartRequestDTO{StartAt: startAt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, startAt, *league.ScheduledDraftStart)
+		assert.Equal(t, 120, league.ScheduledDraftTurnTimeLimit)
+		mocks.schedulerService.AssertExpectations(t)
+		mocks.schedulerService.AssertNotCalled(t, "DeregisterTask", mock.Anything)
+	})
+
+	t.Run("Failure - Schedule a start in the past", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(nil), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.ScheduleDraftStart(leagueID, &requests.DraftScheduleStartRequestDTO{StartAt: time.Now().Add(-time.Minute)})
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+		mocks.leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+	})
+
+	t.Run("Failure - Schedule after the draft has started", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		league := newLeague(nil)
+		league.Status = enums.LeagueStatusDrafting
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+
+		_, err := service.ScheduleDraftStart(leagueID, &requests.DraftScheduleStartRequestDTO{StartAt: time.Now().Add(time.Hour)})
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+
+	t.Run("Success - Run starts the draft and announces it", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		scheduledFor := time.Now().Add(-time.Second)
+		league := newLeague(&scheduledFor)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Twice()
+		mocks.leagueRepo.On("ClearScheduledDraftStart", leagueID).Return(nil).Once()
+		mocks.leagueRepo.On("UpdateLeague", league).Return(league, nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).
+			Return([]models.LeagueMember{{ID: memberID, LeagueID: leagueID, DraftPosition: 1, TeamName: &teamName}}, nil).Once()
+		var draft *models.Draft
+		mocks.draftRepo.On("CreateDraft", mock.AnythingOfType("*models.Draft")).
+			Run(func(args mock.Arguments) { draft = args.Get(0).(*models.Draft) }).Return(nil).Once()
+		mocks.schedulerService.On("RegisterTask", mock.AnythingOfType("*utils.ScheduledTask")).Return().Once()
+		mocks.webhookService.On("SendWebhookMessage", webhookURL, mock.MatchedBy(func(message string) bool {
+			return strings.Contains(message, "draft has started") && strings.Contains(message, teamName)
+		})).Return(nil).Once()
+
+		err := service.RunScheduledDraftStart(leagueID, scheduledFor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 45, draft.TurnTimeLimit)
+		assert.Nil(t, league.ScheduledDraftStart)
+		assert.Equal(t, enums.LeagueStatusDrafting, league.Status)
+		mocks.webhookService.AssertExpectations(t)
+		// the schedule was already cleared before StartDraft, so there is no task left to cancel
+		mocks.schedulerService.AssertNotCalled(t, "DeregisterTask", mock.Anything)
+	})
+
+	t.Run("Failure - Run reports an incomplete manual order to staff", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		scheduledFor := time.Now().Add(-time.Second)
+		league := newLeague(&scheduledFor)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Twice()
+		mocks.leagueRepo.On("ClearScheduledDraftStart", leagueID).Return(nil).Once()
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).
+			Return([]models.LeagueMember{{ID: memberID, LeagueID: leagueID, DraftPosition: 0}}, nil).Once()
+		mocks.webhookService.On("SendWebhookMessage", webhookURL, mock.MatchedBy(func(message string) bool {
+			return strings.Contains(message, "could not start") && strings.Contains(message, types.ErrInvalidDraftPosition.Error())
+		})).Return(nil).Once()
+
+		err := service.RunScheduledDraftStart(leagueID, scheduledFor)
+
+		assert.NoError(t, err, "a reported failure isn't retried")
+		mocks.leagueRepo.AssertExpectations(t)
+		mocks.draftRepo.AssertNotCalled(t, "CreateDraft", mock.Anything)
+		mocks.webhookService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Cancel clears the schedule and its task", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		scheduledFor := time.Now().Add(time.Hour)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(&scheduledFor), nil).Once()
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mocks.leagueRepo.On("ClearScheduledDraftStart", leagueID).Return(nil).Once()
+		mocks.schedulerService.On("DeregisterTask", startTaskID).Return().Once()
+
+		league, err := service.CancelScheduledDraftStart(leagueID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, league.ScheduledDraftStart)
+		assert.Zero(t, league.ScheduledDraftTurnTimeLimit)
+		mocks.leagueRepo.AssertExpectations(t)
+		mocks.leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+		mocks.schedulerService.AssertExpectations(t)
+	})
+
+	t.Run("Success - Run of a rescheduled start is ignored", func(t *testing.T) {
+		service, mocks := setupDraftServiceTest()
+		rescheduledFor := time.Now().Add(time.Hour)
+
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(&rescheduledFor), nil).Once()
+
+		err := service.RunScheduledDraftStart(leagueID, time.Now().Add(-time.Second))
+
+		assert.NoError(t, err)
+		mocks.leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+		mocks.leagueMemberRepo.AssertNotCalled(t, "GetByLeague", mock.Anything)
+	})
+}
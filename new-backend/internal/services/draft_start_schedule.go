@@ -0,0 +1,154 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// defaultScheduledTurnTimeLimit matches the turnTimeLimit POST /draft/start defaults to.
+const defaultScheduledTurnTimeLimit = 120
+
+// draftStartTaskID is the ID of a league's scheduled draft start task.
+func draftStartTaskID(leagueID uuid.UUID) string {
+	return fmt.Sprintf("%d_%s", utils.TaskTypeDraftStart, leagueID)
+}
+
+// newDraftStartTask builds the task that starts the league's draft at its League.ScheduledDraftStart.
+func newDraftStartTask(league *models.League, executeAt time.Time) *utils.ScheduledTask {
+	return &utils.ScheduledTask{
+		ID:        draftStartTaskID(league.ID),
+		ExecuteAt: executeAt,
+		Type:      utils.TaskTypeDraftStart,
+		Payload: utils.PayloadDraftStart{
+			LeagueID:     league.ID,
+			ScheduledFor: *league.ScheduledDraftStart,
+		},
+	}
+}
+
+// ScheduleDraftStart schedules the league's draft to start by itself at input.StartAt, replacing any
+// start already scheduled. Only the draft clock is checked now; the rest of StartDraft's validations
+// (e.g. a complete manual draft order) run when the start fires, so staff can finish setting up the
+// league in the meantime.
+func (s *draftServiceImpl) ScheduleDraftStart(leagueID uuid.UUID, input *requests.DraftScheduleStartRequestDTO) (*models.League, error) {
+	league, err := s.fetchLeagueBeforeDraft(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: ScheduleDraftStart) - cannot schedule draft start for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+	if !input.StartAt.After(s.clock.Now()) {
+		log.Printf("LOG: (DraftService: ScheduleDraftStart) - start time %s for league %s is in the past\n", input.StartAt, leagueID)
+		return nil, types.ErrInvalidInput
+	}
+	if err := league.Format.DraftClock().Validate(); err != nil {
+		log.Printf("LOG: (DraftService: ScheduleDraftStart) - League %s has an invalid draft clock: %v\n", leagueID, err)
+		return nil, types.ErrInvalidLeagueConfiguration
+	}
+
+	turnTimeLimit := input.TurnTimeLimit
+	if turnTimeLimit == 0 {
+		turnTimeLimit = defaultScheduledTurnTimeLimit
+	}
+	rescheduling := league.ScheduledDraftStart != nil
+	startAt := input.StartAt
+	league.ScheduledDraftStart = &startAt
+	league.ScheduledDraftTurnTimeLimit = turnTimeLimit
+	league, err = s.leagueRepo.UpdateLeague(league)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: ScheduleDraftStart) - could not save scheduled start for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	if rescheduling {
+		s.schedulerService.DeregisterTask(draftStartTaskID(leagueID))
+	}
+	s.schedulerService.RegisterTask(newDraftStartTask(league, startAt))
+	log.Printf("LOG: (DraftService: ScheduleDraftStart) - Draft for league %s scheduled to start at %s\n", leagueID, startAt)
+	return league, nil
+}
+
+// CancelScheduledDraftStart cancels the league's scheduled draft start.
+func (s *draftServiceImpl) CancelScheduledDraftStart(leagueID uuid.UUID) (*models.League, error) {
+	league, err := s.fetchLeagueBeforeDraft(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftService: CancelScheduledDraftStart) - cannot cancel draft start for league %s: %v\n", leagueID, err)
+		return nil, err
+	}
+	if league.ScheduledDraftStart == nil {
+		return nil, types.ErrInvalidState
+	}
+
+	if err := s.leagueRepo.ClearScheduledDraftStart(leagueID); err != nil {
+		log.Printf("ERROR: (DraftService: CancelScheduledDraftStart) - could not clear scheduled start for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	league.ScheduledDraftStart = nil
+	league.ScheduledDraftTurnTimeLimit = 0
+	s.schedulerService.DeregisterTask(draftStartTaskID(leagueID))
+	return league, nil
+}
+
+// RunScheduledDraftStart is called by the SchedulerService when a scheduled draft start is due. The
+// schedule is cleared first so a start that fails isn't retried on every restart, then the draft is
+// started through StartDraft, which announces it. If StartDraft fails, the reason is reported to league
+// staff on the draft event stream and the league's webhook, and the draft waits for them to start it;
+// the task itself still succeeds, since running it again can't fix what staff have to.
+func (s *draftServiceImpl) RunScheduledDraftStart(leagueID uuid.UUID, scheduledFor time.Time) error {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (DraftService: RunScheduledDraftStart) - could not find league %s: %v\n", leagueID, err)
+		return types.ErrLeagueNotFound
+	}
+	if league.ScheduledDraftStart == nil || !league.ScheduledDraftStart.Equal(scheduledFor) {
+		log.Printf("WARN: (DraftService: RunScheduledDraftStart) - draft start at %s for league %s is no longer scheduled. Ignoring.\n", scheduledFor, leagueID)
+		return nil
+	}
+
+	turnTimeLimit := league.ScheduledDraftTurnTimeLimit
+	if err := s.leagueRepo.ClearScheduledDraftStart(leagueID); err != nil {
+		log.Printf("ERROR: (DraftService: RunScheduledDraftStart) - could not clear scheduled start for league %s: %v\n", leagueID, err)
+		return types.ErrInternalService
+	}
+	league.ScheduledDraftStart = nil
+	league.ScheduledDraftTurnTimeLimit = 0
+
+	if _, err := s.StartDraft(leagueID, turnTimeLimit); err != nil {
+		log.Printf("ERROR: (DraftService: RunScheduledDraftStart) - scheduled start of the draft for league %s failed: %v\n", leagueID, err)
+		s.publishEvent(leagueID, types.DraftEventStartFailed, types.DraftEventStartFailedPayload{
+			ScheduledFor: scheduledFor,
+			Reason:       err.Error(),
+		})
+		s.notifyLeague(league, fmt.Sprintf("The draft scheduled for %s could not start: %v. League staff need to fix this and start the draft.",
+			scheduledFor.UTC().Format("Jan 2 15:04 MST"), err))
+		return nil // reported; retrying would only start it twice or report it again
+	}
+	return nil
+}
+
+// fetchLeagueBeforeDraft returns the league if its draft hasn't started yet, else ErrInvalidState.
+func (s *draftServiceImpl) fetchLeagueBeforeDraft(leagueID uuid.UUID) (*models.League, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		return nil, types.ErrInternalService
+	}
+	if league.Status != enums.LeagueStatusPending && league.Status != enums.LeagueStatusSetup {
+		return nil, types.ErrInvalidState
+	}
+	if draft, err := s.draftRepo.GetDraftByLeagueID(leagueID); err == nil && draft.Status != enums.DraftStatusPending {
+		return nil, types.ErrInvalidState
+	}
+	return league, nil
+}
@@ -0,0 +1,508 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DraftTradeService handles trades of draft slots (and optionally draft points) between league members.
+// Accepted trades update the slot ownership records that DraftService consults to decide who is on the clock.
+type DraftTradeService interface {
+	GetTradesByLeague(leagueID uuid.UUID) ([]models.DraftPickTrade, error)
+	GetSlotsByLeague(leagueID uuid.UUID) ([]models.DraftPickSlot, error)
+	ProposeTrade(currentUser *models.User, leagueID uuid.UUID, input *requests.DraftPickTradeProposeRequestDTO) (*models.DraftPickTrade, error)
+	AcceptTrade(currentUser *models.User, leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error)
+	RejectTrade(currentUser *models.User, leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error)
+	// VetoTrade is a staff action. A pending trade is simply closed; an accepted trade is reversed
+	// as long as none of its slots have come up yet.
+	VetoTrade(leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error)
+	SetClock(clock utils.Clock)
+}
+
+type draftTradeServiceImpl struct {
+	draftTradeRepo repositories.DraftTradeRepository
+	leagueRepo     repositories.LeagueRepository
+	draftRepo      repositories.DraftRepository
+	memberRepo     repositories.LeagueMemberRepository
+	eventService   DraftEventService
+	clock          utils.Clock
+}
+
+func NewDraftTradeService(
+	draftTradeRepo repositories.DraftTradeRepository,
+	leagueRepo repositories.LeagueRepository,
+	draftRepo repositories.DraftRepository,
+	memberRepo repositories.LeagueMemberRepository,
+	eventService DraftEventService,
+) DraftTradeService {
+	return &draftTradeServiceImpl{
+		draftTradeRepo: draftTradeRepo,
+		leagueRepo:     leagueRepo,
+		draftRepo:      draftRepo,
+		memberRepo:     memberRepo,
+		eventService:   eventService,
+		clock:          utils.SystemClock,
+	}
+}
+
+// SetClock replaces the wall clock that trades are stamped with when they're resolved.
+func (s *draftTradeServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
+func (s *draftTradeServiceImpl) GetTradesByLeague(leagueID uuid.UUID) ([]models.DraftPickTrade, error) {
+	trades, err := s.draftTradeRepo.GetTradesByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: GetTradesByLeague) - failed to fetch trades for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return trades, nil
+}
+
+func (s *draftTradeServiceImpl) GetSlotsByLeague(leagueID uuid.UUID) ([]models.DraftPickSlot, error) {
+	slots, err := s.draftTradeRepo.GetSlotsByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: GetSlotsByLeague) - failed to fetch slots for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return slots, nil
+}
+
+// ProposeTrade creates a PENDING trade from the current user to input.RecipientID.
+// Ownership and points are only checked here so the recipient sees a sensible offer;
+// everything is re-validated when the trade is accepted.
+func (s *draftTradeServiceImpl) ProposeTrade(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.DraftPickTradeProposeRequestDTO,
+) (*models.DraftPickTrade, error) {
+	proposer, err := s.fetchCurrentMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if input.RecipientID == proposer.ID {
+		log.Printf("LOG: (DraftTradeService: ProposeTrade) - member %s attempted to trade with themselves\n", proposer.ID)
+		return nil, types.ErrInvalidInput
+	}
+	recipient, err := s.fetchMember(input.RecipientID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	trade := &models.DraftPickTrade{
+		LeagueID:        leagueID,
+		ProposerID:      proposer.ID,
+		RecipientID:     recipient.ID,
+		OfferedSlots:    slotRefsFromDTO(input.OfferedSlots),
+		RequestedSlots:  slotRefsFromDTO(input.RequestedSlots),
+		OfferedPoints:   input.OfferedPoints,
+		RequestedPoints: input.RequestedPoints,
+		Status:          enums.DraftPickTradeStatusPending,
+	}
+	if err := s.validateExchange(leagueID,
+		proposer, trade.OfferedSlots, trade.OfferedPoints,
+		recipient, trade.RequestedSlots, trade.RequestedPoints,
+	); err != nil {
+		return nil, err
+	}
+
+	createdTrade, err := s.draftTradeRepo.CreateTrade(trade)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: ProposeTrade) - failed to create trade in league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return createdTrade, nil
+}
+
+// AcceptTrade is called by the trade's recipient. It moves the slots and points in a single transaction.
+func (s *draftTradeServiceImpl) AcceptTrade(currentUser *models.User, leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error) {
+	member, err := s.fetchCurrentMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	trade, err := s.fetchPendingTrade(leagueID, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if trade.RecipientID != member.ID {
+		log.Printf("LOG: (DraftTradeService: AcceptTrade) - member %s is not the recipient of trade %s\n", member.ID, trade.ID)
+		return nil, types.ErrUnauthorized
+	}
+
+	proposer, err := s.fetchMember(trade.ProposerID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateExchange(leagueID,
+		proposer, trade.OfferedSlots, trade.OfferedPoints,
+		member, trade.RequestedSlots, trade.RequestedPoints,
+	); err != nil {
+		return nil, err
+	}
+
+	// offered slots go to the recipient, requested slots go to the proposer
+	slots := make([]models.DraftPickSlot, 0, len(trade.OfferedSlots)+len(trade.RequestedSlots))
+	slots = append(slots, buildSlotRecords(leagueID, trade.OfferedSlots, trade.RecipientID)...)
+	slots = append(slots, buildSlotRecords(leagueID, trade.RequestedSlots, trade.ProposerID)...)
+
+	now := s.clock.Now()
+	trade.Status = enums.DraftPickTradeStatusAccepted
+	trade.ResolvedAt = &now
+	pointsToRecipient := trade.OfferedPoints - trade.RequestedPoints
+	if err := s.draftTradeRepo.ApplyTrade(trade, slots, -pointsToRecipient, pointsToRecipient); err != nil {
+		log.Printf("LOG: (DraftTradeService: AcceptTrade) - failed to apply trade %s: %v\n", trade.ID, err)
+		if errors.Is(err, types.ErrInsufficientDraftPoints) {
+			return nil, types.ErrInsufficientDraftPoints
+		}
+		return nil, types.ErrInternalService
+	}
+
+	s.publishPickTraded(trade)
+	return trade, nil
+}
+
+// RejectTrade closes a pending trade. The recipient rejects it; the proposer withdraws (cancels) it.
+func (s *draftTradeServiceImpl) RejectTrade(currentUser *models.User, leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error) {
+	member, err := s.fetchCurrentMember(currentUser.ID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	trade, err := s.fetchPendingTrade(leagueID, tradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch member.ID {
+	case trade.RecipientID:
+		trade.Status = enums.DraftPickTradeStatusRejected
+	case trade.ProposerID:
+		trade.Status = enums.DraftPickTradeStatusCancelled
+	default:
+		log.Printf("LOG: (DraftTradeService: RejectTrade) - member %s is not a party to trade %s\n", member.ID, trade.ID)
+		return nil, types.ErrUnauthorized
+	}
+	now := s.clock.Now()
+	trade.ResolvedAt = &now
+
+	updatedTrade, err := s.draftTradeRepo.UpdateTrade(trade)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: RejectTrade) - failed to update trade %s: %v\n", trade.ID, err)
+		return nil, types.ErrInternalService
+	}
+	return updatedTrade, nil
+}
+
+func (s *draftTradeServiceImpl) VetoTrade(leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error) {
+	trade, err := s.fetchTrade(leagueID, tradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	switch trade.Status {
+	case enums.DraftPickTradeStatusPending:
+		trade.Status = enums.DraftPickTradeStatusVetoed
+		trade.ResolvedAt = &now
+		updatedTrade, err := s.draftTradeRepo.UpdateTrade(trade)
+		if err != nil {
+			log.Printf("LOG: (DraftTradeService: VetoTrade) - failed to update trade %s: %v\n", trade.ID, err)
+			return nil, types.ErrInternalService
+		}
+		return updatedTrade, nil
+
+	case enums.DraftPickTradeStatusAccepted:
+		proposer, err := s.fetchMember(trade.ProposerID, leagueID)
+		if err != nil {
+			return nil, err
+		}
+		recipient, err := s.fetchMember(trade.RecipientID, leagueID)
+		if err != nil {
+			return nil, err
+		}
+		// the reversal is an exchange where the recipient gives back what was offered and vice versa
+		if err := s.validateExchange(leagueID,
+			recipient, trade.OfferedSlots, trade.OfferedPoints,
+			proposer, trade.RequestedSlots, trade.RequestedPoints,
+		); err != nil {
+			return nil, err
+		}
+
+		slots := make([]models.DraftPickSlot, 0, len(trade.OfferedSlots)+len(trade.RequestedSlots))
+		slots = append(slots, buildSlotRecords(leagueID, trade.OfferedSlots, trade.ProposerID)...)
+		slots = append(slots, buildSlotRecords(leagueID, trade.RequestedSlots, trade.RecipientID)...)
+
+		trade.Status = enums.DraftPickTradeStatusVetoed
+		trade.ResolvedAt = &now
+		pointsToRecipient := trade.OfferedPoints - trade.RequestedPoints
+		if err := s.draftTradeRepo.ApplyTrade(trade, slots, pointsToRecipient, -pointsToRecipient); err != nil {
+			log.Printf("LOG: (DraftTradeService: VetoTrade) - failed to reverse trade %s: %v\n", trade.ID, err)
+			if errors.Is(err, types.ErrInsufficientDraftPoints) {
+				return nil, types.ErrInsufficientDraftPoints
+			}
+			return nil, types.ErrInternalService
+		}
+		s.publishPickTraded(trade)
+		return trade, nil
+
+	default:
+		log.Printf("LOG: (DraftTradeService: VetoTrade) - trade %s is already %s\n", trade.ID, trade.Status)
+		return nil, types.ErrInvalidState
+	}
+}
+
+// validateExchange checks that sideA can give slotsA and pointsA to sideB in return for slotsB and pointsB:
+// the league is in a tradeable state, every slot is valid, still to come and currently owned by the side
+// giving it up, both sides can afford the points, and neither ends up with more pick slots than the
+// league's maximum or fewer than its minimum.
+func (s *draftTradeServiceImpl) validateExchange(
+	leagueID uuid.UUID,
+	sideA *models.LeagueMember, slotsA models.DraftSlotRefs, pointsA int,
+	sideB *models.LeagueMember, slotsB models.DraftSlotRefs, pointsB int,
+) error {
+	if len(slotsA)+len(slotsB) == 0 {
+		log.Printf("LOG: (DraftTradeService: validateExchange) - trade between %s and %s has no slots\n", sideA.ID, sideB.ID)
+		return types.ErrInvalidInput
+	}
+	if sideA.DraftPoints < pointsA || sideB.DraftPoints < pointsB {
+		return types.ErrInsufficientDraftPoints
+	}
+
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrLeagueNotFound
+		}
+		log.Printf("LOG: (DraftTradeService: validateExchange) - failed to fetch league %s: %v\n", leagueID, err)
+		return types.ErrInternalService
+	}
+	switch league.Status {
+	case enums.LeagueStatusPending, enums.LeagueStatusSetup, enums.LeagueStatusDrafting:
+	default:
+		return types.ErrInvalidState
+	}
+	if league.Format.IsAuctionDraft() { // auction drafts have no pick slots to trade
+		return types.ErrInvalidState
+	}
+
+	var draft *models.Draft
+	draft, err = s.draftRepo.GetDraftByLeagueID(leagueID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("LOG: (DraftTradeService: validateExchange) - failed to fetch draft for league %s: %v\n", leagueID, err)
+			return types.ErrInternalService
+		}
+		draft = nil // draft not created yet; every slot is still to come
+	}
+	if draft != nil && draft.Status == enums.DraftStatusCompleted {
+		return types.ErrInvalidState
+	}
+
+	members, err := s.memberRepo.GetByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: validateExchange) - failed to fetch members of league %s: %v\n", leagueID, err)
+		return types.ErrInternalService
+	}
+	membersByID := make(map[uuid.UUID]models.LeagueMember, len(members))
+	for _, m := range members {
+		membersByID[m.ID] = m
+	}
+
+	seen := make(map[models.DraftSlotRef]bool)
+	check := func(slots models.DraftSlotRefs, giver uuid.UUID) error {
+		for _, slot := range slots {
+			if seen[slot] {
+				log.Printf("LOG: (DraftTradeService: validateExchange) - slot %s/%d listed more than once\n", slot.OriginalOwnerID, slot.RoundNumber)
+				return types.ErrInvalidInput
+			}
+			seen[slot] = true
+
+			originalOwner, ok := membersByID[slot.OriginalOwnerID]
+			if !ok || slot.RoundNumber < 1 || slot.RoundNumber > league.MaxPokemonPerPlayer {
+				log.Printf("LOG: (DraftTradeService: validateExchange) - slot %s/%d does not exist in league %s\n", slot.OriginalOwnerID, slot.RoundNumber, leagueID)
+				return types.ErrInvalidInput
+			}
+			if !slotStillToCome(league, draft, &originalOwner, slot.RoundNumber, len(members)) {
+				log.Printf("LOG: (DraftTradeService: validateExchange) - slot %s/%d has already come up\n", slot.OriginalOwnerID, slot.RoundNumber)
+				return types.ErrInvalidState
+			}
+			owner, err := s.currentSlotOwner(slot)
+			if err != nil {
+				return err
+			}
+			if owner != giver {
+				log.Printf("LOG: (DraftTradeService: validateExchange) - slot %s/%d is owned by %s, not %s\n", slot.OriginalOwnerID, slot.RoundNumber, owner, giver)
+				return types.ErrUnauthorized
+			}
+		}
+		return nil
+	}
+	if err := check(slotsA, sideA.ID); err != nil {
+		return err
+	}
+	if err := check(slotsB, sideB.ID); err != nil {
+		return err
+	}
+	if len(slotsA) == len(slotsB) {
+		return nil
+	}
+
+	// an uneven trade changes how many picks each side ends up with
+	owned, err := s.slotCounts(league)
+	if err != nil {
+		return err
+	}
+	for _, side := range []struct {
+		id          uuid.UUID
+		gives, gets int
+	}{{sideA.ID, len(slotsA), len(slotsB)}, {sideB.ID, len(slotsB), len(slotsA)}} {
+		after := owned(side.id) - side.gives + side.gets
+		if after > league.MaxPokemonPerPlayer {
+			log.Printf("LOG: (DraftTradeService: validateExchange) - member %s would own %d slots, above the maximum of %d\n", side.id, after, league.MaxPokemonPerPlayer)
+			return types.ErrAboveMaxPokemon
+		}
+		if after < league.MinPokemonPerPlayer {
+			log.Printf("LOG: (DraftTradeService: validateExchange) - member %s would own %d slots, below the minimum of %d\n", side.id, after, league.MinPokemonPerPlayer)
+			return types.ErrBelowMinPokemon
+		}
+	}
+	return nil
+}
+
+// slotCounts returns how many pick slots a member currently owns: one per round, less the slots traded
+// away, plus the slots traded in.
+func (s *draftTradeServiceImpl) slotCounts(league *models.League) (func(uuid.UUID) int, error) {
+	slots, err := s.draftTradeRepo.GetSlotsByLeague(league.ID)
+	if err != nil {
+		log.Printf("LOG: (DraftTradeService: slotCounts) - failed to fetch draft pick slots of league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	delta := make(map[uuid.UUID]int)
+	for _, slot := range slots {
+		if slot.OwnerID != slot.OriginalOwnerID {
+			delta[slot.OriginalOwnerID]--
+			delta[slot.OwnerID]++
+		}
+	}
+	return func(memberID uuid.UUID) int {
+		return league.MaxPokemonPerPlayer + delta[memberID]
+	}, nil
+}
+
+// slotStillToCome reports whether a slot is strictly after the pick currently on the clock.
+// Before the draft starts, draft positions may not be assigned yet, so every slot counts as still to come.
+func slotStillToCome(league *models.League, draft *models.Draft, originalOwner *models.LeagueMember, round, memberCount int) bool {
+	if draft == nil || draft.Status == enums.DraftStatusPending {
+		return true
+	}
+	pickInRound := slotPickInRound(league, round, originalOwner.DraftPosition, memberCount)
+	pickNumber := (round-1)*memberCount + pickInRound
+	return pickNumber > draft.CurrentPickOnClock
+}
+
+func (s *draftTradeServiceImpl) currentSlotOwner(slot models.DraftSlotRef) (uuid.UUID, error) {
+	record, err := s.draftTradeRepo.GetSlot(slot.OriginalOwnerID, slot.RoundNumber)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return slot.OriginalOwnerID, nil
+		}
+		log.Printf("LOG: (DraftTradeService: currentSlotOwner) - failed to fetch slot %s/%d: %v\n", slot.OriginalOwnerID, slot.RoundNumber, err)
+		return uuid.Nil, types.ErrInternalService
+	}
+	return record.OwnerID, nil
+}
+
+func (s *draftTradeServiceImpl) fetchCurrentMember(userID, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	member, err := s.memberRepo.GetByUserAndLeague(userID, leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (DraftTradeService: fetchCurrentMember) - (user %s) error fetching member in league %s: %v\n", userID, leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	return member, nil
+}
+
+func (s *draftTradeServiceImpl) fetchMember(memberID, leagueID uuid.UUID) (*models.LeagueMember, error) {
+	member, err := s.memberRepo.GetByID(memberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (DraftTradeService: fetchMember) - error fetching member %s: %v\n", memberID, err)
+		return nil, types.ErrInternalService
+	}
+	if member.LeagueID != leagueID {
+		return nil, types.ErrPlayerNotFound
+	}
+	return member, nil
+}
+
+func (s *draftTradeServiceImpl) fetchTrade(leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error) {
+	trade, err := s.draftTradeRepo.GetTradeByID(tradeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrDraftPickTradeNotFound
+		}
+		log.Printf("LOG: (DraftTradeService: fetchTrade) - failed to fetch trade %s: %v\n", tradeID, err)
+		return nil, types.ErrInternalService
+	}
+	if trade.LeagueID != leagueID {
+		return nil, types.ErrDraftPickTradeNotFound
+	}
+	return trade, nil
+}
+
+func (s *draftTradeServiceImpl) fetchPendingTrade(leagueID, tradeID uuid.UUID) (*models.DraftPickTrade, error) {
+	trade, err := s.fetchTrade(leagueID, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if trade.Status != enums.DraftPickTradeStatusPending {
+		log.Printf("LOG: (DraftTradeService: fetchPendingTrade) - trade %s is already %s\n", trade.ID, trade.Status)
+		return nil, types.ErrInvalidState
+	}
+	return trade, nil
+}
+
+func (s *draftTradeServiceImpl) publishPickTraded(trade *models.DraftPickTrade) {
+	if s.eventService == nil {
+		return
+	}
+	s.eventService.Publish(trade.LeagueID, types.DraftEventPickTraded, types.DraftEventPickTradedPayload{
+		TradeID:     trade.ID,
+		ProposerID:  trade.ProposerID,
+		RecipientID: trade.RecipientID,
+		Status:      string(trade.Status),
+	})
+}
+
+func slotRefsFromDTO(dtos []requests.DraftSlotRefDTO) models.DraftSlotRefs {
+	refs := make(models.DraftSlotRefs, 0, len(dtos))
+	for _, dto := range dtos {
+		refs = append(refs, models.DraftSlotRef{OriginalOwnerID: dto.OriginalOwnerID, RoundNumber: dto.RoundNumber})
+	}
+	return refs
+}
+
+func buildSlotRecords(leagueID uuid.UUID, refs models.DraftSlotRefs, ownerID uuid.UUID) []models.DraftPickSlot {
+	slots := make([]models.DraftPickSlot, 0, len(refs))
+	for _, ref := range refs {
+		slots = append(slots, models.DraftPickSlot{
+			LeagueID:        leagueID,
+			OriginalOwnerID: ref.OriginalOwnerID,
+			RoundNumber:     ref.RoundNumber,
+			OwnerID:         ownerID,
+		})
+	}
+	return slots
+}
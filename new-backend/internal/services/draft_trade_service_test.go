@@ -0,0 +1,319 @@
+package services_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type draftTradeServiceMocks struct {
+	draftTradeRepo   *mock_repositories.MockDraftTradeRepository
+	leagueRepo       *mock_repositories.MockLeagueRepository
+	draftRepo        *mock_repositories.MockDraftRepository
+	leagueMemberRepo *mock_repositories.MockLeagueMemberRepository
+	eventService     services.DraftEventService
+}
+
+func setupDraftTradeServiceTest() (services.DraftTradeService, draftTradeServiceMocks) {
+	mocks := draftTradeServiceMocks{
+		draftTradeRepo:   new(mock_repositories.MockDraftTradeRepository),
+		leagueRepo:       new(mock_repositories.MockLeagueRepository),
+		draftRepo:        new(mock_repositories.MockDraftRepository),
+		leagueMemberRepo: new(mock_repositories.MockLeagueMemberRepository),
+		eventService:     services.NewDraftEventService(),
+	}
+	service := services.NewDraftTradeService(mocks.draftTradeRepo, mocks.leagueRepo, mocks.draftRepo, mocks.leagueMemberRepo, mocks.eventService)
+	return service, mocks
+}
+
+func TestDraftTradeService(t *testing.T) {
+	leagueID := uuid.New()
+	proposerUser := &models.User{ID: uuid.New()}
+	recipientUser := &models.User{ID: uuid.New()}
+	newMembers := func() (*models.LeagueMember, *models.LeagueMember, []models.LeagueMember) {
+		proposer := &models.LeagueMember{ID: uuid.New(), UserID: proposerUser.ID, LeagueID: leagueID, DraftPosition: 1, DraftPoints: 10}
+		recipient := &models.LeagueMember{ID: uuid.New(), UserID: recipientUser.ID, LeagueID: leagueID, DraftPosition: 2, DraftPoints: 10}
+		return proposer, recipient, []models.LeagueMember{*proposer, *recipient}
+	}
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting,
+			MaxPokemonPerPlayer: 6,
+			Format:              &types.LeagueFormat{IsSnakeRoundDraft: true},
+		}
+	}
+	// expectLeagueState mocks everything validateExchange fetches, with no slot traded yet
+	expectLeagueState := func(mocks draftTradeServiceMocks, draft *models.Draft, members []models.LeagueMember) {
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil)
+		if draft == nil {
+			mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound)
+		} else {
+			mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(draft, nil)
+		}
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(members, nil)
+		mocks.draftTradeRepo.On("GetSlot", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		mocks.draftTradeRepo.On("GetSlotsByLeague", leagueID).Return([]models.DraftPickSlot{}, nil).Maybe()
+	}
+
+	t.Run("ProposeTrade - Success before the draft starts", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		input := &requests.DraftPickTradeProposeRequestDTO{
+			RecipientID:     recipient.ID,
+			OfferedSlots:    []requests.DraftSlotRefDTO{{OriginalOwnerID: proposer.ID, RoundNumber: 3}},
+			RequestedSlots:  []requests.DraftSlotRefDTO{{OriginalOwnerID: recipient.ID, RoundNumber: 5}},
+			OfferedPoints:   2,
+			RequestedPoints: 0,
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+		expectLeagueState(mocks, nil, members)
+		mocks.draftTradeRepo.On("CreateTrade", mock.MatchedBy(func(trade *models.DraftPickTrade) bool {
+			return trade.Status == enums.DraftPickTradeStatusPending &&
+				trade.ProposerID == proposer.ID && trade.RecipientID == recipient.ID &&
+				len(trade.OfferedSlots) == 1 && trade.OfferedSlots[0].RoundNumber == 3 &&
+				trade.OfferedPoints == 2
+		})).Return(&models.DraftPickTrade{ID: uuid.New(), Status: enums.DraftPickTradeStatusPending}, nil).Once()
+
+		trade, err := service.ProposeTrade(proposerUser, leagueID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftPickTradeStatusPending, trade.Status)
+		mocks.draftTradeRepo.AssertExpectations(t)
+	})
+
+	t.Run("ProposeTrade - Failure offering a slot the proposer does not own", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		input := &requests.DraftPickTradeProposeRequestDTO{
+			RecipientID:  recipient.ID,
+			OfferedSlots: []requests.DraftSlotRefDTO{{OriginalOwnerID: recipient.ID, RoundNumber: 2}},
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+		expectLeagueState(mocks, nil, members)
+
+		_, err := service.ProposeTrade(proposerUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		mocks.draftTradeRepo.AssertNotCalled(t, "CreateTrade", mock.Anything)
+	})
+
+	t.Run("ProposeTrade - Failure slot already came up", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		// snake, 2 members: round 2 order is recipient (pick 3), proposer (pick 4)
+		draft := &models.Draft{LeagueID: leagueID, Status: enums.DraftStatusOngoing, CurrentPickOnClock: 4}
+		input := &requests.DraftPickTradeProposeRequestDTO{
+			RecipientID:  recipient.ID,
+			OfferedSlots: []requests.DraftSlotRefDTO{{OriginalOwnerID: proposer.ID, RoundNumber: 2}},
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+		expectLeagueState(mocks, draft, members)
+
+		_, err := service.ProposeTrade(proposerUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+
+	t.Run("ProposeTrade - Failure insufficient points", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, _ := newMembers()
+		input := &requests.DraftPickTradeProposeRequestDTO{
+			RecipientID:   recipient.ID,
+			OfferedSlots:  []requests.DraftSlotRefDTO{{OriginalOwnerID: proposer.ID, RoundNumber: 1}},
+			OfferedPoints: 11,
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+
+		_, err := service.ProposeTrade(proposerUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrInsufficientDraftPoints)
+	})
+
+	t.Run("ProposeTrade - Failure uneven trade puts the recipient above the maximum", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		input := &requests.DraftPickTradeProposeRequestDTO{
+			RecipientID:  recipient.ID,
+			OfferedSlots: []requests.DraftSlotRefDTO{{OriginalOwnerID: proposer.ID, RoundNumber: 3}},
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+		expectLeagueState(mocks, nil, members)
+
+		_, err := service.ProposeTrade(proposerUser, leagueID, input)
+
+		assert.ErrorIs(t, err, types.ErrAboveMaxPokemon)
+		mocks.draftTradeRepo.AssertNotCalled(t, "CreateTrade", mock.Anything)
+	})
+
+	t.Run("AcceptTrade - Failure points spent since the trade was validated", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		trade := &models.DraftPickTrade{
+			ID:             uuid.New(),
+			LeagueID:       leagueID,
+			ProposerID:     proposer.ID,
+			RecipientID:    recipient.ID,
+			OfferedSlots:   models.DraftSlotRefs{{OriginalOwnerID: proposer.ID, RoundNumber: 3}},
+			RequestedSlots: models.DraftSlotRefs{{OriginalOwnerID: recipient.ID, RoundNumber: 5}},
+			OfferedPoints:  2,
+			Status:         enums.DraftPickTradeStatusPending,
+		}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", recipientUser.ID, leagueID).Return(recipient, nil).Once()
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", proposer.ID).Return(proposer, nil).Once()
+		expectLeagueState(mocks, nil, members)
+		mocks.draftTradeRepo.On("ApplyTrade", trade, mock.Anything, -2, 2).
+			Return(fmt.Errorf("member %s: %w", proposer.ID, types.ErrInsufficientDraftPoints)).Once()
+
+		_, err := service.AcceptTrade(recipientUser, leagueID, trade.ID)
+
+		assert.ErrorIs(t, err, types.ErrInsufficientDraftPoints)
+	})
+
+	t.Run("AcceptTrade - Success moves slots and points", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		trade := &models.DraftPickTrade{
+			ID:             uuid.New(),
+			LeagueID:       leagueID,
+			ProposerID:     proposer.ID,
+			RecipientID:    recipient.ID,
+			OfferedSlots:   models.DraftSlotRefs{{OriginalOwnerID: proposer.ID, RoundNumber: 3}},
+			RequestedSlots: models.DraftSlotRefs{{OriginalOwnerID: recipient.ID, RoundNumber: 5}},
+			OfferedPoints:  2,
+			Status:         enums.DraftPickTradeStatusPending,
+		}
+		_, events, unsubscribe := mocks.eventService.Subscribe(leagueID, 0)
+		defer unsubscribe()
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", recipientUser.ID, leagueID).Return(recipient, nil).Once()
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", proposer.ID).Return(proposer, nil).Once()
+		expectLeagueState(mocks, nil, members)
+		mocks.draftTradeRepo.On("ApplyTrade", trade, mock.MatchedBy(func(slots []models.DraftPickSlot) bool {
+			return len(slots) == 2 &&
+				slots[0].OriginalOwnerID == proposer.ID && slots[0].RoundNumber == 3 && slots[0].OwnerID == recipient.ID &&
+				slots[1].OriginalOwnerID == recipient.ID && slots[1].RoundNumber == 5 && slots[1].OwnerID == proposer.ID
+		}), -2, 2).Return(nil).Once()
+
+		result, err := service.AcceptTrade(recipientUser, leagueID, trade.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftPickTradeStatusAccepted, result.Status)
+		assert.NotNil(t, result.ResolvedAt)
+		event := <-events
+		assert.Equal(t, types.DraftEventPickTraded, event.Type)
+		mocks.draftTradeRepo.AssertExpectations(t)
+	})
+
+	t.Run("AcceptTrade - Failure proposer cannot accept their own trade", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, _ := newMembers()
+		trade := &models.DraftPickTrade{ID: uuid.New(), LeagueID: leagueID, ProposerID: proposer.ID, RecipientID: recipient.ID, Status: enums.DraftPickTradeStatusPending}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+
+		_, err := service.AcceptTrade(proposerUser, leagueID, trade.ID)
+
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		mocks.draftTradeRepo.AssertNotCalled(t, "ApplyTrade", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RejectTrade - Proposer withdrawing cancels the trade", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, _ := newMembers()
+		trade := &models.DraftPickTrade{ID: uuid.New(), LeagueID: leagueID, ProposerID: proposer.ID, RecipientID: recipient.ID, Status: enums.DraftPickTradeStatusPending}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", proposerUser.ID, leagueID).Return(proposer, nil).Once()
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+		mocks.draftTradeRepo.On("UpdateTrade", mock.MatchedBy(func(updated *models.DraftPickTrade) bool {
+			return updated.Status == enums.DraftPickTradeStatusCancelled
+		})).Return(trade, nil).Once()
+
+		_, err := service.RejectTrade(proposerUser, leagueID, trade.ID)
+
+		assert.NoError(t, err)
+		mocks.draftTradeRepo.AssertExpectations(t)
+	})
+
+	t.Run("RejectTrade - Failure trade already resolved", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		_, recipient, _ := newMembers()
+		trade := &models.DraftPickTrade{ID: uuid.New(), LeagueID: leagueID, RecipientID: recipient.ID, Status: enums.DraftPickTradeStatusAccepted}
+
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", recipientUser.ID, leagueID).Return(recipient, nil).Once()
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+
+		_, err := service.RejectTrade(recipientUser, leagueID, trade.ID)
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+	})
+
+	t.Run("VetoTrade - Reverses an accepted trade", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		proposer, recipient, members := newMembers()
+		trade := &models.DraftPickTrade{
+			ID:            uuid.New(),
+			LeagueID:      leagueID,
+			ProposerID:    proposer.ID,
+			RecipientID:   recipient.ID,
+			OfferedSlots:  models.DraftSlotRefs{{OriginalOwnerID: proposer.ID, RoundNumber: 3}},
+			OfferedPoints: 2,
+			Status:        enums.DraftPickTradeStatusAccepted,
+		}
+
+		mocks.draftTradeRepo.On("GetTradeByID", trade.ID).Return(trade, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", proposer.ID).Return(proposer, nil).Once()
+		mocks.leagueMemberRepo.On("GetByID", recipient.ID).Return(recipient, nil).Once()
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil)
+		mocks.draftRepo.On("GetDraftByLeagueID", leagueID).Return(nil, gorm.ErrRecordNotFound)
+		mocks.leagueMemberRepo.On("GetByLeague", leagueID).Return(members, nil)
+		mocks.draftTradeRepo.On("GetSlot", proposer.ID, 3).
+			Return(&models.DraftPickSlot{OriginalOwnerID: proposer.ID, RoundNumber: 3, OwnerID: recipient.ID}, nil).Once()
+		mocks.draftTradeRepo.On("GetSlotsByLeague", leagueID).
+			Return([]models.DraftPickSlot{{OriginalOwnerID: proposer.ID, RoundNumber: 3, OwnerID: recipient.ID}}, nil).Once()
+		mocks.draftTradeRepo.On("ApplyTrade", trade, mock.MatchedBy(func(slots []models.DraftPickSlot) bool {
+			return len(slots) == 1 && slots[0].OwnerID == proposer.ID
+		}), 2, -2).Return(nil).Once()
+
+		result, err := service.VetoTrade(leagueID, trade.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.DraftPickTradeStatusVetoed, result.Status)
+		mocks.draftTradeRepo.AssertExpectations(t)
+	})
+
+	t.Run("VetoTrade - Failure trade not in league", func(t *testing.T) {
+		service, mocks := setupDraftTradeServiceTest()
+		tradeID := uuid.New()
+
+		mocks.draftTradeRepo.On("GetTradeByID", tradeID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.VetoTrade(leagueID, tradeID)
+
+		assert.ErrorIs(t, err, types.ErrDraftPickTradeNotFound)
+	})
+}
@@ -7,12 +7,16 @@ import (
 	"math/bits"
 	"math/rand/v2"
 	"sort"
+	"strings"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/showdown"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -25,15 +29,29 @@ type GameService interface {
 	GeneratePlayoffBracket(leagueID uuid.UUID) error
 
 	ReportGameResult(gameID uuid.UUID, dto *requests.ReportGameRequestDTO) error
+	// ReadBattleLogs reads a game's result from the Showdown logs of its battles, to prefill a report.
+	ReadBattleLogs(gameID uuid.UUID, battleLogs []string) (*responses.BattleLogResultResponse, error)
 	FinalizeGameResult(gameID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error
+	// ConfirmGameResult is the opponent agreeing with a reported result. It reports whether the
+	// confirmation also finalized the game, which it does if the league auto-finalizes confirmed games.
+	ConfirmGameResult(gameID uuid.UUID, memberID uuid.UUID) (bool, error)
+	DisputeGameResult(gameID uuid.UUID, dto *requests.DisputeGameRequestDTO) error
+	// GetDisputedGames is the staff queue of a league's disputed games, each with its result history.
+	GetDisputedGames(leagueID uuid.UUID) ([]models.Game, error)
 	SetLeagueService(leagueService LeagueService)
+	// SetClaimRepository lets reports with battle logs record each claimed Pokémon's stats.
+	SetClaimRepository(claimRepo repositories.ClaimRepository)
+	// SetClock replaces the wall clock a league's end date is stamped from when its playoffs finish.
+	SetClock(clock utils.Clock)
 }
 
 type gameServiceImpl struct {
 	gameRepo      repositories.GameRepository
 	leagueRepo    repositories.LeagueRepository
 	memberRepo    repositories.LeagueMemberRepository
+	claimRepo     repositories.ClaimRepository
 	leagueService LeagueService
+	clock         utils.Clock
 }
 
 func NewGameService(
@@ -45,6 +63,7 @@ func NewGameService(
 		gameRepo:   gameRepo,
 		leagueRepo: leagueRepo,
 		memberRepo: memberRepo,
+		clock:      utils.SystemClock,
 	}
 }
 
@@ -52,6 +71,14 @@ func (s *gameServiceImpl) SetLeagueService(leagueService LeagueService) {
 	s.leagueService = leagueService
 }
 
+func (s *gameServiceImpl) SetClaimRepository(claimRepo repositories.ClaimRepository) {
+	s.claimRepo = claimRepo
+}
+
+func (s *gameServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
 func (s *gameServiceImpl) GetGameByID(ID uuid.UUID) (*models.Game, error) {
 	game, err := s.gameRepo.GetGameByID(ID)
 	if err != nil {
@@ -99,6 +126,24 @@ func (s *gameServiceImpl) ReportGameResult(gameID uuid.UUID, dto *requests.Repor
 	if game.Status != enums.GameStatusScheduled {
 		return types.ErrConflict
 	}
+	// a playoff game waiting on the games feeding it
+	if game.Player1ID == uuid.Nil || game.Player2ID == uuid.Nil {
+		return types.ErrConflict
+	}
+
+	var stats []models.ClaimGameStat
+	if len(dto.BattleLogs) > 0 {
+		result, err := s.readBattleLogs(&game, dto.BattleLogs)
+		if err != nil {
+			return err
+		}
+		if err := prefillReport(dto, result); err != nil {
+			return err
+		}
+		if stats, err = s.claimGameStats(&game, result); err != nil {
+			return err
+		}
+	}
 
 	// Determine loser ID
 	var loserID uuid.UUID
@@ -123,14 +168,182 @@ func (s *gameServiceImpl) ReportGameResult(gameID uuid.UUID, dto *requests.Repor
 		return fmt.Errorf("%w: scores cannot be tied for a reported result", types.ErrInvalidInput)
 	}
 
-	if err := s.gameRepo.UpdateGameReport(gameID, loserID, dto); err != nil {
+	if err := s.gameRepo.UpdateGameReport(gameID, loserID, dto, stats); err != nil {
 		return fmt.Errorf("ReportGameResult: failed to update game report %s: %w", gameID, err)
 	}
 
 	return nil
 }
 
+func (s *gameServiceImpl) ReadBattleLogs(gameID uuid.UUID, battleLogs []string) (*responses.BattleLogResultResponse, error) {
+	game, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("%w: %s", types.ErrInternalService, err.Error())
+	}
+	return s.readBattleLogs(&game, battleLogs)
+}
+
+// readBattleLogs parses the logs of a game's battles and tallies the series. Every log must be a
+// finished battle between the game's two players, matched by Showdown username.
+func (s *gameServiceImpl) readBattleLogs(game *models.Game, battleLogs []string) (*responses.BattleLogResultResponse, error) {
+	if game.Player1 == nil || game.Player1.User == nil || game.Player2 == nil || game.Player2.User == nil {
+		log.Printf("ERROR: (GameService: readBattleLogs) - game %s was fetched without its players' users\n", game.ID)
+		return nil, types.ErrInternalService
+	}
+	player1 := showdown.ToID(game.Player1.User.ShowdownUsername)
+	player2 := showdown.ToID(game.Player2.User.ShowdownUsername)
+
+	result := &responses.BattleLogResultResponse{Battles: make([]responses.BattleLogResponse, 0, len(battleLogs))}
+	for i, battleLog := range battleLogs {
+		battle, err := showdown.Parse([]byte(battleLog))
+		if err != nil {
+			return nil, fmt.Errorf("%w: battle log %d: %w", types.ErrInvalidInput, i+1, err)
+		}
+		sideOf := map[string]*showdown.Side{}
+		for j := range battle.Sides {
+			sideOf[showdown.ToID(battle.Sides[j].Player)] = &battle.Sides[j]
+		}
+		if sideOf[player1] == nil || sideOf[player2] == nil {
+			return nil, fmt.Errorf("%w: battle log %d is not a battle between %s and %s", types.ErrInvalidInput, i+1,
+				game.Player1.User.ShowdownUsername, game.Player2.User.ShowdownUsername)
+		}
+		winner := battle.WinnerSide()
+		if winner == nil {
+			return nil, fmt.Errorf("%w: battle log %d has no winner", types.ErrInvalidInput, i+1)
+		}
+
+		summary := responses.BattleLogResponse{
+			Format:      battle.Format,
+			Turns:       battle.Turns,
+			Player1Team: sideOf[player1].Team,
+			Player2Team: sideOf[player2].Team,
+		}
+		if winner == sideOf[player1] {
+			summary.WinnerID = game.Player1ID
+			result.Player1Wins++
+		} else {
+			summary.WinnerID = game.Player2ID
+			result.Player2Wins++
+		}
+		result.Battles = append(result.Battles, summary)
+	}
+
+	switch {
+	case result.Player1Wins > result.Player2Wins:
+		result.WinnerID = game.Player1ID
+	case result.Player2Wins > result.Player1Wins:
+		result.WinnerID = game.Player2ID
+	default:
+		return nil, fmt.Errorf("%w: the battle logs leave the series tied", types.ErrInvalidInput)
+	}
+	return result, nil
+}
+
+// claimGameStats totals how each Pokémon the players brought did across the game's battles, against the
+// Claim its player held in the game's week, even if it has been released since. That's the game's round
+// for a regular season game, and the league's current week for a playoff game, played after the last one.
+// Pokémon that match none of the player's claims are left out.
+func (s *gameServiceImpl) claimGameStats(game *models.Game, result *responses.BattleLogResultResponse) ([]models.ClaimGameStat, error) {
+	if s.claimRepo == nil {
+		return nil, nil
+	}
+	week := game.RoundNumber
+	if game.GameType != enums.GameTypeRegularSeason {
+		league, err := s.fetchLeagueResource(game.LeagueID)
+		if err != nil {
+			return nil, err
+		}
+		week = league.CurrentWeekNumber
+	}
+	var stats []models.ClaimGameStat
+	for _, player := range []uuid.UUID{game.Player1ID, game.Player2ID} {
+		claims, err := s.claimRepo.GetHeldByPlayerInWeek(player, week)
+		if err != nil {
+			log.Printf("ERROR: (GameService: claimGameStats) - could not fetch claims of member %s: %v\n", player, err)
+			return nil, types.ErrInternalService
+		}
+
+		byClaim := make(map[uuid.UUID]*models.ClaimGameStat)
+		for _, battle := range result.Battles {
+			team := battle.Player1Team
+			if player == game.Player2ID {
+				team = battle.Player2Team
+			}
+			for _, pokemon := range team {
+				if !pokemon.Brought {
+					continue
+				}
+				claim := claimForSpecies(claims, pokemon.Species)
+				if claim == nil {
+					log.Printf("WARN: (GameService: claimGameStats) - %s brought by member %s in game %s is not on their roster\n", pokemon.Species, player, game.ID)
+					continue
+				}
+				stat, ok := byClaim[claim.ID]
+				if !ok {
+					stat = &models.ClaimGameStat{LeagueID: game.LeagueID, GameID: game.ID, ClaimID: claim.ID}
+					byClaim[claim.ID] = stat
+				}
+				stat.BattlesBrought++
+				stat.DirectKOs += pokemon.DirectKOs
+				stat.PassiveKOs += pokemon.PassiveKOs
+				if pokemon.Fainted {
+					stat.Faints++
+				}
+			}
+		}
+		// in roster order, so the rows come out the same way every time
+		for _, claim := range claims {
+			if stat, ok := byClaim[claim.ID]; ok {
+				stats = append(stats, *stat)
+			}
+		}
+	}
+	return stats, nil
+}
+
+// claimForSpecies finds the claim of a Pokémon named as Showdown names it. A claim on a species' base
+// forme also covers its other formes, e.g. "Urshifu" covers "Urshifu-Rapid-Strike".
+func claimForSpecies(claims []models.Claim, species string) *models.Claim {
+	base, _, _ := strings.Cut(species, "-")
+	var baseMatch *models.Claim
+	for i, claim := range claims {
+		if claim.PokemonSpecies == nil {
+			continue
+		}
+		switch showdown.ToID(claim.PokemonSpecies.Name) {
+		case showdown.ToID(species):
+			return &claims[i]
+		case showdown.ToID(base):
+			baseMatch = &claims[i]
+		}
+	}
+	return baseMatch
+}
+
+// prefillReport fills in whatever of the result a report leaves out from its battle logs, and checks
+// whatever it does give against them.
+func prefillReport(dto *requests.ReportGameRequestDTO, result *responses.BattleLogResultResponse) error {
+	if dto.WinnerID == uuid.Nil {
+		dto.WinnerID = result.WinnerID
+	}
+	if dto.Player1Wins == nil {
+		dto.Player1Wins = &result.Player1Wins
+	}
+	if dto.Player2Wins == nil {
+		dto.Player2Wins = &result.Player2Wins
+	}
+	if dto.WinnerID != result.WinnerID || *dto.Player1Wins != result.Player1Wins || *dto.Player2Wins != result.Player2Wins {
+		return fmt.Errorf("%w: the reported result doesn't match the battle logs, which have it %d-%d", types.ErrInvalidInput,
+			result.Player1Wins, result.Player2Wins)
+	}
+	return nil
+}
+
 // FinalizeGameResult allows league staff to approve, submit, or retroactively edit a game result.
+// Finalizing a playoff game advances the league's bracket.
 func (s *gameServiceImpl) FinalizeGameResult(gameID uuid.UUID, dto *requests.FinalizeGameRequestDTO) error {
 	// Fetch game to determine loser ID
 	game, err := s.gameRepo.GetGameByID(gameID)
@@ -141,7 +354,11 @@ func (s *gameServiceImpl) FinalizeGameResult(gameID uuid.UUID, dto *requests.Fin
 		return fmt.Errorf("%w: %s", types.ErrInternalService, err.Error())
 	}
 
-	if !(game.Status == enums.GameStatusApprovalPending || game.Status == enums.GameStatusDisputed) {
+	if game.Status == enums.GameStatusScheduled {
+		return types.ErrConflict
+	}
+	// a playoff game waiting on the games feeding it, or a bye
+	if game.Player1ID == uuid.Nil || game.Player2ID == uuid.Nil {
 		return types.ErrConflict
 	}
 
@@ -165,14 +382,153 @@ func (s *gameServiceImpl) FinalizeGameResult(gameID uuid.UUID, dto *requests.Fin
 
 	// RBAC Check is handled in controller, service layer proceeds with business logic
 
-	err = s.gameRepo.FinalizeGameAndUpdateStats(&game, loserID, dto)
+	winnerID := dto.WinnerID
+	ruling := &models.GameReport{
+		GameID:      gameID,
+		MemberID:    dto.FinalizerID,
+		Action:      enums.GameReportActionRuling,
+		WinnerID:    &winnerID,
+		Player1Wins: dto.Player1Wins,
+		Player2Wins: dto.Player2Wins,
+		ReplayLinks: dto.ReplayLinks,
+		Reason:      dto.Reason,
+	}
+	if game.GameType != enums.GameTypeRegularSeason {
+		if err := s.finalizePlayoffGame(&game, loserID, dto, ruling); err != nil {
+			return fmt.Errorf("FinalizeGameResult: failed to finalize playoff game %s: %w", gameID, err)
+		}
+		return nil
+	}
+	err = s.gameRepo.FinalizeGameAndUpdateStats(&game, loserID, dto, ruling)
 	if err != nil {
 		return fmt.Errorf("FinalizeGameResult: failed to finalize game and update stats for game %s: %w", gameID, err)
 	}
+	return nil
+}
+
+// ConfirmGameResult lets the reporter's opponent accept the reported result. Staff still approve it
+// with FinalizeGameResult unless the league's format has AutoFinalizeConfirmedGames.
+func (s *gameServiceImpl) ConfirmGameResult(gameID uuid.UUID, memberID uuid.UUID) (bool, error) {
+	game, err := s.getReportedGameForOpponent(gameID, memberID)
+	if err != nil {
+		return false, err
+	}
+	confirmation := &models.GameReport{
+		GameID:   gameID,
+		MemberID: memberID,
+		Action:   enums.GameReportActionConfirm,
+	}
+	league, err := s.fetchLeagueResource(game.LeagueID)
+	if err != nil {
+		return false, err
+	}
+	if league.Format == nil || !league.Format.AutoFinalizeConfirmedGames {
+		if err := s.gameRepo.AddGameReport(confirmation); err != nil {
+			return false, fmt.Errorf("%w: failed to record confirmation of game %s: %w", types.ErrInternalService, gameID, err)
+		}
+		return false, nil
+	}
+
+	// both players agree, so the reported result stands as it is
+	if game.WinnerID == nil || game.LoserID == nil {
+		return false, fmt.Errorf("%w: game %s has no reported winner", types.ErrInternalService, gameID)
+	}
+	player1Wins, player2Wins := game.Player1Wins, game.Player2Wins
+	dto := &requests.FinalizeGameRequestDTO{
+		FinalizerID: memberID,
+		WinnerID:    *game.WinnerID,
+		Player1Wins: &player1Wins,
+		Player2Wins: &player2Wins,
+		ReplayLinks: game.ShowdownReplayLinks,
+	}
+	if game.GameType != enums.GameTypeRegularSeason {
+		if err := s.finalizePlayoffGame(game, *game.LoserID, dto, confirmation); err != nil {
+			return false, fmt.Errorf("failed to finalize confirmed playoff game %s: %w", gameID, err)
+		}
+		return true, nil
+	}
+	if err := s.gameRepo.FinalizeGameAndUpdateStats(game, *game.LoserID, dto, confirmation); err != nil {
+		return false, fmt.Errorf("%w: failed to finalize confirmed game %s: %w", types.ErrInternalService, gameID, err)
+	}
+	return true, nil
+}
+
+// DisputeGameResult lets the reporter's opponent reject the reported result, optionally reporting
+// what they say happened instead. The game waits in the league's dispute queue for a staff ruling.
+func (s *gameServiceImpl) DisputeGameResult(gameID uuid.UUID, dto *requests.DisputeGameRequestDTO) error {
+	game, err := s.getReportedGameForOpponent(gameID, dto.DisputerID)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(dto.Reason) == "" {
+		return fmt.Errorf("%w: a dispute needs a reason", types.ErrInvalidInput)
+	}
 
+	reason := dto.Reason
+	dispute := &models.GameReport{
+		GameID:      gameID,
+		MemberID:    dto.DisputerID,
+		Action:      enums.GameReportActionDispute,
+		ReplayLinks: dto.ReplayLinks,
+		Reason:      &reason,
+	}
+	if dto.WinnerID != nil || dto.Player1Wins != nil || dto.Player2Wins != nil {
+		if dto.WinnerID == nil || dto.Player1Wins == nil || dto.Player2Wins == nil {
+			return fmt.Errorf("%w: a counter-report needs the winner and both players' wins", types.ErrInvalidInput)
+		}
+		if *dto.WinnerID != game.Player1ID && *dto.WinnerID != game.Player2ID {
+			return fmt.Errorf("%w: the winner must be one of the players in the game", types.ErrInvalidInput)
+		}
+		if *dto.Player1Wins == *dto.Player2Wins {
+			return fmt.Errorf("%w: scores cannot be tied for a counter-report", types.ErrInvalidInput)
+		}
+		dispute.WinnerID = dto.WinnerID
+		dispute.Player1Wins = dto.Player1Wins
+		dispute.Player2Wins = dto.Player2Wins
+	}
+
+	if err := s.gameRepo.DisputeGame(dispute); err != nil {
+		return fmt.Errorf("%w: failed to dispute game %s: %w", types.ErrInternalService, gameID, err)
+	}
 	return nil
 }
 
+func (s *gameServiceImpl) GetDisputedGames(leagueID uuid.UUID) ([]models.Game, error) {
+	games, err := s.gameRepo.GetDisputedGamesByLeague(leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrInternalService, err)
+	}
+	return games, nil
+}
+
+// getReportedGameForOpponent fetches a game awaiting approval that memberID played in but did not
+// report, and has not yet confirmed.
+func (s *gameServiceImpl) getReportedGameForOpponent(gameID uuid.UUID, memberID uuid.UUID) (*models.Game, error) {
+	game, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("%w: %s", types.ErrInternalService, err.Error())
+	}
+	if game.Status != enums.GameStatusApprovalPending {
+		return nil, fmt.Errorf("%w: the game has no result awaiting approval", types.ErrConflict)
+	}
+	if memberID != game.Player1ID && memberID != game.Player2ID {
+		return nil, fmt.Errorf("%w: only the players of the game can confirm or dispute its result", types.ErrUnauthorized)
+	}
+	if game.ReportingPlayerID != nil && *game.ReportingPlayerID == memberID {
+		return nil, fmt.Errorf("%w: the result must be confirmed or disputed by the opponent of whoever reported it", types.ErrUnauthorized)
+	}
+	// a dispute takes the game out of APPROVAL_PENDING, so only a confirmation can have answered it already
+	for _, report := range game.Reports {
+		if report.Action == enums.GameReportActionConfirm && report.MemberID == memberID {
+			return nil, fmt.Errorf("%w: the result is already confirmed", types.ErrConflict)
+		}
+	}
+	return &game, nil
+}
+
 // GenerateRegularSeasonGames generates all the games of the regular season for every week assigning the correct RoundNumbers.
 // For GroupCounts > 1 (only 1 or 2 is allowed), players are assigned opponents within their group.
 func (s *gameServiceImpl) GenerateRegularSeasonGames(leagueID uuid.UUID) error {
@@ -260,6 +616,14 @@ func (s *gameServiceImpl) GeneratePlayoffBracket(leagueID uuid.UUID) error {
 		membersByGroup[i] = membersOfGroupX
 	}
 
+	// checked before seeding, so an invalid configuration fails without ranking the standings first
+	if league.Format.PlayoffType == enums.LeaguePlayoffTypeSingleElim && league.Format.PlayoffSeedingType == enums.LeaguePlayoffSeedingTypeFullySeeded {
+		return fmt.Errorf("%w: %s and %s are incompatible playoff options",
+			types.ErrInvalidLeagueConfiguration,
+			enums.LeaguePlayoffTypeSingleElim,
+			enums.LeaguePlayoffSeedingTypeFullySeeded)
+	}
+
 	seededMembers, err := s.getSeededPlayers(league, membersByGroup)
 	if err != nil {
 		log.Printf("ERROR: (Service: GeneratePlayoffBracket): error seeding members for playoffs for league %s: %v", league.ID, err)
@@ -268,12 +632,6 @@ func (s *gameServiceImpl) GeneratePlayoffBracket(leagueID uuid.UUID) error {
 
 	var generatedGames []*models.Game
 	if league.Format.PlayoffType == enums.LeaguePlayoffTypeSingleElim {
-		if league.Format.PlayoffSeedingType == enums.LeaguePlayoffSeedingTypeFullySeeded {
-			return fmt.Errorf("%w: %s and %s are incompatible playoff options",
-				types.ErrInvalidLeagueConfiguration,
-				enums.LeaguePlayoffTypeSingleElim,
-				enums.LeaguePlayoffSeedingTypeFullySeeded)
-		}
 		generatedGames, err = s.generateSingleEliminationBracket(league, seededMembers)
 		if err != nil {
 			log.Printf("ERROR: (Service: GeneratePlayoffBracket) - Error generating single elimination bracket for league %s: %v\n", leagueID, err)
@@ -809,7 +1167,8 @@ func isPowerOfTwo(N int) bool {
 }
 
 // getSeededPlayers prepares a list of players for playoff bracket generation.
-// It first sorts players within their respective groups. Then, it selects
+// It first ranks players within their respective groups, as in the standings (StandingsService),
+// breaking ties with the league's tiebreak chain. Then, it selects
 // a qualifying number from each group and interleaves them to determine
 // their overall seeding for the playoffs (e.g., 1st from Group A, 1st from Group B,
 // 2nd from Group A, 2nd from Group B, etc.).
@@ -819,12 +1178,20 @@ func (s *gameServiceImpl) getSeededPlayers(league *models.League, membersByGroup
 
 	numMembersToQualifyPerGroup := league.Format.PlayoffParticipantCount / league.Format.GroupCount
 
+	ranker := newStandingsRanker(league, s.gameRepo)
 	for i := range membersByGroup {
 		if len(membersByGroup[i]) == 0 {
 			log.Printf("INFO: (Service: getSeededPlayers) - Encountered an empty member group %d for league %s. Skipping group.\n", i+1, league.ID)
 			continue
 		}
-		sortMembers(membersByGroup[i])
+		ranked, err := ranker.rank(membersByGroup[i])
+		if err != nil {
+			log.Printf("ERROR: (Service: getSeededPlayers) - Could not rank group %d of league %s: %v\n", i+1, league.ID, err)
+			return nil, err
+		}
+		for rank, st := range ranked {
+			membersByGroup[i][rank] = st.member
+		}
 	}
 
 	for rank := range numMembersToQualifyPerGroup {
@@ -1,17 +1,23 @@
 package services_test
 
 import (
+	"fmt"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
 	mock_repos "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
 )
 
 func TestGameService_GeneratePlayoffBracket_Correct(t *testing.T) {
@@ -229,3 +235,438 @@ func TestGameService_GeneratePlayoffBracket_ErrInvalidConfig(t *testing.T) {
 	mockLeagueMemberRepo.AssertExpectations(t)
 	mockGameRepo.AssertNotCalled(t, "CreateGames")
 }
+
+func TestGameService_ConfirmAndDisputeGameResult(t *testing.T) {
+	leagueID := uuid.New()
+	reporterID, opponentID := uuid.New(), uuid.New()
+	reportedGame := func() models.Game {
+		return models.Game{
+			ID:                uuid.New(),
+			LeagueID:          leagueID,
+			Player1ID:         reporterID,
+			Player2ID:         opponentID,
+			WinnerID:          &reporterID,
+			LoserID:           &opponentID,
+			Player1Wins:       2,
+			Player2Wins:       1,
+			GameType:          enums.GameTypeRegularSeason,
+			Status:            enums.GameStatusApprovalPending,
+			ReportingPlayerID: &reporterID,
+			Reports:           []models.GameReport{{MemberID: reporterID, Action: enums.GameReportActionReport}},
+		}
+	}
+	setup := func(game models.Game, autoFinalize bool) (services.GameService, *mock_repos.MockGameRepository) {
+		mockGameRepo := new(mock_repos.MockGameRepository)
+		mockLeagueRepo := new(mock_repos.MockLeagueRepository)
+		mockGameRepo.On("GetGameByID", game.ID).Return(game, nil)
+		mockLeagueRepo.On("GetLeagueByID", leagueID).Return(&models.League{
+			ID:     leagueID,
+			Format: &types.LeagueFormat{AutoFinalizeConfirmedGames: autoFinalize},
+		}, nil)
+		return services.NewGameService(mockGameRepo, mockLeagueRepo, new(mock_repos.MockLeagueMemberRepository)), mockGameRepo
+	}
+
+	t.Run("A confirmation waits for staff unless the league auto-finalizes", func(t *testing.T) {
+		game := reportedGame()
+		gameService, mockGameRepo := setup(game, false)
+		mockGameRepo.On("AddGameReport", mock.MatchedBy(func(r *models.GameReport) bool {
+			return r.GameID == game.ID && r.MemberID == opponentID && r.Action == enums.GameReportActionConfirm
+		})).Return(nil).Once()
+
+		finalized, err := gameService.ConfirmGameResult(game.ID, opponentID)
+
+		assert.NoError(t, err)
+		assert.False(t, finalized)
+		mockGameRepo.AssertExpectations(t)
+		mockGameRepo.AssertNotCalled(t, "FinalizeGameAndUpdateStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("A confirmation finalizes the reported result in an auto-finalizing league", func(t *testing.T) {
+		game := reportedGame()
+		gameService, mockGameRepo := setup(game, true)
+		mockGameRepo.On("FinalizeGameAndUpdateStats", mock.AnythingOfType("*models.Game"), opponentID,
+			mock.MatchedBy(func(dto *requests.FinalizeGameRequestDTO) bool {
+				return dto.FinalizerID == opponentID && dto.WinnerID == reporterID && *dto.Player1Wins == 2 && *dto.Player2Wins == 1
+			}),
+			mock.MatchedBy(func(r *models.GameReport) bool { return r.Action == enums.GameReportActionConfirm }),
+		).Return(nil).Once()
+
+		finalized, err := gameService.ConfirmGameResult(game.ID, opponentID)
+
+		assert.NoError(t, err)
+		assert.True(t, finalized)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("A confirmation racing another answer to the report is a conflict", func(t *testing.T) {
+		game := reportedGame()
+		gameService, mockGameRepo := setup(game, true)
+		mockGameRepo.On("FinalizeGameAndUpdateStats", mock.Anything, opponentID, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("game %s was changed by someone else: %w", game.ID, types.ErrConflict)).Once()
+
+		finalized, err := gameService.ConfirmGameResult(game.ID, opponentID)
+
+		assert.ErrorIs(t, err, types.ErrConflict)
+		assert.False(t, finalized)
+	})
+
+	t.Run("Only the reporter's opponent can answer a report, and only once", func(t *testing.T) {
+		game := reportedGame()
+		game.Reports = append(game.Reports, models.GameReport{MemberID: opponentID, Action: enums.GameReportActionConfirm})
+		gameService, _ := setup(game, false)
+
+		_, err := gameService.ConfirmGameResult(game.ID, reporterID)
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		_, err = gameService.ConfirmGameResult(game.ID, uuid.New())
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		err = gameService.DisputeGameResult(game.ID, &requests.DisputeGameRequestDTO{DisputerID: opponentID, Reason: "changed my mind"})
+		assert.ErrorIs(t, err, types.ErrConflict)
+	})
+
+	t.Run("A dispute records the counter-report", func(t *testing.T) {
+		game := reportedGame()
+		gameService, mockGameRepo := setup(game, false)
+		one, two := 1, 2
+		mockGameRepo.On("DisputeGame", mock.MatchedBy(func(r *models.GameReport) bool {
+			return r.GameID == game.ID && r.MemberID == opponentID && r.Action == enums.GameReportActionDispute &&
+				*r.Reason == "I won game 3" && *r.WinnerID == opponentID && *r.Player2Wins == 2
+		})).Return(nil).Once()
+
+		err := gameService.DisputeGameResult(game.ID, &requests.DisputeGameRequestDTO{
+			DisputerID: opponentID, Reason: "I won game 3", WinnerID: &opponentID, Player1Wins: &one, Player2Wins: &two,
+		})
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("A counter-report must be complete", func(t *testing.T) {
+		game := reportedGame()
+		gameService, mockGameRepo := setup(game, false)
+
+		err := gameService.DisputeGameResult(game.ID, &requests.DisputeGameRequestDTO{
+			DisputerID: opponentID, Reason: "I won", WinnerID: &opponentID,
+		})
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+		mockGameRepo.AssertNotCalled(t, "DisputeGame", mock.Anything)
+	})
+
+	t.Run("A staff ruling settles a disputed game and is kept in its history", func(t *testing.T) {
+		game := reportedGame()
+		game.Status = enums.GameStatusDisputed
+		gameService, mockGameRepo := setup(game, false)
+		staffID := uuid.New()
+		one, two, note := 1, 2, "replay shows Player 2 won game 3"
+		mockGameRepo.On("FinalizeGameAndUpdateStats", mock.AnythingOfType("*models.Game"), reporterID, mock.Anything,
+			mock.MatchedBy(func(r *models.GameReport) bool {
+				return r.Action == enums.GameReportActionRuling && r.MemberID == staffID && *r.WinnerID == opponentID && *r.Reason == note
+			}),
+		).Return(nil).Once()
+
+		err := gameService.FinalizeGameResult(game.ID, &requests.FinalizeGameRequestDTO{
+			FinalizerID: staffID, WinnerID: opponentID, Player1Wins: &one, Player2Wins: &two, Reason: &note,
+		})
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+}
+
+func TestGameService_ReportGameResult_BattleLogs(t *testing.T) {
+	readFixture := func(name string) string {
+		data, err := os.ReadFile("../showdown/testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	ashWins, garyWins := readFixture("gen9ou.log"), readFixture("gen9ou-replay.html")
+	ash := &models.LeagueMember{ID: uuid.New(), User: &models.User{ShowdownUsername: "ash ketchum"}}
+	gary := &models.LeagueMember{ID: uuid.New(), User: &models.User{ShowdownUsername: "GaryOak"}}
+	setup := func() (services.GameService, *mock_repos.MockGameRepository, models.Game) {
+		game := models.Game{
+			ID:          uuid.New(),
+			Player1ID:   ash.ID,
+			Player2ID:   gary.ID,
+			Player1:     ash,
+			Player2:     gary,
+			Status:      enums.GameStatusScheduled,
+			GameType:    enums.GameTypeRegularSeason,
+			RoundNumber: 3,
+		}
+		mockGameRepo := new(mock_repos.MockGameRepository)
+		mockGameRepo.On("GetGameByID", game.ID).Return(game, nil)
+		return services.NewGameService(mockGameRepo, new(mock_repos.MockLeagueRepository), new(mock_repos.MockLeagueMemberRepository)), mockGameRepo, game
+	}
+
+	t.Run("The logs fill in the result", func(t *testing.T) {
+		gameService, mockGameRepo, game := setup()
+		mockGameRepo.On("UpdateGameReport", game.ID, gary.ID, mock.MatchedBy(func(dto *requests.ReportGameRequestDTO) bool {
+			return dto.WinnerID == ash.ID && *dto.Player1Wins == 2 && *dto.Player2Wins == 1
+		}), []models.ClaimGameStat(nil)).Return(nil).Once()
+
+		err := gameService.ReportGameResult(game.ID, &requests.ReportGameRequestDTO{
+			ReporterID: gary.ID,
+			BattleLogs: []string{ashWins, garyWins, ashWins},
+		})
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("The Pokémon brought are credited to the claims held at the time", func(t *testing.T) {
+		gameService, mockGameRepo, game := setup()
+		claimRepo := new(mock_repos.MockClaimRepository)
+		gameService.SetClaimRepository(claimRepo)
+		claim := func(name string) models.Claim {
+			return models.Claim{ID: uuid.New(), PokemonSpecies: &models.PokemonSpecies{Name: name}}
+		}
+		urshifu, tusk := claim("Urshifu"), claim("Great Tusk")
+		ferrothorn := claim("Ferrothorn")
+		// the claims held in the game's week, including any released since
+		claimRepo.On("GetHeldByPlayerInWeek", ash.ID, 3).Return([]models.Claim{urshifu, claim("Kingambit"), tusk}, nil)
+		claimRepo.On("GetHeldByPlayerInWeek", gary.ID, 3).Return([]models.Claim{ferrothorn}, nil)
+		var stats []models.ClaimGameStat
+		mockGameRepo.On("UpdateGameReport", game.ID, gary.ID, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stats = args.Get(3).([]models.ClaimGameStat) }).
+			Return(nil).Once()
+
+		err := gameService.ReportGameResult(game.ID, &requests.ReportGameRequestDTO{
+			ReporterID: ash.ID,
+			BattleLogs: []string{ashWins, ashWins},
+		})
+
+		assert.NoError(t, err)
+		// roster order; the base forme claim covers Urshifu-Rapid-Strike
+		assert.Len(t, stats, 4)
+		assert.Equal(t, urshifu.ID, stats[0].ClaimID)
+		assert.Equal(t, models.ClaimGameStat{GameID: game.ID, ClaimID: urshifu.ID, BattlesBrought: 2, DirectKOs: 2, Faints: 2}, stats[0])
+		assert.Equal(t, tusk.ID, stats[2].ClaimID)
+		assert.Equal(t, models.ClaimGameStat{GameID: game.ID, ClaimID: ferrothorn.ID, BattlesBrought: 2, PassiveKOs: 2, Faints: 2}, stats[3])
+	})
+
+	t.Run("A playoff game credits the claims held in the league's current week", func(t *testing.T) {
+		_, _, game := setup()
+		game.LeagueID, game.GameType, game.RoundNumber = uuid.New(), enums.GameTypePlayoffSingleElim, 1
+		mockGameRepo, leagueRepo := new(mock_repos.MockGameRepository), new(mock_repos.MockLeagueRepository)
+		mockGameRepo.On("GetGameByID", game.ID).Return(game, nil)
+		leagueRepo.On("GetLeagueByID", game.LeagueID).Return(&models.League{ID: game.LeagueID, CurrentWeekNumber: 9}, nil)
+		claimRepo := new(mock_repos.MockClaimRepository)
+		claimRepo.On("GetHeldByPlayerInWeek", mock.Anything, 9).Return([]models.Claim{}, nil).Twice()
+		mockGameRepo.On("UpdateGameReport", game.ID, gary.ID, mock.Anything, mock.Anything).Return(nil).Once()
+		gameService := services.NewGameService(mockGameRepo, leagueRepo, new(mock_repos.MockLeagueMemberRepository))
+		gameService.SetClaimRepository(claimRepo)
+
+		err := gameService.ReportGameResult(game.ID, &requests.ReportGameRequestDTO{
+			ReporterID: ash.ID,
+			BattleLogs: []string{ashWins, ashWins},
+		})
+
+		assert.NoError(t, err)
+		claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("A result the logs contradict is rejected", func(t *testing.T) {
+		gameService, mockGameRepo, game := setup()
+
+		err := gameService.ReportGameResult(game.ID, &requests.ReportGameRequestDTO{
+			ReporterID: gary.ID,
+			WinnerID:   gary.ID,
+			BattleLogs: []string{ashWins},
+		})
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+		mockGameRepo.AssertNotCalled(t, "UpdateGameReport", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Logs of someone else's battle or an undecided series are rejected", func(t *testing.T) {
+		gameService, _, game := setup()
+		ash.User.ShowdownUsername = "Red"
+		_, err := gameService.ReadBattleLogs(game.ID, []string{ashWins})
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+		ash.User.ShowdownUsername = "ash ketchum"
+
+		_, err = gameService.ReadBattleLogs(game.ID, []string{ashWins, garyWins})
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+
+	t.Run("Reading the logs matches each battle's sides to the game's players", func(t *testing.T) {
+		gameService, _, game := setup()
+
+		result, err := gameService.ReadBattleLogs(game.ID, []string{garyWins, ashWins, ashWins})
+
+		assert.NoError(t, err)
+		assert.Equal(t, ash.ID, result.WinnerID)
+		assert.Equal(t, gary.ID, result.Battles[0].WinnerID)
+		// Gary was p1 in the replay, but his team is still reported as player 2's
+		assert.Equal(t, "Heatran", result.Battles[0].Player2Team[5].Species)
+		assert.Equal(t, 1, result.Battles[0].Player2Team[5].DirectKOs)
+		assert.Equal(t, 1, result.Battles[0].Player2Team[5].PassiveKOs)
+	})
+}
+
+func TestGameService_FinalizeGameResult_AdvancesBracket(t *testing.T) {
+	leagueID := uuid.New()
+	staffID := uuid.New()
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	position := func(s string) *string { return &s }
+	played := func(game models.Game, winner, loser uuid.UUID) models.Game {
+		game.Status = enums.GameStatusCompleted
+		game.WinnerID, game.LoserID = &winner, &loser
+		if winner == game.Player1ID {
+			game.Player1Wins = 2
+		} else {
+			game.Player2Wins = 2
+		}
+		return game
+	}
+	// reported is the game before it is finalized; bracket is every playoff game after
+	// update is what the replay last asked FinalizeGameAndUpdateBracket to save, and saved, voided and removed its parts
+	var update *repositories.BracketUpdate
+	var saved []*models.Game
+	var voided []models.Game
+	var removed []uuid.UUID
+	setup := func(league *models.League, reported models.Game, bracket []models.Game) services.GameService {
+		update, saved, voided, removed = nil, nil, nil, nil
+		gameRepo := new(mock_repos.MockGameRepository)
+		gameRepo.On("GetGameByID", reported.ID).Return(reported, nil)
+		gameRepo.On("FinalizeGameAndUpdateBracket", mock.MatchedBy(func(game *models.Game) bool { return game.ID == reported.ID }),
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			replay := args.Get(4).(func(*models.League, []models.Game) (*repositories.BracketUpdate, error))
+			var err error
+			update, err = replay(league, bracket)
+			assert.NoError(t, err)
+			if update != nil {
+				saved, voided, removed = update.Games, update.Voided, update.Removed
+			}
+		}).Return(nil).Once()
+		return services.NewGameService(gameRepo, new(mock_repos.MockLeagueRepository), new(mock_repos.MockLeagueMemberRepository))
+	}
+	finalize := func(service services.GameService, game models.Game) error {
+		return service.FinalizeGameResult(game.ID, &requests.FinalizeGameRequestDTO{
+			FinalizerID: staffID, WinnerID: *game.WinnerID, Player1Wins: &game.Player1Wins, Player2Wins: &game.Player2Wins,
+		})
+	}
+	savedGame := func(id uuid.UUID) *models.Game {
+		for _, game := range saved {
+			if game.ID == id {
+				return game
+			}
+		}
+		return nil
+	}
+
+	t.Run("The winner and loser move on, and a game left with one player is a bye", func(t *testing.T) {
+		league := &models.League{ID: leagueID, Status: enums.LeagueStatusPlayoffs, Format: &types.LeagueFormat{PlayoffType: enums.LeaguePlayoffTypeDoubleElim}}
+		ub1 := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: a, Player2ID: b, RoundNumber: 1, GameType: enums.GameTypePlayoffUpper, Status: enums.GameStatusApprovalPending, BracketPosition: position("Upper Round 1: Game 1")}
+		ub2 := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: c, RoundNumber: 2, GameType: enums.GameTypePlayoffUpper, Status: enums.GameStatusScheduled, BracketPosition: position("Upper Round 2: Game 1")}
+		lb1 := models.Game{ID: uuid.New(), LeagueID: leagueID, RoundNumber: 1, GameType: enums.GameTypePlayoffLower, Status: enums.GameStatusScheduled, BracketPosition: position("Lower Round 1: Game 1")}
+		lb2 := models.Game{ID: uuid.New(), LeagueID: leagueID, RoundNumber: 2, GameType: enums.GameTypePlayoffLower, Status: enums.GameStatusScheduled, BracketPosition: position("Lower Round 2: Game 1")}
+		ub1.WinnerToGameID, ub1.LoserToGameID = ub2.ID, lb1.ID
+		ub2.LoserToGameID = lb2.ID
+		lb1.WinnerToGameID = lb2.ID
+		reported := played(ub1, a, b)
+		reported.Status = enums.GameStatusApprovalPending
+		finalized := played(ub1, a, b)
+		service := setup(league, reported, []models.Game{finalized, lb1, ub2, lb2})
+
+		err := finalize(service, finalized)
+
+		assert.NoError(t, err)
+		assert.Len(t, saved, 3)
+		assert.Equal(t, [2]uuid.UUID{c, a}, [2]uuid.UUID{savedGame(ub2.ID).Player1ID, savedGame(ub2.ID).Player2ID})
+		// nobody else comes to lower round 1, so b goes straight through
+		assert.Equal(t, enums.GameStatusCompleted, savedGame(lb1.ID).Status)
+		assert.Equal(t, b, *savedGame(lb1.ID).WinnerID)
+		assert.Nil(t, savedGame(lb1.ID).LoserID)
+		// the upper bracket's loser is still to come
+		assert.Equal(t, [2]uuid.UUID{uuid.Nil, b}, [2]uuid.UUID{savedGame(lb2.ID).Player1ID, savedGame(lb2.ID).Player2ID})
+		assert.Equal(t, enums.GameStatusScheduled, savedGame(lb2.ID).Status)
+		assert.Empty(t, voided)
+	})
+
+	t.Run("Re-finalizing a game redoes the games downstream and reopens a completed league", func(t *testing.T) {
+		league := &models.League{ID: leagueID, Status: enums.LeagueStatusCompleted, Format: &types.LeagueFormat{PlayoffType: enums.LeaguePlayoffTypeSingleElim}}
+		semi1 := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: a, Player2ID: d, RoundNumber: 1, GameType: enums.GameTypePlayoffSingleElim, BracketPosition: position("Round 1: Game 1")}
+		semi2 := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: b, Player2ID: c, RoundNumber: 1, GameType: enums.GameTypePlayoffSingleElim, BracketPosition: position("Round 1: Game 2")}
+		final := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: a, Player2ID: b, RoundNumber: 2, GameType: enums.GameTypePlayoffGrandFinal, BracketPosition: position("Grand Final")}
+		semi1.WinnerToGameID, semi2.WinnerToGameID = final.ID, final.ID
+		service := setup(league, played(semi1, a, d),
+			[]models.Game{played(semi1, d, a), played(semi2, b, c), played(final, a, b)})
+		err := finalize(service, played(semi1, d, a))
+
+		assert.NoError(t, err)
+		assert.Len(t, saved, 1)
+		assert.Equal(t, [2]uuid.UUID{d, b}, [2]uuid.UUID{saved[0].Player1ID, saved[0].Player2ID})
+		assert.Equal(t, enums.GameStatusScheduled, saved[0].Status)
+		assert.Nil(t, saved[0].WinnerID)
+		// a's win in the final comes off the records
+		assert.Len(t, voided, 1)
+		assert.Equal(t, a, *voided[0].WinnerID)
+		assert.Equal(t, enums.LeagueStatusPlayoffs, update.LeagueStatus)
+		assert.Nil(t, update.EndDate)
+	})
+
+	upperFinal := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: a, Player2ID: b, RoundNumber: 2, GameType: enums.GameTypePlayoffUpper, BracketPosition: position("Upper Final")}
+	lowerFinal := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: b, Player2ID: c, RoundNumber: 3, GameType: enums.GameTypePlayoffLower, BracketPosition: position("Lower Final")}
+	grandFinal := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: a, Player2ID: c, RoundNumber: 3, GameType: enums.GameTypePlayoffGrandFinal, Status: enums.GameStatusApprovalPending, BracketPosition: position("Grand Final")}
+	upperFinal.WinnerToGameID, lowerFinal.WinnerToGameID = grandFinal.ID, grandFinal.ID
+	doubleElim := func(status enums.LeagueStatus) *models.League {
+		return &models.League{ID: leagueID, Status: status, Format: &types.LeagueFormat{PlayoffType: enums.LeaguePlayoffTypeDoubleElim}}
+	}
+
+	t.Run("The lower bracket's winner taking the grand final forces a reset", func(t *testing.T) {
+		finalized := played(grandFinal, c, a)
+		service := setup(doubleElim(enums.LeagueStatusPlayoffs), grandFinal,
+			[]models.Game{played(upperFinal, a, b), played(lowerFinal, c, b), finalized})
+
+		err := finalize(service, finalized)
+
+		assert.NoError(t, err)
+		assert.Len(t, saved, 2)
+		reset := saved[1]
+		assert.Equal(t, "Grand Final Reset", *reset.BracketPosition)
+		assert.Equal(t, enums.GameTypePlayoffGrandFinal, reset.GameType)
+		assert.Equal(t, [2]uuid.UUID{c, a}, [2]uuid.UUID{reset.Player1ID, reset.Player2ID})
+		assert.Equal(t, reset.ID, saved[0].WinnerToGameID)
+		assert.Equal(t, reset.ID, saved[0].LoserToGameID)
+		// the season isn't over until the reset is played
+		assert.Empty(t, update.LeagueStatus)
+	})
+
+	t.Run("The upper bracket's winner taking the grand final ends the season", func(t *testing.T) {
+		finalized := played(grandFinal, a, c)
+		league := doubleElim(enums.LeagueStatusPlayoffs)
+		service := setup(league, grandFinal,
+			[]models.Game{played(upperFinal, a, b), played(lowerFinal, c, b), finalized})
+		clock := utils.NewVirtualClock()
+		service.SetClock(clock)
+		endedBy := clock.AdvanceTo(time.Now().Add(30 * 24 * time.Hour))
+
+		err := finalize(service, finalized)
+
+		assert.NoError(t, err)
+		assert.Empty(t, saved)
+		assert.Equal(t, enums.LeagueStatusCompleted, update.LeagueStatus)
+		assert.False(t, update.EndDate.Before(endedBy))
+	})
+
+	t.Run("A reset the grand final no longer needs is removed", func(t *testing.T) {
+		reset := models.Game{ID: uuid.New(), LeagueID: leagueID, Player1ID: c, Player2ID: a, RoundNumber: 4, GameType: enums.GameTypePlayoffGrandFinal, BracketPosition: position("Grand Final Reset")}
+		withReset := grandFinal
+		withReset.WinnerToGameID, withReset.LoserToGameID = reset.ID, reset.ID
+		finalized := played(withReset, a, c)
+		service := setup(doubleElim(enums.LeagueStatusCompleted), played(withReset, c, a),
+			[]models.Game{played(upperFinal, a, b), played(lowerFinal, c, b), finalized, played(reset, c, a)})
+
+		err := finalize(service, finalized)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{reset.ID}, removed)
+		assert.Len(t, voided, 1)
+		assert.Equal(t, reset.ID, voided[0].ID)
+		assert.Len(t, saved, 1)
+		assert.Equal(t, uuid.Nil, saved[0].WinnerToGameID)
+	})
+}
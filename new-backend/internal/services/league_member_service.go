@@ -175,7 +175,7 @@ func (s *leagueMemberServiceImpl) Create(currentUser *models.User, input *reques
 		Losses:       0,
 		DraftPosition: 0,
 		GroupNumber:   league.NewPlayerGroupNumber,
-		SkipsLeft:     league.MaxPokemonPerPlayer - league.MinPokemonPerPlayer,
+		SkipsLeft:     league.Format.StartingSkipsLeft(league.MinPokemonPerPlayer, league.MaxPokemonPerPlayer),
 		Role:          rbac.MRoleMember,
 	}
 
@@ -3,6 +3,7 @@ package services
 import (
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
@@ -33,6 +34,7 @@ type LeagueService interface {
 	SetSchedulerService(schedulerService SchedulerService)
 	SetGameService(gameService GameService)
 	SetTransferService(transferService TransferService)
+	SetClock(clock utils.Clock)
 }
 
 type leagueServiceImpl struct {
@@ -43,6 +45,7 @@ type leagueServiceImpl struct {
 	schedulerService SchedulerService
 	transferService  TransferService
 	gameService      GameService
+	clock            utils.Clock
 }
 
 func NewLeagueService(
@@ -56,6 +59,7 @@ func NewLeagueService(
 		memberRepo: memberRepo,
 		draftRepo:  draftRepo,
 		gameRepo:   gameRepo,
+		clock:      utils.SystemClock,
 	}
 }
 
@@ -71,6 +75,11 @@ func (s *leagueServiceImpl) SetTransferService(transferService TransferService)
 	s.transferService = transferService
 }
 
+// SetClock replaces the wall clock that season dates and the current week are read from.
+func (s *leagueServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
 // handles the business logic for creating a new league.
 func (s *leagueServiceImpl) CreateLeague(userID uuid.UUID, input *requests.LeagueCreateRequestDTO) (*models.League, error) {
 	const maxLeaguesCommisionable = 2
@@ -95,6 +104,25 @@ func (s *leagueServiceImpl) CreateLeague(userID uuid.UUID, input *requests.Leagu
 		return nil, fmt.Errorf("%w: TransferWindowFrequencyDays must be a multiple of 7", types.ErrInvalidLeagueConfiguration)
 	}
 
+	for i, tiebreaker := range input.Format.Tiebreakers {
+		input.Format.Tiebreakers[i] = tiebreaker.Normalize()
+	}
+	if err := input.Format.ValidateTiebreakers(); err != nil {
+		return nil, fmt.Errorf("%w: %v", types.ErrInvalidLeagueConfiguration, err)
+	}
+
+	if input.PreviousSeasonLeagueID != nil {
+		previousSeason, err := s.leagueRepo.GetLeagueByID(*input.PreviousSeasonLeagueID)
+		if err != nil {
+			log.Printf("(Error: LeagueService.CreateLeague) - Could not get previous season league %s: %v\n", *input.PreviousSeasonLeagueID, err)
+			return nil, fmt.Errorf("failed to get previous season league: %w", err)
+		}
+		// a league can only carry on from one of the same commissioner's leagues
+		if previousSeason.OwnerUserID != userID {
+			return nil, types.ErrUnauthorized
+		}
+	}
+
 	newPlayerGroupNumber := 1
 	if input.Format.GroupCount > 1 {
 		// Owner is the first player and auto assigned 1. So, next player will have to be group 2
@@ -111,8 +139,12 @@ func (s *leagueServiceImpl) CreateLeague(userID uuid.UUID, input *requests.Leagu
 		StartingDraftPoints:  input.StartingDraftPoints,
 		NewPlayerGroupNumber: newPlayerGroupNumber,
 		Format:               &input.Format,
+
+		PreviousSeasonLeagueID: input.PreviousSeasonLeagueID,
 	}
-	league.StartDate = time.Now()
+	league.StartDate = s.clock.Now()
+	tiebreakSeed := rand.Int64()
+	league.TiebreakSeed = &tiebreakSeed
 
 	createdLeague, err := s.leagueRepo.CreateLeague(league)
 	if err != nil {
@@ -129,6 +161,7 @@ func (s *leagueServiceImpl) CreateLeague(userID uuid.UUID, input *requests.Leagu
 		InLeagueName: &inLeagueName,
 		TeamName:     &teamName,
 		DraftPoints:  int(createdLeague.StartingDraftPoints),
+		SkipsLeft:    createdLeague.Format.StartingSkipsLeft(createdLeague.MinPokemonPerPlayer, createdLeague.MaxPokemonPerPlayer),
 		GroupNumber:  1,
 		Role:         rbac.MRoleOwner,
 	}
@@ -204,7 +237,7 @@ func (s *leagueServiceImpl) StartRegularSeason(leagueID uuid.UUID) error {
 	}
 
 	// 3. Update League Status, CurrentWeekNumber, and RegularSeasonStartDate
-	now := time.Now()
+	now := s.clock.Now()
 	league.Status = enums.LeagueStatusRegularSeason
 	league.CurrentWeekNumber = 1 // Season starts at Week 1
 	league.RegularSeasonStartDate = &now
@@ -271,7 +304,7 @@ func (s *leagueServiceImpl) ProcessWeeklyTick(leagueID uuid.UUID) error {
 	}
 
 	oldWeekNumber := league.CurrentWeekNumber
-	now := time.Now()
+	now := s.clock.Now()
 
 	// Calculate the correct current week based on RegularSeasonStartDate
 	durationSinceSeasonStart := now.Sub(*league.RegularSeasonStartDate)
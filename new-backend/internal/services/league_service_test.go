@@ -71,6 +71,7 @@ func TestLeagueService_CreateLeague(t *testing.T) {
 			InLeagueName: &inLeagueName,
 			TeamName:     &teamName,
 			DraftPoints:  1000,
+			SkipsLeft:    6, // max 6, no minimum
 			GroupNumber:  1,
 			Role:         rbac.MRoleOwner,
 		}
@@ -116,6 +117,21 @@ func TestLeagueService_CreateLeague(t *testing.T) {
 		mockLeagueMemberRepo.AssertNotCalled(t, "Create")
 	})
 
+	t.Run("Fails if previous season belongs to another commissioner", func(t *testing.T) {
+		previousLeagueID := uuid.New()
+		seasonInput := *input
+		seasonInput.PreviousSeasonLeagueID = &previousLeagueID
+		mockLeagueRepo.On("GetLeaguesCountWhereOwner", testUserID).Return(int64(0), nil).Once()
+		mockLeagueRepo.On("GetLeagueByID", previousLeagueID).Return(&models.League{ID: previousLeagueID, OwnerUserID: uuid.New()}, nil).Once()
+
+		result, err := service.CreateLeague(testUserID, &seasonInput)
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+		assert.Nil(t, result)
+
+		mockLeagueRepo.AssertExpectations(t)
+		mockLeagueRepo.AssertNotCalled(t, "CreateLeague")
+	})
+
 	t.Run("Fails if CreateLeague returns error", func(t *testing.T) {
 		dbError := errors.New("database error")
 		mockLeagueRepo.On("GetLeaguesCountWhereOwner", testUserID).Return(int64(0), nil).Once()
@@ -0,0 +1,532 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/rbac"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	u "github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// mockDraftTTL is how long a mock draft sandbox is kept after it was created.
+const mockDraftTTL = 24 * time.Hour
+
+// MockDraftService runs rehearsal drafts against a copy of a league's pool.
+//
+// Each mock draft is a sandbox: an in-memory copy of the league, its pool and a set of seats, driven by
+// its own DraftService so costs, roster bounds, snake order and accumulated picks behave exactly as in
+// the real draft. Nothing is written to the database. The mock draft's ID doubles as the sandbox's
+// league ID, so it can never collide with the real league's draft state.
+//
+// Seats not taken by league members are played by bots, which draft the best base stat total per point
+// they can afford as soon as they are on the clock. Mock drafts have no turn timers.
+//
+// Sandboxes live in the memory of the instance that created them, so with several instances behind a
+// load balancer every request for a mock draft has to reach that instance: route on the instance cookie
+// the mock draft controllers set (see InstanceID). A request that lands on another instance is refused
+// as misdirected rather than answered with a 404.
+type MockDraftService interface {
+	CreateMockDraft(currentUser *models.User, leagueID uuid.UUID, input *requests.MockDraftCreateRequestDTO) (*responses.MockDraftResponse, error)
+	GetMockDraft(leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error)
+	// JoinMockDraft gives the current user one of the bot seats of a mock draft that hasn't started.
+	JoinMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error)
+	StartMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error)
+	DeleteMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) error
+	MakePick(currentUser *models.User, leagueID, mockDraftID uuid.UUID, input *requests.DraftMakePickRequestDTO) error
+	SkipTurn(currentUser *models.User, leagueID, mockDraftID uuid.UUID) error
+	SetClock(clock u.Clock)
+	// InstanceID identifies this instance, and so the sandboxes it holds. It changes on every restart.
+	InstanceID() uuid.UUID
+}
+
+type mockDraftSandbox struct {
+	mu           sync.Mutex // serialises actions so bots always move between human actions
+	id           uuid.UUID
+	leagueID     uuid.UUID
+	createdBy    uuid.UUID
+	createdAt    time.Time
+	store        *repositories.InMemoryDraftStore
+	draftService DraftService
+	bots         map[uuid.UUID]bool // sandbox member IDs of bot seats
+}
+
+type mockDraftServiceImpl struct {
+	instanceID uuid.UUID
+	mu         sync.Mutex
+	sandboxes  map[uuid.UUID]*mockDraftSandbox
+	clock      u.Clock
+
+	leagueRepo    repositories.LeagueRepository
+	memberRepo    repositories.LeagueMemberRepository
+	poolEntryRepo repositories.PoolEntryRepository
+}
+
+func NewMockDraftService(
+	leagueRepo repositories.LeagueRepository,
+	memberRepo repositories.LeagueMemberRepository,
+	poolEntryRepo repositories.PoolEntryRepository,
+) MockDraftService {
+	return &mockDraftServiceImpl{
+		instanceID:    uuid.New(),
+		sandboxes:     make(map[uuid.UUID]*mockDraftSandbox),
+		clock:         u.SystemClock,
+		leagueRepo:    leagueRepo,
+		memberRepo:    memberRepo,
+		poolEntryRepo: poolEntryRepo,
+	}
+}
+
+// SetClock replaces the wall clock that sandboxes expire and run their drafts by. Sandboxes already
+// created keep the clock they started with.
+func (s *mockDraftServiceImpl) SetClock(clock u.Clock) {
+	s.clock = clock
+}
+
+func (s *mockDraftServiceImpl) CreateMockDraft(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	input *requests.MockDraftCreateRequestDTO,
+) (*responses.MockDraftResponse, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - failed to fetch league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	if league.Format.IsAuctionDraft() {
+		// auction lots close on a timer, which mock drafts don't have
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - league %s uses an auction draft\n", leagueID)
+		return nil, types.ErrInvalidState
+	}
+
+	creator, err := s.memberRepo.GetByUserAndLeague(currentUser.ID, leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - (user %s) failed to fetch member in league %s: %v\n", currentUser.ID, leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	seats := input.Seats
+	if seats == 0 {
+		members, err := s.memberRepo.GetByLeague(leagueID)
+		if err != nil {
+			log.Printf("LOG: (MockDraftService: CreateMockDraft) - failed to fetch members of league %s: %v\n", leagueID, err)
+			return nil, types.ErrInternalService
+		}
+		seats = max(len(members), 2)
+	}
+	position := input.DraftPosition
+	if position == 0 {
+		seed := s.clock.Now().UnixNano()
+		position = rand.New(rand.NewSource(seed)).Intn(seats) + 1
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - drew draft position %d of %d with seed %d\n", position, seats, seed)
+	}
+	if position > seats {
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - draft position %d is beyond %d seats\n", position, seats)
+		return nil, types.ErrInvalidInput
+	}
+
+	poolEntries, err := s.poolEntryRepo.GetByLeague(leagueID)
+	if err != nil {
+		log.Printf("LOG: (MockDraftService: CreateMockDraft) - failed to fetch pool for league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	if len(poolEntries) == 0 {
+		return nil, types.ErrInvalidState
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweepExpired()
+	for _, sandbox := range s.sandboxes {
+		if sandbox.leagueID == leagueID && sandbox.createdBy == currentUser.ID {
+			log.Printf("LOG: (MockDraftService: CreateMockDraft) - user %s already has mock draft %s in league %s\n", currentUser.ID, sandbox.id, leagueID)
+			return nil, types.ErrConflict
+		}
+	}
+
+	sandbox := newMockDraftSandbox(league, creator, poolEntries, seats, position, s.clock)
+	sandbox.createdBy = currentUser.ID
+	s.sandboxes[sandbox.id] = sandbox
+
+	return s.buildResponse(sandbox)
+}
+
+func (s *mockDraftServiceImpl) GetMockDraft(leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error) {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return nil, err
+	}
+	sandbox.mu.Lock()
+	defer sandbox.mu.Unlock()
+	return s.buildResponse(sandbox)
+}
+
+func (s *mockDraftServiceImpl) JoinMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error) {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.memberRepo.GetByUserAndLeague(currentUser.ID, leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrPlayerNotFound
+		}
+		log.Printf("LOG: (MockDraftService: JoinMockDraft) - (user %s) failed to fetch member in league %s: %v\n", currentUser.ID, leagueID, err)
+		return nil, types.ErrInternalService
+	}
+
+	sandbox.mu.Lock()
+	defer sandbox.mu.Unlock()
+
+	if _, err := sandbox.store.DraftRepository().GetDraftByLeagueID(sandbox.id); err == nil {
+		return nil, types.ErrInvalidState // already started
+	}
+	seatRepo := sandbox.store.LeagueMemberRepository()
+	if _, err := seatRepo.GetByUserAndLeague(currentUser.ID, sandbox.id); err == nil {
+		return nil, types.ErrUserAlreadyInLeague
+	}
+	seats, err := seatRepo.GetByLeague(sandbox.id)
+	if err != nil {
+		log.Printf("LOG: (MockDraftService: JoinMockDraft) - failed to read seats of mock draft %s: %v\n", sandbox.id, err)
+		return nil, types.ErrInternalService
+	}
+	seatIdx := slices.IndexFunc(seats, func(m models.LeagueMember) bool { return sandbox.bots[m.ID] })
+	if seatIdx == -1 {
+		return nil, types.ErrConflict // no bot seat left to take over
+	}
+
+	seat := seats[seatIdx]
+	seat.UserID = currentUser.ID
+	seat.TeamName = member.TeamName
+	seat.InLeagueName = member.InLeagueName
+	if _, err := seatRepo.Update(&seat); err != nil {
+		log.Printf("LOG: (MockDraftService: JoinMockDraft) - failed to update seat in mock draft %s: %v\n", sandbox.id, err)
+		return nil, types.ErrInternalService
+	}
+	delete(sandbox.bots, seat.ID)
+
+	return s.buildResponse(sandbox)
+}
+
+func (s *mockDraftServiceImpl) StartMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) (*responses.MockDraftResponse, error) {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return nil, err
+	}
+	if sandbox.createdBy != currentUser.ID {
+		return nil, types.ErrUnauthorized
+	}
+
+	sandbox.mu.Lock()
+	defer sandbox.mu.Unlock()
+
+	if _, err := sandbox.store.DraftRepository().GetDraftByLeagueID(sandbox.id); err == nil {
+		return nil, types.ErrInvalidState // already started
+	}
+	if _, err := sandbox.draftService.StartDraft(sandbox.id, 0); err != nil {
+		log.Printf("LOG: (MockDraftService: StartMockDraft) - failed to start mock draft %s: %v\n", sandbox.id, err)
+		return nil, err
+	}
+	s.runBots(sandbox)
+
+	return s.buildResponse(sandbox)
+}
+
+func (s *mockDraftServiceImpl) DeleteMockDraft(currentUser *models.User, leagueID, mockDraftID uuid.UUID) error {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return err
+	}
+	if sandbox.createdBy != currentUser.ID {
+		return types.ErrUnauthorized
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sandboxes, sandbox.id)
+	return nil
+}
+
+// MakePick runs DraftService.MakePick inside the sandbox, then lets any bots that are now on the clock pick.
+func (s *mockDraftServiceImpl) MakePick(
+	currentUser *models.User,
+	leagueID uuid.UUID,
+	mockDraftID uuid.UUID,
+	input *requests.DraftMakePickRequestDTO,
+) error {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return err
+	}
+	sandbox.mu.Lock()
+	defer sandbox.mu.Unlock()
+
+	if err := sandbox.draftService.MakePick(currentUser, sandbox.id, input); err != nil {
+		return err
+	}
+	s.runBots(sandbox)
+	return nil
+}
+
+// SkipTurn runs DraftService.SkipTurn inside the sandbox, then lets any bots that are now on the clock pick.
+func (s *mockDraftServiceImpl) SkipTurn(currentUser *models.User, leagueID, mockDraftID uuid.UUID) error {
+	sandbox, err := s.fetchSandbox(leagueID, mockDraftID)
+	if err != nil {
+		return err
+	}
+	sandbox.mu.Lock()
+	defer sandbox.mu.Unlock()
+
+	if err := sandbox.draftService.SkipTurn(currentUser, sandbox.id); err != nil {
+		return err
+	}
+	s.runBots(sandbox)
+	return nil
+}
+
+func (s *mockDraftServiceImpl) InstanceID() uuid.UUID {
+	return s.instanceID
+}
+
+// newMockDraftSandbox copies the league and its whole pool (as if nothing had been drafted yet) into a
+// fresh in-memory store. The creator sits at position; every other seat is a bot.
+func newMockDraftSandbox(
+	league *models.League,
+	creator *models.LeagueMember,
+	poolEntries []models.PoolEntry,
+	seats int,
+	position int,
+	clock u.Clock,
+) *mockDraftSandbox {
+	sandboxID := uuid.New()
+
+	sandboxLeague := *league
+	sandboxLeague.ID = sandboxID
+	sandboxLeague.Status = enums.LeagueStatusSetup
+	sandboxLeague.Members = nil
+	sandboxLeague.PoolEntries = nil
+	sandboxLeague.Claims = nil
+	// seats are laid out here, so StartDraft must not reshuffle them
+	format := types.LeagueFormat{}
+	if league.Format != nil {
+		format = *league.Format
+	}
+	format.DraftOrderType = enums.DraftOrderTypeManual
+	sandboxLeague.Format = &format
+
+	bots := make(map[uuid.UUID]bool, seats-1)
+	members := make([]models.LeagueMember, 0, seats)
+	for pos := 1; pos <= seats; pos++ {
+		member := models.LeagueMember{
+			ID:              uuid.New(),
+			LeagueID:        sandboxID,
+			DraftPoints:     league.StartingDraftPoints,
+			DraftPosition:   pos,
+			SkipsLeft:       league.Format.StartingSkipsLeft(league.MinPokemonPerPlayer, league.MaxPokemonPerPlayer),
+			Role:            rbac.MRoleMember,
+			IsParticipating: true,
+		}
+		if pos == position {
+			member.UserID = creator.UserID
+			member.TeamName = creator.TeamName
+			member.InLeagueName = creator.InLeagueName
+		} else {
+			teamName := fmt.Sprintf("Bot %d", pos)
+			member.UserID = uuid.New() // bots have no user; this only has to be unique
+			member.TeamName = &teamName
+			bots[member.ID] = true
+		}
+		members = append(members, member)
+	}
+
+	sandboxPool := make([]models.PoolEntry, len(poolEntries))
+	for i, entry := range poolEntries {
+		entry.LeagueID = sandboxID
+		entry.IsAvailable = true
+		entry.League = nil
+		sandboxPool[i] = entry
+	}
+
+	store := repositories.NewInMemoryDraftStore(&sandboxLeague, members, sandboxPool)
+	draftService := NewDraftService(store.LeagueRepository(), store.DraftRepository(), store.LeagueMemberRepository(), nil)
+	draftService.SetNewRepositories(store.DraftPickRepository(), store.ClaimRepository(), store.PoolEntryRepository())
+	draftService.SetSchedulerService(noopSchedulerService{})
+	draftService.SetClock(clock)
+
+	return &mockDraftSandbox{
+		id:           sandboxID,
+		leagueID:     league.ID,
+		createdAt:    clock.Now(),
+		store:        store,
+		draftService: draftService,
+		bots:         bots,
+	}
+}
+
+// runBots plays every bot turn until a human is on the clock or the draft stops. A bot picks its
+// best value entry; if that fails it skips, and if it can't skip it is auto-skipped like a member
+// whose turn timed out (which may pause the mock draft). Callers must hold sandbox.mu.
+func (s *mockDraftServiceImpl) runBots(sandbox *mockDraftSandbox) {
+	draftRepo := sandbox.store.DraftRepository()
+	memberRepo := sandbox.store.LeagueMemberRepository()
+	league, err := sandbox.store.LeagueRepository().GetLeagueByID(sandbox.id)
+	if err != nil {
+		log.Printf("LOG: (MockDraftService: runBots) - failed to read league of mock draft %s: %v\n", sandbox.id, err)
+		return
+	}
+	seatCount, _ := memberRepo.GetCountByLeague(sandbox.id)
+	maxActions := int(seatCount)*league.MaxPokemonPerPlayer*2 + 1 // every slot picked or skipped, with room for accumulated picks
+
+	for range maxActions {
+		draft, err := draftRepo.GetDraftByLeagueID(sandbox.id)
+		if err != nil {
+			log.Printf("LOG: (MockDraftService: runBots) - failed to read draft of mock draft %s: %v\n", sandbox.id, err)
+			return
+		}
+		if draft.Status != enums.DraftStatusOngoing || draft.CurrentTurnMemberID == nil || !sandbox.bots[*draft.CurrentTurnMemberID] {
+			return
+		}
+		bot, err := memberRepo.GetByID(*draft.CurrentTurnMemberID)
+		if err != nil {
+			log.Printf("LOG: (MockDraftService: runBots) - failed to read bot seat in mock draft %s: %v\n", sandbox.id, err)
+			return
+		}
+		botUser := &models.User{ID: bot.UserID}
+
+		// bots draft like a member whose league auto-picks the best available on timeout
+		if entry := bestAvailableAutoPick(sandbox.store.ClaimRepository(), sandbox.store.PoolEntryRepository(), league, bot); entry != nil {
+			err := sandbox.draftService.MakePick(botUser, sandbox.id, &requests.DraftMakePickRequestDTO{
+				RequestedPickCount: 1,
+				RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: entry.ID, DraftPickNumber: draft.CurrentPickOnClock}},
+			})
+			if err == nil {
+				continue
+			}
+			log.Printf("LOG: (MockDraftService: runBots) - bot %s could not pick in mock draft %s: %v\n", bot.ID, sandbox.id, err)
+		}
+		if err := sandbox.draftService.SkipTurn(botUser, sandbox.id); err == nil {
+			continue
+		}
+		if err := sandbox.draftService.AutoSkipTurn(bot.ID, sandbox.id, draft.CurrentPickOnClock); err != nil {
+			log.Printf("LOG: (MockDraftService: runBots) - bot %s stalled mock draft %s: %v\n", bot.ID, sandbox.id, err)
+			return
+		}
+	}
+}
+
+// fetchSandbox looks up a live sandbox, sweeping expired ones first so abandoned mock drafts are freed
+// by whatever request comes next rather than only by the next CreateMockDraft.
+func (s *mockDraftServiceImpl) fetchSandbox(leagueID, mockDraftID uuid.UUID) (*mockDraftSandbox, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweepExpired()
+	sandbox, ok := s.sandboxes[mockDraftID]
+	if !ok || sandbox.leagueID != leagueID {
+		return nil, types.ErrMockDraftNotFound
+	}
+	return sandbox, nil
+}
+
+// sweepExpired drops sandboxes past mockDraftTTL. Callers must hold s.mu.
+func (s *mockDraftServiceImpl) sweepExpired() {
+	for id, sandbox := range s.sandboxes {
+		if s.clock.Now().Sub(sandbox.createdAt) > mockDraftTTL {
+			delete(s.sandboxes, id)
+		}
+	}
+}
+
+// buildResponse reads the sandbox's current state. Callers must hold sandbox.mu.
+func (s *mockDraftServiceImpl) buildResponse(sandbox *mockDraftSandbox) (*responses.MockDraftResponse, error) {
+	members, err := sandbox.store.LeagueMemberRepository().GetByLeague(sandbox.id)
+	if err != nil {
+		log.Printf("LOG: (MockDraftService: buildResponse) - failed to read seats of mock draft %s: %v\n", sandbox.id, err)
+		return nil, types.ErrInternalService
+	}
+
+	response := &responses.MockDraftResponse{
+		ID:        sandbox.id,
+		LeagueID:  sandbox.leagueID,
+		CreatedBy: sandbox.createdBy,
+		CreatedAt: sandbox.createdAt,
+		Seats:     make([]responses.MockDraftSeat, 0, len(members)),
+		Picks:     []models.DraftPick{},
+	}
+	for _, m := range members {
+		seat := responses.MockDraftSeat{
+			MemberID:      m.ID,
+			DraftPosition: m.DraftPosition,
+			DraftPoints:   m.DraftPoints,
+			SkipsLeft:     m.SkipsLeft,
+			IsBot:         sandbox.bots[m.ID],
+		}
+		if m.TeamName != nil {
+			seat.TeamName = *m.TeamName
+		}
+		if !seat.IsBot {
+			userID := m.UserID
+			seat.UserID = &userID
+		}
+		response.Seats = append(response.Seats, seat)
+	}
+
+	draft, err := sandbox.store.DraftRepository().GetDraftByLeagueID(sandbox.id)
+	if err != nil {
+		return response, nil // not started yet
+	}
+	response.Draft = draft
+	picks, err := sandbox.store.DraftPickRepository().GetByDraft(draft.ID)
+	if err != nil {
+		log.Printf("LOG: (MockDraftService: buildResponse) - failed to read picks of mock draft %s: %v\n", sandbox.id, err)
+		return nil, types.ErrInternalService
+	}
+	response.Picks = picks
+	return response, nil
+}
+
+// noopSchedulerService stands in for the scheduler inside mock drafts, which have no turn timers:
+// humans take as long as they like and bots move immediately.
+type noopSchedulerService struct{}
+
+func (noopSchedulerService) Start() error                                       { return nil }
+func (noopSchedulerService) RegisterTask(task *u.ScheduledTask)                 {}
+func (noopSchedulerService) DeregisterTask(taskID string)                       {}
+func (noopSchedulerService) Stop()                                              {}
+func (noopSchedulerService) SetDraftService(draftService DraftService)          {}
+func (noopSchedulerService) SetTransferService(transferService TransferService) {}
+func (noopSchedulerService) SetLeagueService(leagueService LeagueService)       {}
+func (noopSchedulerService) GetDeadLetterTasks(leagueID *uuid.UUID) ([]models.ScheduledTask, error) {
+	return nil, nil
+}
+func (noopSchedulerService) ListPendingTasks(leagueID *uuid.UUID) []models.ScheduledTask { return nil }
+func (noopSchedulerService) RescheduleTask(leagueID *uuid.UUID, taskID string, executeAt time.Time) (*models.ScheduledTask, error) {
+	return nil, types.ErrScheduledTaskNotFound
+}
+func (noopSchedulerService) RunTaskNow(leagueID *uuid.UUID, taskID string) (*models.ScheduledTask, error) {
+	return nil, types.ErrScheduledTaskNotFound
+}
+func (noopSchedulerService) CancelTask(leagueID *uuid.UUID, taskID string) error {
+	return types.ErrScheduledTaskNotFound
+}
+func (noopSchedulerService) AdvanceClock(d time.Duration) (time.Time, error) {
+	return time.Time{}, types.ErrTimeTravelDisabled
+}
+func (noopSchedulerService) SetClock(clock u.Clock) {}
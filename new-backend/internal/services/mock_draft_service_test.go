@@ -0,0 +1,235 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
+	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockDraftServiceMocks struct {
+	leagueRepo       *mock_repositories.MockLeagueRepository
+	leagueMemberRepo *mock_repositories.MockLeagueMemberRepository
+	poolEntryRepo    *mock_repositories.MockPoolEntryRepository
+}
+
+func setupMockDraftServiceTest() (services.MockDraftService, mockDraftServiceMocks) {
+	mocks := mockDraftServiceMocks{
+		leagueRepo:       new(mock_repositories.MockLeagueRepository),
+		leagueMemberRepo: new(mock_repositories.MockLeagueMemberRepository),
+		poolEntryRepo:    new(mock_repositories.MockPoolEntryRepository),
+	}
+	service := services.NewMockDraftService(mocks.leagueRepo, mocks.leagueMemberRepo, mocks.poolEntryRepo)
+	return service, mocks
+}
+
+func TestMockDraftService(t *testing.T) {
+	leagueID := uuid.New()
+	creatorUser := &models.User{ID: uuid.New()}
+	otherUser := &models.User{ID: uuid.New()}
+	creatorTeam := "Creator Team"
+	creator := &models.LeagueMember{ID: uuid.New(), UserID: creatorUser.ID, LeagueID: leagueID, TeamName: &creatorTeam}
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusDrafting, // the real draft may well be running
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 3,
+			StartingDraftPoints: 30,
+			Format:              &types.LeagueFormat{IsSnakeRoundDraft: true, DraftOrderType: enums.DraftOrderTypeRandom},
+		}
+	}
+	newEntry := func(cost, bst int) models.PoolEntry {
+		stat := bst / 6
+		return models.PoolEntry{
+			ID:          uuid.New(),
+			LeagueID:    leagueID,
+			Cost:        &cost,
+			IsAvailable: false, // already drafted in the real league; the sandbox starts from a full pool
+			PokemonSpecies: &models.PokemonSpecies{
+				Stats: models.BaseStats{Hp: stat, Attack: stat, Defense: stat, SpecialAttack: stat, SpecialDefense: stat, Speed: stat},
+			},
+		}
+	}
+	// best base stat total per point first, apart from expensive which the creator takes
+	expensive := newEntry(10, 600)
+	pool := []models.PoolEntry{
+		expensive,
+		newEntry(2, 300), // 150 per point
+		newEntry(3, 390), // 130
+		newEntry(4, 480), // 120
+		newEntry(5, 504), // ~100
+		newEntry(1, 90),  // 90
+		newEntry(8, 564), // ~70
+	}
+	expectCreate := func(mocks mockDraftServiceMocks, league *models.League) {
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", creatorUser.ID, leagueID).Return(creator, nil).Once()
+		mocks.poolEntryRepo.On("GetByLeague", leagueID).Return(pool, nil).Once()
+	}
+	seatAt := func(mockDraft *responses.MockDraftResponse, position int) responses.MockDraftSeat {
+		for _, seat := range mockDraft.Seats {
+			if seat.DraftPosition == position {
+				return seat
+			}
+		}
+		t.Fatalf("no seat at position %d", position)
+		return responses.MockDraftSeat{}
+	}
+
+	t.Run("CreateMockDraft - Seats the creator and fills the rest with bots", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		expectCreate(mocks, newLeague())
+
+		mockDraft, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3, DraftPosition: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, mockDraft.Seats, 3)
+		assert.Nil(t, mockDraft.Draft)
+		seat := seatAt(mockDraft, 2)
+		assert.False(t, seat.IsBot)
+		assert.Equal(t, creatorUser.ID, *seat.UserID)
+		assert.Equal(t, creatorTeam, seat.TeamName)
+		assert.Equal(t, 30, seat.DraftPoints)
+		assert.Equal(t, 2, seat.SkipsLeft)
+		assert.True(t, seatAt(mockDraft, 1).IsBot)
+		assert.Nil(t, seatAt(mockDraft, 1).UserID)
+		assert.True(t, seatAt(mockDraft, 3).IsBot)
+		mocks.leagueRepo.AssertExpectations(t)
+		mocks.leagueMemberRepo.AssertExpectations(t)
+		mocks.poolEntryRepo.AssertExpectations(t)
+	})
+
+	t.Run("CreateMockDraft - Rejects a second mock draft for the same user", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		expectCreate(mocks, newLeague())
+		expectCreate(mocks, newLeague())
+
+		_, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3, DraftPosition: 1})
+		assert.NoError(t, err)
+		_, err = service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3, DraftPosition: 1})
+
+		assert.ErrorIs(t, err, types.ErrConflict)
+	})
+
+	t.Run("CreateMockDraft - Rejects auction leagues", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		league := newLeague()
+		league.Format.DraftMode = enums.DraftModeAuction
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil).Once()
+
+		_, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3})
+
+		assert.ErrorIs(t, err, types.ErrInvalidState)
+		mocks.leagueMemberRepo.AssertNotCalled(t, "GetByUserAndLeague", mock.Anything, mock.Anything)
+	})
+
+	t.Run("GetMockDraft - Expires a day after creation on the service's clock", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		clock := utils.NewVirtualClock()
+		service.SetClock(clock)
+		expectCreate(mocks, newLeague())
+
+		mockDraft, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3})
+		assert.NoError(t, err)
+		_, err = service.GetMockDraft(leagueID, mockDraft.ID)
+		assert.NoError(t, err)
+
+		clock.AdvanceTo(clock.Now().Add(25 * time.Hour))
+		_, err = service.GetMockDraft(leagueID, mockDraft.ID)
+
+		assert.ErrorIs(t, err, types.ErrMockDraftNotFound)
+	})
+
+	t.Run("MakePick - Bots draft best value in snake order until the creator is back on the clock", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		expectCreate(mocks, newLeague())
+		mockDraft, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 3, DraftPosition: 1})
+		assert.NoError(t, err)
+
+		mockDraft, err = service.StartMockDraft(creatorUser, leagueID, mockDraft.ID)
+		assert.NoError(t, err)
+		creatorSeat := seatAt(mockDraft, 1)
+		assert.Equal(t, creatorSeat.MemberID, *mockDraft.Draft.CurrentTurnMemberID, "creator seat was kept despite random draft order")
+
+		err = service.MakePick(creatorUser, leagueID, mockDraft.ID, &requests.DraftMakePickRequestDTO{
+			RequestedPickCount: 1,
+			RequestedPicks:     []requests.RequestedPickDTO{{PoolEntryID: expensive.ID, DraftPickNumber: 1}},
+		})
+		assert.NoError(t, err)
+
+		mockDraft, err = service.GetMockDraft(leagueID, mockDraft.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, mockDraft.Draft.CurrentPickOnClock)
+		assert.Equal(t, creatorSeat.MemberID, *mockDraft.Draft.CurrentTurnMemberID)
+		assert.Len(t, mockDraft.Picks, 5)
+
+		picked := make(map[int]uuid.UUID, len(mockDraft.Picks))
+		for _, pick := range mockDraft.Picks {
+			picked[pick.PickNumber] = pick.PoolEntryID
+		}
+		// snake: seat 2, seat 3, seat 3, seat 2
+		assert.Equal(t, pool[1].ID, picked[2])
+		assert.Equal(t, pool[2].ID, picked[3])
+		assert.Equal(t, pool[3].ID, picked[4])
+		assert.Equal(t, pool[4].ID, picked[5])
+		assert.Equal(t, 20, seatAt(mockDraft, 1).DraftPoints)
+		assert.Equal(t, 23, seatAt(mockDraft, 2).DraftPoints)
+
+		// the real league was only read
+		mocks.leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+		mocks.leagueMemberRepo.AssertNotCalled(t, "Update", mock.Anything)
+		mocks.poolEntryRepo.AssertNotCalled(t, "MarkUnavailable", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MakePick - Unknown mock draft", func(t *testing.T) {
+		service, _ := setupMockDraftServiceTest()
+
+		err := service.MakePick(creatorUser, leagueID, uuid.New(), &requests.DraftMakePickRequestDTO{})
+
+		assert.ErrorIs(t, err, types.ErrMockDraftNotFound)
+	})
+
+	t.Run("JoinMockDraft - Replaces a bot seat", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		expectCreate(mocks, newLeague())
+		mockDraft, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 2, DraftPosition: 1})
+		assert.NoError(t, err)
+		otherTeam := "Other Team"
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", otherUser.ID, leagueID).
+			Return(&models.LeagueMember{ID: uuid.New(), UserID: otherUser.ID, LeagueID: leagueID, TeamName: &otherTeam}, nil)
+
+		mockDraft, err = service.JoinMockDraft(otherUser, leagueID, mockDraft.ID)
+
+		assert.NoError(t, err)
+		seat := seatAt(mockDraft, 2)
+		assert.False(t, seat.IsBot)
+		assert.Equal(t, otherUser.ID, *seat.UserID)
+		assert.Equal(t, otherTeam, seat.TeamName)
+
+		// no bot seats left
+		_, err = service.JoinMockDraft(otherUser, leagueID, mockDraft.ID)
+		assert.ErrorIs(t, err, types.ErrUserAlreadyInLeague)
+	})
+
+	t.Run("StartMockDraft - Only the creator can start", func(t *testing.T) {
+		service, mocks := setupMockDraftServiceTest()
+		expectCreate(mocks, newLeague())
+		mockDraft, err := service.CreateMockDraft(creatorUser, leagueID, &requests.MockDraftCreateRequestDTO{Seats: 2, DraftPosition: 1})
+		assert.NoError(t, err)
+
+		_, err = service.StartMockDraft(otherUser, leagueID, mockDraft.ID)
+
+		assert.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,327 @@
+package services
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/requests"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// finalizePlayoffGame finalizes a playoff game and brings its league's bracket up to date with it, in
+// one transaction: a finalized result is never left out of the bracket. Rather than moving one game's
+// winner and loser along, it replays the whole bracket from its results, so re-finalizing a game
+// redoes everything downstream of it, and replaying again changes nothing.
+//
+// A game's players come from the games feeding it (WinnerToGameID, LoserToGameID). With two feeds,
+// the upper bracket's (or the earlier game's) fills Player1; with one, Player1 is a seeded or bye
+// player set when the bracket was generated and the feed fills Player2. A game left with one player
+// and nobody else to come is a bye: it completes with that player as its winner and no loser.
+// When a game's players change, whatever result it had is voided, along with its battles' claim stats.
+// The league and its games stay locked while the game is finalized and the bracket replayed and saved,
+// so two games finalized at once are advanced one after the other rather than over each other.
+//
+// In double elimination, the lower bracket's winner taking the grand final forces a reset: a second
+// grand final, unless the league's format has NoGrandFinalReset. The league is COMPLETED once the
+// last game is decided, with its EndDate stamped, and back in PLAYOFFS (EndDate cleared) if a
+// re-finalization undecides it.
+func (s *gameServiceImpl) finalizePlayoffGame(game *models.Game, loserID uuid.UUID, dto *requests.FinalizeGameRequestDTO, report *models.GameReport) error {
+	// the replay runs inside the repository's transaction, against the league and games as locked there
+	err := s.gameRepo.FinalizeGameAndUpdateBracket(game, loserID, dto, report, s.replayBracket)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrConflict):
+			return err
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return types.ErrLeagueNotFound
+		}
+		log.Printf("ERROR: (GameService: finalizePlayoffGame) - could not finalize game %s and advance the bracket of league %s: %v\n", game.ID, game.LeagueID, err)
+		return types.ErrInternalService
+	}
+	return nil
+}
+
+// replayBracket works out what finalizePlayoffGame changes in league's bracket, given all its playoff games.
+// It returns nil when nothing changes.
+func (s *gameServiceImpl) replayBracket(league *models.League, games []models.Game) (*repositories.BracketUpdate, error) {
+	if len(games) == 0 {
+		return nil, nil
+	}
+	b, err := newBracket(games)
+	if err != nil {
+		return nil, fmt.Errorf("league %s: %w", league.ID, err)
+	}
+	b.replay()
+	final := b.settleGrandFinalReset(league)
+
+	update := &repositories.BracketUpdate{Voided: b.voided, Removed: b.removed}
+	for _, game := range b.games {
+		if b.changed[game.ID] {
+			update.Games = append(update.Games, game)
+		}
+	}
+	if final != nil {
+		decided := final.Status == enums.GameStatusCompleted
+		switch {
+		case decided && league.Status != enums.LeagueStatusCompleted:
+			update.LeagueStatus = enums.LeagueStatusCompleted
+			now := s.clock.Now()
+			update.EndDate = &now
+		case !decided && league.Status == enums.LeagueStatusCompleted:
+			update.LeagueStatus = enums.LeagueStatusPlayoffs
+		}
+	}
+	if len(update.Games) == 0 && len(update.Removed) == 0 && update.LeagueStatus == "" {
+		return nil, nil
+	}
+	return update, nil
+}
+
+// bracketFeed is a game's winner (or loser) moving on to another game.
+type bracketFeed struct {
+	from  *models.Game
+	loser bool
+}
+
+// bracketEntrant is who plays one side of a bracket game.
+type bracketEntrant struct {
+	id      uuid.UUID // uuid.Nil if nobody (yet)
+	pending bool      // fed by a game still to be decided
+}
+
+type bracket struct {
+	games   []*models.Game // every game after the games feeding it
+	feeds   map[uuid.UUID][]bracketFeed
+	changed map[uuid.UUID]bool
+	voided  []models.Game // completed games whose results no longer stand
+	removed []uuid.UUID
+}
+
+func newBracket(games []models.Game) (*bracket, error) {
+	byID := make(map[uuid.UUID]*models.Game, len(games))
+	for i := range games {
+		byID[games[i].ID] = &games[i]
+	}
+	b := &bracket{
+		feeds:   make(map[uuid.UUID][]bracketFeed),
+		changed: make(map[uuid.UUID]bool),
+	}
+	for i := range games {
+		game := &games[i]
+		if _, ok := byID[game.WinnerToGameID]; ok {
+			b.feeds[game.WinnerToGameID] = append(b.feeds[game.WinnerToGameID], bracketFeed{from: game})
+		}
+		if _, ok := byID[game.LoserToGameID]; ok {
+			b.feeds[game.LoserToGameID] = append(b.feeds[game.LoserToGameID], bracketFeed{from: game, loser: true})
+		}
+	}
+	for _, feeds := range b.feeds {
+		slices.SortFunc(feeds, compareFeeds)
+	}
+
+	// order the games so each comes after the games feeding it
+	waiting := make(map[uuid.UUID]int, len(games))
+	var ready []*models.Game
+	for i := range games {
+		if waiting[games[i].ID] = len(b.feeds[games[i].ID]); waiting[games[i].ID] == 0 {
+			ready = append(ready, &games[i])
+		}
+	}
+	for len(ready) > 0 {
+		game := ready[0]
+		ready = ready[1:]
+		b.games = append(b.games, game)
+		for _, next := range []uuid.UUID{game.WinnerToGameID, game.LoserToGameID} {
+			if _, ok := byID[next]; !ok {
+				continue
+			}
+			if waiting[next]--; waiting[next] == 0 {
+				ready = append(ready, byID[next])
+			}
+		}
+	}
+	if len(b.games) != len(games) {
+		return nil, fmt.Errorf("the bracket's games feed into each other in a loop")
+	}
+	return b, nil
+}
+
+// replay fills every game's players from the games feeding it, in bracket order.
+func (b *bracket) replay() {
+	for _, game := range b.games {
+		entrants := [2]bracketEntrant{{id: game.Player1ID}, {id: game.Player2ID}}
+		feeds := b.feeds[game.ID]
+		if len(feeds) > 2 {
+			log.Printf("WARN: (GameService: replayBracket) - game %s is fed by %d games; only the first 2 count\n", game.ID, len(feeds))
+			feeds = feeds[:2]
+		}
+		for i, feed := range feeds {
+			entrants[i+2-len(feeds)] = feed.outcome()
+		}
+
+		if entrants[0].id != game.Player1ID || entrants[1].id != game.Player2ID {
+			b.clearResult(game)
+			game.Player1ID, game.Player2ID = entrants[0].id, entrants[1].id
+			b.changed[game.ID] = true
+		}
+
+		if entrants[0].pending || entrants[1].pending || (entrants[0].id != uuid.Nil && entrants[1].id != uuid.Nil) {
+			if isBracketBye(game) {
+				// someone has turned up for what was a bye
+				b.clearResult(game)
+			}
+			continue
+		}
+		winner := cmp.Or(entrants[0].id, entrants[1].id)
+		if isBracketBye(game) && winnerOf(game) == winner {
+			continue
+		}
+		b.clearResult(game)
+		game.Status = enums.GameStatusCompleted
+		if winner != uuid.Nil {
+			game.WinnerID = &winner
+		}
+	}
+}
+
+// settleGrandFinalReset adds the grand final reset a double elimination bracket needs, or removes one
+// it no longer does. It returns the game that decides the bracket, or nil if there isn't one.
+func (b *bracket) settleGrandFinalReset(league *models.League) *models.Game {
+	var grandFinal, reset *models.Game
+	for _, game := range b.games {
+		if game.GameType != enums.GameTypePlayoffGrandFinal {
+			continue
+		}
+		if feeds := b.feeds[game.ID]; len(feeds) > 0 && feeds[0].from.GameType == enums.GameTypePlayoffGrandFinal {
+			reset = game
+		} else {
+			grandFinal = game
+		}
+	}
+	if grandFinal == nil {
+		return nil
+	}
+
+	// Player2 is the lower bracket's winner, the only one with a loss coming in
+	needsReset := league.Format != nil && league.Format.PlayoffType == enums.LeaguePlayoffTypeDoubleElim &&
+		!league.Format.NoGrandFinalReset &&
+		grandFinal.Status == enums.GameStatusCompleted && grandFinal.LoserID != nil &&
+		winnerOf(grandFinal) == grandFinal.Player2ID
+	switch {
+	case needsReset && reset == nil:
+		bracketPosition := "Grand Final Reset"
+		reset = &models.Game{
+			ID:              uuid.New(),
+			LeagueID:        grandFinal.LeagueID,
+			Player1ID:       *grandFinal.WinnerID, // as replay would have it: the winner's feed comes first
+			Player2ID:       *grandFinal.LoserID,
+			RoundNumber:     grandFinal.RoundNumber + 1,
+			GameType:        enums.GameTypePlayoffGrandFinal,
+			Status:          enums.GameStatusScheduled,
+			BracketPosition: &bracketPosition,
+		}
+		grandFinal.WinnerToGameID, grandFinal.LoserToGameID = reset.ID, reset.ID
+		b.games = append(b.games, reset)
+		b.changed[grandFinal.ID], b.changed[reset.ID] = true, true
+	case !needsReset && reset != nil:
+		b.clearResult(reset)
+		delete(b.changed, reset.ID)
+		b.removed = append(b.removed, reset.ID)
+		grandFinal.WinnerToGameID, grandFinal.LoserToGameID = uuid.Nil, uuid.Nil
+		b.changed[grandFinal.ID] = true
+		reset = nil
+	}
+	if reset != nil {
+		return reset
+	}
+	return grandFinal
+}
+
+// clearResult puts a game back to be played, voiding any result it had.
+func (b *bracket) clearResult(game *models.Game) {
+	if game.Status == enums.GameStatusScheduled && game.WinnerID == nil {
+		return
+	}
+	if game.Status == enums.GameStatusCompleted && game.WinnerID != nil && game.LoserID != nil {
+		b.voided = append(b.voided, *game)
+	}
+	game.WinnerID, game.LoserID = nil, nil
+	game.Player1Wins, game.Player2Wins = 0, 0
+	game.ReportingPlayerID, game.ApproverID = nil, nil
+	game.ShowdownReplayLinks = nil
+	game.Status = enums.GameStatusScheduled
+	b.changed[game.ID] = true
+}
+
+// outcome is who the feed sends on, once its game is decided.
+func (f bracketFeed) outcome() bracketEntrant {
+	if f.from.Status != enums.GameStatusCompleted {
+		return bracketEntrant{pending: true}
+	}
+	if f.loser {
+		if f.from.LoserID == nil {
+			return bracketEntrant{} // a bye has no loser
+		}
+		return bracketEntrant{id: *f.from.LoserID}
+	}
+	return bracketEntrant{id: winnerOf(f.from)}
+}
+
+// compareFeeds orders the feeds of a game: upper bracket before lower, earlier rounds and games first,
+// and a game's winner before its loser.
+func compareFeeds(a, b bracketFeed) int {
+	side := func(f bracketFeed) int {
+		if f.from.GameType == enums.GameTypePlayoffLower {
+			return 1
+		}
+		return 0
+	}
+	outcome := func(f bracketFeed) int {
+		if f.loser {
+			return 1
+		}
+		return 0
+	}
+	return cmp.Or(
+		cmp.Compare(side(a), side(b)),
+		cmp.Compare(a.from.RoundNumber, b.from.RoundNumber),
+		cmp.Compare(bracketGameNumber(a.from), bracketGameNumber(b.from)),
+		cmp.Compare(outcome(a), outcome(b)),
+		cmp.Compare(a.from.ID.String(), b.from.ID.String()),
+	)
+}
+
+// bracketGameNumber reads N from a generated BracketPosition such as "Upper Round 2: Game N".
+func bracketGameNumber(game *models.Game) int {
+	if game.BracketPosition == nil {
+		return 0
+	}
+	_, number, ok := strings.Cut(*game.BracketPosition, "Game ")
+	if !ok {
+		return 0
+	}
+	n, _ := strconv.Atoi(number)
+	return n
+}
+
+// isBracketBye reports whether a game was completed as a bye. A played game always has a loser.
+func isBracketBye(game *models.Game) bool {
+	return game.Status == enums.GameStatusCompleted && game.LoserID == nil
+}
+
+func winnerOf(game *models.Game) uuid.UUID {
+	if game.WinnerID == nil {
+		return uuid.Nil
+	}
+	return *game.WinnerID
+}
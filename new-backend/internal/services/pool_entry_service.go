@@ -69,6 +69,18 @@ func (s *poolEntryServiceImpl) getPokemonSpeciesByID(pokemonSpeciesID int64) (*m
 	return pokemon, nil
 }
 
+// validateTier checks tier names one of the league's pool tiers. nil and "" (no tier) are always valid.
+func validateTier(league *models.League, tier *string) error {
+	if tier == nil || *tier == "" {
+		return nil
+	}
+	if league.Format.Tier(*tier) == nil {
+		log.Printf("(Service: PoolEntryService.validateTier) - league %s has no tier %q\n", league.ID, *tier)
+		return types.ErrInvalidInput
+	}
+	return nil
+}
+
 func (s *poolEntryServiceImpl) GetByID(id uuid.UUID) (*models.PoolEntry, error) {
 	entry, err := s.poolEntryRepo.GetByID(id)
 	if err != nil {
@@ -120,12 +132,16 @@ func (s *poolEntryServiceImpl) Create(currentUser *models.User, input *requests.
 	if err != nil {
 		return nil, err
 	}
+	if err := validateTier(league, input.Tier); err != nil {
+		return nil, err
+	}
 
 	entry := &models.PoolEntry{
 		LeagueID:         input.LeagueID,
 		PokemonSpeciesID: input.PokemonSpeciesID,
 		Cost:             input.Cost,
 		IsAvailable:      true,
+		Tier:             input.Tier,
 	}
 
 	created, err := s.poolEntryRepo.Create(entry)
@@ -166,12 +182,16 @@ func (s *poolEntryServiceImpl) CreateBatch(currentUser *models.User, inputs []re
 		if err != nil {
 			return nil, err
 		}
+		if err := validateTier(league, input.Tier); err != nil {
+			return nil, err
+		}
 
 		entriesToCreate = append(entriesToCreate, models.PoolEntry{
 			LeagueID:         input.LeagueID,
 			PokemonSpeciesID: input.PokemonSpeciesID,
 			Cost:             input.Cost,
 			IsAvailable:      true,
+			Tier:             input.Tier,
 		})
 	}
 
@@ -218,6 +238,16 @@ func (s *poolEntryServiceImpl) Update(currentUser *models.User, input *requests.
 	if *input.IsAvailable != existing.IsAvailable {
 		existing.IsAvailable = *input.IsAvailable
 	}
+	if input.Tier != nil {
+		if err := validateTier(league, input.Tier); err != nil {
+			return nil, err
+		}
+		if *input.Tier == "" {
+			existing.Tier = nil
+		} else {
+			existing.Tier = input.Tier
+		}
+	}
 
 	updated, err := s.poolEntryRepo.Update(existing)
 	if err != nil {
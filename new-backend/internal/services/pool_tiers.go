@@ -0,0 +1,122 @@
+package services
+
+import (
+	"log"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+)
+
+// checkRosterTierLimits returns ErrTierLimitExceeded if member's roster, after taking added and giving up
+// removed, would break the league's tier limits (LeagueFormat.Tiers): more of a tier than its MaxPerRoster,
+// or fewer open roster spots than the tier minimums still need. removed is for swaps such as trades; pass
+// nil when the roster only grows.
+//
+// A roster that already breaks a limit (e.g. tiers were added mid-season) is only rejected if the change
+// makes it worse, so members can always work their way back within the limits.
+func checkRosterTierLimits(
+	claimRepo repositories.ClaimRepository,
+	poolEntryRepo repositories.PoolEntryRepository,
+	league *models.League,
+	memberID uuid.UUID,
+	added []*models.PoolEntry,
+	removed []*models.PoolEntry,
+) error {
+	if !league.Format.HasTiers() {
+		return nil
+	}
+	roster, err := loadRosterTiers(claimRepo, poolEntryRepo, league, memberID)
+	if err != nil {
+		return err
+	}
+	return roster.check(added, removed)
+}
+
+// rosterTiers is how many pokemon of each tier a member's roster holds, so several changes can be
+// checked against the tier limits without going back to the database.
+type rosterTiers struct {
+	league   *models.League
+	memberID uuid.UUID
+	counts   map[string]int
+	size     int
+}
+
+func loadRosterTiers(
+	claimRepo repositories.ClaimRepository,
+	poolEntryRepo repositories.PoolEntryRepository,
+	league *models.League,
+	memberID uuid.UUID,
+) (*rosterTiers, error) {
+	claims, err := claimRepo.GetActiveByPlayer(memberID)
+	if err != nil {
+		log.Printf("LOG: (checkRosterTierLimits) - could not get roster of member %s: %v\n", memberID, err)
+		return nil, types.ErrInternalService
+	}
+	poolEntries, err := poolEntryRepo.GetByLeague(league.ID)
+	if err != nil {
+		log.Printf("LOG: (checkRosterTierLimits) - could not get pool of league %s: %v\n", league.ID, err)
+		return nil, types.ErrInternalService
+	}
+	tierBySpecies := make(map[int64]string, len(poolEntries))
+	for _, entry := range poolEntries {
+		if entry.Tier != nil {
+			tierBySpecies[entry.PokemonSpeciesID] = *entry.Tier
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, claim := range claims {
+		counts[tierBySpecies[claim.SpeciesID]]++
+	}
+	return &rosterTiers{league: league, memberID: memberID, counts: counts, size: len(claims)}, nil
+}
+
+// check applies checkRosterTierLimits to the roster taking added and giving up removed.
+func (r *rosterTiers) check(added, removed []*models.PoolEntry) error {
+	after := make(map[string]int, len(r.counts))
+	for tier, count := range r.counts {
+		after[tier] = count
+	}
+	for _, entry := range added {
+		after[poolEntryTier(entry)]++
+	}
+	for _, entry := range removed {
+		after[poolEntryTier(entry)]--
+	}
+
+	for _, tier := range r.league.Format.Tiers {
+		if tier.MaxPerRoster != nil && after[tier.Name] > *tier.MaxPerRoster && after[tier.Name] > r.counts[tier.Name] {
+			log.Printf("LOG: (checkRosterTierLimits) - member %s would hold %d %s-tier pokemon (max %d)\n", r.memberID, after[tier.Name], tier.Name, *tier.MaxPerRoster)
+			return types.ErrTierLimitExceeded
+		}
+	}
+
+	shortfallBefore := tierMinimumShortfall(r.league, r.counts, r.size)
+	shortfallAfter := tierMinimumShortfall(r.league, after, r.size+len(added)-len(removed))
+	if shortfallAfter > 0 && shortfallAfter > shortfallBefore {
+		log.Printf("LOG: (checkRosterTierLimits) - member %s would be %d roster spot(s) short of the tier minimums\n", r.memberID, shortfallAfter)
+		return types.ErrTierLimitExceeded
+	}
+	return nil
+}
+
+// tierMinimumShortfall returns how many more pokemon the tier minimums need than a roster of rosterSize
+// has open spots for. 0 means every minimum can still be met.
+func tierMinimumShortfall(league *models.League, counts map[string]int, rosterSize int) int {
+	needed := 0
+	for _, tier := range league.Format.Tiers {
+		if tier.MinPerRoster != nil && counts[tier.Name] < *tier.MinPerRoster {
+			needed += *tier.MinPerRoster - counts[tier.Name]
+		}
+	}
+	return max(needed-(league.MaxPokemonPerPlayer-rosterSize), 0)
+}
+
+func poolEntryTier(entry *models.PoolEntry) string {
+	if entry.Tier == nil {
+		return ""
+	}
+	return *entry.Tier
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	u "github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+)
+
+// BackfillScheduledTasks imports the tasks of a deployment that predates the scheduled_tasks table.
+// Those were never stored: the scheduler used to rebuild them on boot from drafts and leagues. So when
+// the table is still empty, this rebuilds them the same way (turn timeouts and reminders, open auction
+// lots, scheduled draft starts, weekly ticks and transfer windows) and stores them as PENDING, for
+// SchedulerService.Start to load like any other task. Once the table has a row it does nothing.
+// Run it before Start.
+func BackfillScheduledTasks(
+	taskRepo repositories.ScheduledTaskRepository,
+	leagueRepo repositories.LeagueRepository,
+	draftRepo repositories.DraftRepository,
+) error {
+	count, err := taskRepo.Count()
+	if err != nil {
+		log.Printf("LOG: (SchedulerService: BackfillScheduledTasks) - error counting stored tasks: %v\n", err)
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	tasks, err := legacyTasks(leagueRepo, draftRepo)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		record, err := taskRecord(task, enums.ScheduledTaskStatusPending)
+		if err != nil {
+			log.Printf("ERROR: (SchedulerService: BackfillScheduledTasks) - could not encode payload of task %s: %v. Skipping.\n", task.ID, err)
+			continue
+		}
+		if err := taskRepo.Save(record); err != nil {
+			log.Printf("LOG: (SchedulerService: BackfillScheduledTasks) - error storing task %s: %v\n", task.ID, err)
+			return err
+		}
+	}
+	log.Printf("LOG: (SchedulerService: BackfillScheduledTasks) - Stored %d task(s) rebuilt from drafts and leagues.\n", len(tasks))
+	return nil
+}
+
+// legacyTasks rebuilds the pending tasks from the state of drafts and leagues, as Start did before tasks
+// were stored. Tasks already overdue are kept as they are; Start runs them right away.
+func legacyTasks(leagueRepo repositories.LeagueRepository, draftRepo repositories.DraftRepository) ([]*u.ScheduledTask, error) {
+	var tasks []*u.ScheduledTask
+
+	drafts, err := draftRepo.GetAllDraftsByStatus(enums.DraftStatusOngoing)
+	if err != nil {
+		log.Printf("LOG: (SchedulerService: legacyTasks) - error fetching drafts with status %s: %v\n", enums.DraftStatusOngoing, err)
+		return nil, err
+	}
+	for _, draft := range drafts {
+		// an auction draft with an open lot is waiting on the lot's countdown, not a turn
+		if draft.AuctionPoolEntryID != nil && draft.AuctionLotEndsAt != nil {
+			tasks = append(tasks, &u.ScheduledTask{
+				ID:        fmt.Sprintf("%d_%s", u.TaskTypeAuctionLotClose, draft.LeagueID),
+				ExecuteAt: *draft.AuctionLotEndsAt,
+				Type:      u.TaskTypeAuctionLotClose,
+				Payload: u.PayloadAuctionLotClose{
+					LeagueID:    draft.LeagueID,
+					PoolEntryID: *draft.AuctionPoolEntryID,
+				},
+			})
+			continue
+		}
+
+		// same deadline DraftService.scheduleTurnTimeout sets: round time limit, frozen through quiet hours
+		turnEndTime := draft.CurrentTurnEndsAt()
+		if turnEndTime == nil || draft.CurrentTurnMemberID == nil {
+			log.Printf("WARN: (SchedulerService: legacyTasks) - Draft %s is ongoing without a timed turn. Skipping.\n", draft.ID)
+			continue
+		}
+		tasks = append(tasks, &u.ScheduledTask{
+			ID:        fmt.Sprintf("%d_%s", u.TaskTypeDraftTurnTimeout, draft.LeagueID),
+			ExecuteAt: *turnEndTime,
+			Type:      u.TaskTypeDraftTurnTimeout,
+			Payload: u.PayloadDraftTurnTimeout{
+				DraftID:    draft.ID,
+				LeagueID:   draft.LeagueID,
+				PlayerID:   *draft.CurrentTurnMemberID,
+				PickNumber: draft.CurrentPickOnClock,
+			},
+		})
+		// reminders the turn hasn't reached yet; ones that came due while the server was down are dropped
+		tasks = append(tasks, turnReminderTasks(&draft, time.Now())...)
+	}
+
+	scheduledStartLeagues, err := leagueRepo.GetLeaguesWithScheduledDraftStart()
+	if err != nil {
+		log.Printf("LOG: (SchedulerService: legacyTasks) - error fetching leagues with a scheduled draft start: %v\n", err)
+		return nil, err
+	}
+	for _, league := range scheduledStartLeagues {
+		tasks = append(tasks, newDraftStartTask(&league, *league.ScheduledDraftStart))
+	}
+
+	// the weekly tick keeps running through a transfer window
+	ongoingLeagues, err := leagueRepo.GetLeaguesByStatuses([]enums.LeagueStatus{enums.LeagueStatusRegularSeason, enums.LeagueStatusTransferWindow})
+	if err != nil {
+		log.Printf("LOG: (SchedulerService: legacyTasks) - error fetching ongoing regular season/transfer window leagues: %v\n", err)
+		return nil, err
+	}
+	for _, league := range ongoingLeagues {
+		if league.NextWeeklyTick == nil {
+			continue
+		}
+		tasks = append(tasks, &u.ScheduledTask{
+			ID:        fmt.Sprintf("%d_%s", u.TaskTypeLeagueWeeklyTick, league.ID),
+			ExecuteAt: *league.NextWeeklyTick,
+			Type:      u.TaskTypeLeagueWeeklyTick,
+			Payload:   u.PayloadLeagueWeeklyTick{LeagueID: league.ID},
+		})
+	}
+
+	transferLeagues, err := leagueRepo.GetLeaguesThatAllowTransfers()
+	if err != nil {
+		log.Printf("LOG: (SchedulerService: legacyTasks) - error fetching leagues with transfers enabled: %v\n", err)
+		return nil, err
+	}
+	for _, league := range transferLeagues {
+		if task := transferWindowTask(&league); task != nil {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// transferWindowTask is the end of the league's open transfer window, or the start of its next one for
+// a league in its regular season (or the playoffs of a bracket-only season). nil if neither applies.
+func transferWindowTask(league *models.League) *u.ScheduledTask {
+	if league.Format == nil {
+		return nil
+	}
+	var task *u.ScheduledTask
+	switch {
+	case league.Status == enums.LeagueStatusTransferWindow:
+		task = &u.ScheduledTask{
+			ID:      fmt.Sprintf("%d_%s", u.TaskTypeTransferPeriodEnd, league.ID),
+			Type:    u.TaskTypeTransferPeriodEnd,
+			Payload: u.PayloadTransferPeriodEnd{LeagueID: league.ID},
+		}
+	case league.Status == enums.LeagueStatusRegularSeason,
+		league.Status == enums.LeagueStatusPlayoffs && league.Format.SeasonType == enums.LeagueSeasonTypeBracketOnly:
+		task = &u.ScheduledTask{
+			ID:      fmt.Sprintf("%d_%s", u.TaskTypeTransferPeriodStart, league.ID),
+			Type:    u.TaskTypeTransferPeriodStart,
+			Payload: u.PayloadTransferPeriodStart{LeagueID: league.ID},
+		}
+	default:
+		return nil
+	}
+	if league.Format.NextTransferWindowStart == nil {
+		log.Printf("WARN: (SchedulerService: transferWindowTask) - League %s is in %s but NextTransferWindowStart is nil. Skipping.\n", league.ID, league.Status)
+		return nil
+	}
+
+	task.ExecuteAt = *league.Format.NextTransferWindowStart
+	if task.Type == u.TaskTypeTransferPeriodEnd {
+		// same end TransferService.StartTransferPeriod schedules
+		task.ExecuteAt = task.ExecuteAt.Add(time.Duration(league.Format.TransferWindowDuration) * time.Hour)
+	}
+	return task
+}
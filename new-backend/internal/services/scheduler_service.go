@@ -2,14 +2,21 @@ package services
 
 import (
 	"container/heap"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
 	u "github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
 )
 
 // SchedulerService defines the interface for managing scheduled tasks.
@@ -17,20 +24,70 @@ type SchedulerService interface {
 	Start() error
 	RegisterTask(task *u.ScheduledTask)
 	DeregisterTask(taskID string)
+	// task management for staff; leagueID limits them to one league's tasks, nil allows any (admins)
+	GetDeadLetterTasks(leagueID *uuid.UUID) ([]models.ScheduledTask, error)
+	ListPendingTasks(leagueID *uuid.UUID) []models.ScheduledTask
+	RescheduleTask(leagueID *uuid.UUID, taskID string, executeAt time.Time) (*models.ScheduledTask, error)
+	RunTaskNow(leagueID *uuid.UUID, taskID string) (*models.ScheduledTask, error)
+	CancelTask(leagueID *uuid.UUID, taskID string) error
+	// dev only: moves a virtual clock forward, running the tasks that come due on the way
+	AdvanceClock(d time.Duration) (time.Time, error)
 	Stop()
+	SetClock(clock u.Clock)
 	SetDraftService(draftService DraftService)
 	SetTransferService(transferService TransferService)
 	SetLeagueService(leagueService LeagueService)
 }
 
+const (
+	schedulerWorkers   = 4                // tasks that can run at once
+	maxTaskAttempts    = 5                // runs before a failing task is dead-lettered
+	taskRetryBaseDelay = 30 * time.Second // wait after the first failure, doubled after each one after it
+	taskRetryMaxDelay  = 30 * time.Minute
+
+	housekeepingInterval = 5 * time.Second // how often leadership is checked and other instances' writes are synced
+	taskSyncOverlap      = time.Minute     // re-reads writes this far back, for clock skew between instances
+)
+
+var (
+	// errServiceNotSet is returned by executeTask when the service a task calls into was never injected.
+	errServiceNotSet = errors.New("service needed to run the task is not set")
+	// errUnrunnableTask is returned by executeTask for a task it has no way to run.
+	errUnrunnableTask = errors.New("task cannot be run")
+)
+
+// schedulerServiceImpl keeps pending tasks in a heap ordered by due time. A single loop goroutine hands
+// due tasks to a fixed pool of workers, so a slow task never holds up the others past the pool size.
+// mu guards the heap and taskMap, which request goroutines reach through RegisterTask and DeregisterTask,
+// and also orders writes to taskRepo so the stored record of a task ID always matches the task in memory.
+//
+// When several instances run, each keeps the full schedule, syncing the tasks the others register or
+// cancel from taskRepo, but only the one holding leaderLock hands tasks to its workers. If the leader
+// dies its lock is freed and another instance takes over at its next housekeeping tick.
+//
+// Due times are read off clock. Bookkeeping against taskRepo (localWrites, syncedUpTo) stays on the wall
+// clock, as the row timestamps it is compared with are stamped by GORM from the writing instance's wall
+// clock (hence taskSyncOverlap for skew between instances).
 type schedulerServiceImpl struct {
-	tasks           *u.TaskHeap
-	taskMap         map[string]*u.ScheduledTask
-	taskChan        chan *u.ScheduledTask
-	rescheduleChan  chan struct{}
-	stopChan        chan struct{}
-	leagueRepo      repositories.LeagueRepository
-	draftRepo       repositories.DraftRepository
+	mu           sync.Mutex
+	idle         *sync.Cond // signalled on mu whenever a task leaves running
+	advanceMu    sync.Mutex // one AdvanceClock at a time
+	clock        u.Clock
+	tasks        *u.TaskHeap
+	taskMap      map[string]*u.ScheduledTask // pending tasks by ID; a task leaves it once handed to a worker
+	running      map[string]*u.ScheduledTask // tasks handed to a worker and not finished yet
+	localWrites  map[string]time.Time        // when this instance last wrote each task to taskRepo
+	failedWrites map[string]func() error     // writes to taskRepo that failed, retried by flushWrites
+	syncedUpTo   time.Time                   // writes to taskRepo before this are in memory
+	wakeChan     chan struct{}               // the earliest task may have changed
+	workChan     chan *u.ScheduledTask       // due tasks, waiting for a free worker
+	stopChan     chan struct{}
+	stopOnce     sync.Once
+	routines     sync.WaitGroup // the workers and the housekeeping goroutine
+	leader       atomic.Bool
+
+	taskRepo        repositories.ScheduledTaskRepository
+	leaderLock      repositories.LeaderLock
 	draftService    DraftService
 	transferService TransferService
 	leagueService   LeagueService
@@ -38,18 +95,29 @@ type schedulerServiceImpl struct {
 
 func NewSchedulerService(
 	tasks *u.TaskHeap,
-	leagueRepo repositories.LeagueRepository,
-	draftRepo repositories.DraftRepository,
+	taskRepo repositories.ScheduledTaskRepository,
+	leaderLock repositories.LeaderLock,
 ) SchedulerService {
-	return &schedulerServiceImpl{
-		tasks:          tasks,
-		taskMap:        make(map[string]*u.ScheduledTask),
-		taskChan:       make(chan *u.ScheduledTask, 5),
-		rescheduleChan: make(chan struct{}, 1),
-		stopChan:       make(chan struct{}),
-		leagueRepo:     leagueRepo,
-		draftRepo:      draftRepo,
+	s := &schedulerServiceImpl{
+		clock:        u.SystemClock,
+		tasks:        tasks,
+		taskMap:      make(map[string]*u.ScheduledTask),
+		running:      make(map[string]*u.ScheduledTask),
+		localWrites:  make(map[string]time.Time),
+		failedWrites: make(map[string]func() error),
+		wakeChan:     make(chan struct{}, 1),
+		workChan:     make(chan *u.ScheduledTask),
+		stopChan:     make(chan struct{}),
+		taskRepo:     taskRepo,
+		leaderLock:   leaderLock,
 	}
+	s.idle = sync.NewCond(&s.mu)
+	return s
+}
+
+// SetClock replaces the wall clock tasks are scheduled against, e.g. with a u.VirtualClock in dev.
+func (s *schedulerServiceImpl) SetClock(clock u.Clock) {
+	s.clock = clock
 }
 
 // SetDraftService injects the dependency needed for the scheduler to execute draft-related tasks.
@@ -70,238 +138,553 @@ func (s *schedulerServiceImpl) SetLeagueService(leagueService LeagueService) {
 	s.leagueService = leagueService
 }
 
-// Start initializes the scheduler on application boot. It fetches all ongoing drafts
-// and active league phases from the database, reconstructs the necessary tasks
-// (e.g.,turn timeouts), and launches the main scheduling loop in a background goroutine.
+// Start initializes the scheduler on application boot. Every task registered through RegisterTask is
+// stored (models.ScheduledTask), so it reloads the tasks still PENDING, whatever their type, then tries
+// to take leadership and starts the worker pool, the scheduling loop and the housekeeping goroutine.
+// Tasks that came due while no instance was running run right away. Tasks from before they were stored
+// are imported by BackfillScheduledTasks, which runs first.
 func (s *schedulerServiceImpl) Start() error {
-	// Fetch all ongoing drafts
-	drafts, err := s.draftRepo.GetAllDraftsByStatus(enums.DraftStatusOngoing)
+	loadedAt := time.Now()
+	records, err := s.taskRepo.GetByStatus(enums.ScheduledTaskStatusPending)
 	if err != nil {
-		log.Printf("LOG: (SchedulerService: Start) - error fetching drafts with status %s: %v\n", enums.DraftStatusOngoing, err)
+		log.Printf("LOG: (SchedulerService: Start) - error fetching pending tasks: %v\n", err)
 		return err
 	}
 
-	// Fetch leagues that use the transfer credit system
-	leagues, err := s.leagueRepo.GetLeaguesThatAllowTransfers()
-	if err != nil {
-		log.Printf("LOG: (SchedulerService: Start) - error fetching leagues with transfers enabled: %v\n", err)
-		return err
+	s.mu.Lock()
+	for _, record := range records {
+		task, err := taskFromRecord(&record)
+		if err != nil {
+			// leave it PENDING; a later release may know how to run it
+			log.Printf("ERROR: (SchedulerService: Start) - could not restore task %s: %v. Skipping.\n", record.ID, err)
+			continue
+		}
+		heap.Push(s.tasks, task)
+		s.taskMap[task.ID] = task
 	}
+	log.Printf("LOG: (SchedulerService: Start) - Restored %d pending task(s).\n", len(s.taskMap))
+	s.syncedUpTo = loadedAt
+	s.mu.Unlock()
+
+	s.checkLeadership()
+	log.Printf("LOG: (SchedulerService: Start) - Running Scheduler with %d workers\n", schedulerWorkers)
+	for range schedulerWorkers {
+		s.routines.Add(1)
+		go s.runWorker()
+	}
+	s.routines.Add(1)
+	go s.runHousekeeping()
+	go s.runSchedulerLoop()
 
-	// Schedule LeagueWeeklyTick for ongoing regular season leagues and leagues currently in transfer window
-	// If the server restarts during a transfer window, we still need to make sure the next tick is scheduled.
-	ongoingLeagues, err := s.leagueRepo.GetLeaguesByStatuses([]enums.LeagueStatus{enums.LeagueStatusRegularSeason, enums.LeagueStatusTransferWindow})
-	if err != nil {
-		log.Printf("LOG: (SchedulerService: Start) - error fetching ongoing regular season/transfer window leagues: %v\n", err)
-		return err
+	return nil
+}
+
+// RegisterTask adds a new task to the scheduler. It is called by other services
+// to schedule a future action, such as the timeout for a draft turn.
+// A pending task with the same ID is replaced.
+func (s *schedulerServiceImpl) RegisterTask(task *u.ScheduledTask) {
+	s.mu.Lock()
+	if existing, exists := s.taskMap[task.ID]; exists {
+		heap.Remove(s.tasks, existing.Index)
 	}
-	for _, league := range ongoingLeagues {
-		if league.NextWeeklyTick != nil {
-			// If a tick is in the past, execute it immediately. Otherwise, schedule it for its designated time.
-			executeAt := *league.NextWeeklyTick
-			if executeAt.Before(time.Now()) {
-				log.Printf("LOG: (SchedulerService: Start) - Weekly tick for league %s is overdue. Scheduling for immediate execution.\n", league.ID)
-				executeAt = time.Now()
-			}
+	heap.Push(s.tasks, task)
+	s.taskMap[task.ID] = task
+	// write through so the task survives a restart; a task that can't be stored still runs
+	s.saveTask(task, enums.ScheduledTaskStatusPending, nil)
+	s.mu.Unlock()
 
-			newTask := &u.ScheduledTask{
-				ID:        fmt.Sprintf("%d_%s", u.TaskTypeLeagueWeeklyTick, league.ID),
-				ExecuteAt: executeAt,
-				Type:      u.TaskTypeLeagueWeeklyTick,
-				Payload: u.PayloadLeagueWeeklyTick{
-					LeagueID: league.ID,
-				},
-			}
-			heap.Push(s.tasks, newTask)
-			s.taskMap[newTask.ID] = newTask
-			log.Printf("LOG: (SchedulerService: Start) - Restored weekly tick for league %s, scheduled for %s.\n", league.ID, executeAt.String())
+	s.wake()
+	log.Printf("LOG: (SchedulerService: RegisterTask) - Task registered: %s (Type: %s, ExecuteAt: %s)\n", task.ID, task.Type, task.ExecuteAt)
+}
+
+// DeregisterTask removes a task from the scheduler. This is called when a task
+// is completed ahead of schedule, for example, when a player makes a draft pick
+// before their turn timer expires.
+func (s *schedulerServiceImpl) DeregisterTask(taskID string) {
+	s.mu.Lock()
+	task, exists := s.taskMap[taskID]
+	if !exists {
+		// another instance may have registered it since the last sync
+		s.updateTaskStatus(taskID, enums.ScheduledTaskStatusCancelled)
+		s.mu.Unlock()
+		log.Printf("WARN: (SchedulerService: DeregisterTask) - Attempted to deregister non-existent task: %s\n", taskID)
+		return
+	}
+	heap.Remove(s.tasks, task.Index)
+	delete(s.taskMap, taskID)
+	s.updateTaskStatus(taskID, enums.ScheduledTaskStatusCancelled)
+	s.mu.Unlock()
+
+	s.wake()
+	log.Printf("LOG: (SchedulerService: DeregisterTask) - Task deregistered: %s\n", taskID)
+}
+
+// GetDeadLetterTasks returns the tasks that kept failing after every retry, earliest first.
+func (s *schedulerServiceImpl) GetDeadLetterTasks(leagueID *uuid.UUID) ([]models.ScheduledTask, error) {
+	if leagueID == nil {
+		tasks, err := s.taskRepo.GetByStatus(enums.ScheduledTaskStatusDeadLetter)
+		if err != nil {
+			log.Printf("ERROR: (SchedulerService: GetDeadLetterTasks) - could not fetch dead-letter tasks: %v\n", err)
+			return nil, types.ErrInternalService
 		}
+		return tasks, nil
+	}
+	tasks, err := s.taskRepo.GetByLeagueAndStatus(*leagueID, enums.ScheduledTaskStatusDeadLetter)
+	if err != nil {
+		log.Printf("ERROR: (SchedulerService: GetDeadLetterTasks) - could not fetch dead-letter tasks for league %s: %v\n", *leagueID, err)
+		return nil, types.ErrInternalService
 	}
+	return tasks, nil
+}
 
-	var leaguesInTransferWindow []*models.League
-	// Leagues in regular season or those that are bracket only; No transfer credit accrual during playoffs planned
-	var leaguesInSeasonOrBracketOnly []*models.League
-	for _, league := range leagues {
-		if league.Format == nil {
-			log.Printf("WARN: (SchedulerService: Start) - League %s has nil Format. Skipping.\n", league.ID)
+// ListPendingTasks returns the tasks waiting to run, earliest first. A task already handed to a worker is
+// not pending anymore.
+func (s *schedulerServiceImpl) ListPendingTasks(leagueID *uuid.UUID) []models.ScheduledTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := make([]models.ScheduledTask, 0, len(s.taskMap))
+	for _, task := range s.taskMap {
+		if leagueID != nil && u.PayloadLeagueID(task.Payload) != *leagueID {
 			continue
 		}
-		if league.Status == enums.LeagueStatusTransferWindow {
-			leaguesInTransferWindow = append(leaguesInTransferWindow, &league)
-		} else if league.Status == enums.LeagueStatusRegularSeason {
-			leaguesInSeasonOrBracketOnly = append(leaguesInSeasonOrBracketOnly, &league)
-		} else if league.Format.SeasonType == enums.LeagueSeasonTypeBracketOnly &&
-			league.Status == enums.LeagueStatusPlayoffs {
-			leaguesInSeasonOrBracketOnly = append(leaguesInSeasonOrBracketOnly, &league)
+		record, err := taskRecord(task, enums.ScheduledTaskStatusPending)
+		if err != nil {
+			log.Printf("ERROR: (SchedulerService: ListPendingTasks) - could not encode payload of task %s: %v\n", task.ID, err)
+			continue
 		}
+		pending = append(pending, *record)
 	}
+	slices.SortFunc(pending, func(a, b models.ScheduledTask) int { return a.ExecuteAt.Compare(b.ExecuteAt) })
+	return pending
+}
 
-	// create task objects
-	for _, draft := range drafts {
-		turnTimeLimit := draft.TurnTimeLimit
-		turnStartTime := draft.CurrentTurnStartTime
-		turnEndTime := turnStartTime.Add(time.Duration(turnTimeLimit) * time.Minute)
-
-		newTask := &u.ScheduledTask{
-			ID:        fmt.Sprintf("%d_%s", u.TaskTypeDraftTurnTimeout, draft.ID),
-			ExecuteAt: turnEndTime,
-			Type:      u.TaskTypeDraftTurnTimeout,
-			Payload: u.PayloadDraftTurnTimeout{
-				DraftID:  draft.ID,
-				LeagueID: draft.LeagueID,
-				PlayerID: *draft.CurrentTurnMemberID,
-			},
-		}
-		heap.Push(s.tasks, newTask)
-		s.taskMap[newTask.ID] = newTask
+// RescheduleTask moves a pending task to executeAt, which must be in the future. The task is registered
+// again through RegisterTask, replacing the pending one.
+func (s *schedulerServiceImpl) RescheduleTask(leagueID *uuid.UUID, taskID string, executeAt time.Time) (*models.ScheduledTask, error) {
+	if !executeAt.After(s.clock.Now()) {
+		return nil, types.ErrInvalidInput
 	}
+	return s.reregisterTask(leagueID, taskID, executeAt)
+}
 
-	for _, league := range leaguesInTransferWindow {
-		if league.Format.NextTransferWindowStart == nil {
-			log.Printf("WARN: (SchedulerService: Start) - League %s is in TransferWindow but NextTransferWindowStart is nil. Skipping.\n", league.ID)
-			continue
-		}
-		windowStartTime := league.Format.NextTransferWindowStart
-		windowDuration := league.Format.TransferWindowDuration
-		windowEndTime := windowStartTime.Add(time.Duration(windowDuration) * time.Minute)
-
-		newTask := &u.ScheduledTask{
-			ID:        fmt.Sprintf("%d_%s", u.TaskTypeTransferPeriodEnd, league.ID),
-			ExecuteAt: windowEndTime,
-			Type:      u.TaskTypeTransferPeriodEnd,
-			Payload: u.PayloadTransferPeriodEnd{
-				LeagueID: league.ID,
-			},
-		}
-		heap.Push(s.tasks, newTask)
-		s.taskMap[newTask.ID] = newTask
+// RunTaskNow makes a pending task due immediately, as if its time had come.
+func (s *schedulerServiceImpl) RunTaskNow(leagueID *uuid.UUID, taskID string) (*models.ScheduledTask, error) {
+	return s.reregisterTask(leagueID, taskID, s.clock.Now())
+}
+
+// CancelTask removes a pending task through DeregisterTask.
+func (s *schedulerServiceImpl) CancelTask(leagueID *uuid.UUID, taskID string) error {
+	if _, err := s.pendingTask(leagueID, taskID); err != nil {
+		return err
+	}
+	s.DeregisterTask(taskID)
+	return nil
+}
+
+func (s *schedulerServiceImpl) reregisterTask(leagueID *uuid.UUID, taskID string, executeAt time.Time) (*models.ScheduledTask, error) {
+	task, err := s.pendingTask(leagueID, taskID)
+	if err != nil {
+		return nil, err
 	}
+	task.ExecuteAt = executeAt
+	s.RegisterTask(&task)
+	log.Printf("LOG: (SchedulerService: reregisterTask) - Task %s moved to %s\n", taskID, executeAt)
+	return taskRecord(&task, enums.ScheduledTaskStatusPending)
+}
 
-	for _, league := range leaguesInSeasonOrBracketOnly {
-		if league.Format.NextTransferWindowStart == nil {
-			log.Printf("WARN: (SchedulerService: Start) - League %s is in Season/Bracket but NextTransferWindowStart is nil. Skipping.\n", league.ID)
+// pendingTask returns a copy of the pending task taskID, or ErrScheduledTaskNotFound if there is none
+// or it belongs to a league other than leagueID.
+func (s *schedulerServiceImpl) pendingTask(leagueID *uuid.UUID, taskID string) (u.ScheduledTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task, exists := s.taskMap[taskID]
+	if !exists || (leagueID != nil && u.PayloadLeagueID(task.Payload) != *leagueID) {
+		return u.ScheduledTask{}, types.ErrScheduledTaskNotFound
+	}
+	return *task, nil
+}
+
+// wake tells the scheduling loop to look at the heap again. A wake-up already pending covers this one.
+func (s *schedulerServiceImpl) wake() {
+	select {
+	case s.wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+// runSchedulerLoop is the main loop of the scheduler. It sleeps until the earliest task is due, or until
+// woken by a change to the heap, and hands due tasks to the workers one at a time. A task stays in the
+// heap, where DeregisterTask can still reach it, until a worker is free to take it.
+func (s *schedulerServiceImpl) runSchedulerLoop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		task, wait := s.nextTask(s.clock.Now())
+		if task == nil {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-s.wakeChan:
+			case <-s.stopChan:
+				log.Printf("LOG: (SchedulerService: runSchedulerLoop) - Scheduler received stop signal. Shutting down.\n")
+				return
+			}
 			continue
 		}
-		nextWindowStartTime := league.Format.NextTransferWindowStart
-
-		newTask := &u.ScheduledTask{
-			ID:        fmt.Sprintf("%d_%s", u.TaskTypeTransferPeriodStart, league.ID),
-			ExecuteAt: *nextWindowStartTime,
-			Type:      u.TaskTypeTransferPeriodStart,
-			Payload: u.PayloadTransferPeriodStart{
-				LeagueID: league.ID,
-			},
+
+		select {
+		case s.workChan <- task:
+		case <-s.stopChan:
+			// the task is still stored as PENDING, so it runs after the next start
+			log.Printf("LOG: (SchedulerService: runSchedulerLoop) - Scheduler received stop signal. Shutting down.\n")
+			return
 		}
-		heap.Push(s.tasks, newTask)
-		s.taskMap[newTask.ID] = newTask
 	}
+}
 
-	log.Printf("LOG: (SchedulerService: Start) - Running Scheduler\n")
-	go s.runSchedulerLoop()
+// nextTask pops the earliest task if it is due and this instance is the leader. Otherwise it returns how
+// long to wait before checking again.
+func (s *schedulerServiceImpl) nextTask(now time.Time) (*u.ScheduledTask, time.Duration) {
+	if !s.leader.Load() {
+		return nil, 24 * time.Hour // checkLeadership wakes the loop on taking over
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upcomingTask, exists := s.tasks.Peek()
+	if !exists {
+		return nil, 24 * time.Hour // RegisterTask wakes the loop before then
+	}
+	if upcomingTask.ExecuteAt.After(now) {
+		return nil, upcomingTask.ExecuteAt.Sub(now)
+	}
+	heap.Pop(s.tasks)
+	delete(s.taskMap, upcomingTask.ID)
+	s.running[upcomingTask.ID] = upcomingTask
+	return upcomingTask, 0
+}
 
-	return nil
+// runWorker runs due tasks until the scheduler stops.
+func (s *schedulerServiceImpl) runWorker() {
+	defer s.routines.Done()
+	for {
+		select {
+		case task := <-s.workChan:
+			s.runTask(task)
+		case <-s.stopChan:
+			return
+		}
+	}
 }
 
-// RegisterTask adds a new task to the scheduler. It is called by other services
-// to schedule a future action, such as the timeout for a draft turn.
-func (s *schedulerServiceImpl) RegisterTask(task *u.ScheduledTask) {
-	// add to the map for quick lookup and deregistration
+// runTask executes a due task and records the outcome. A failed task is put back in the heap with an
+// exponential backoff (taskRetryDelay) until it has failed maxTaskAttempts times, or straight away for a
+// task that can never run; it is then stored as DEAD_LETTER for staff to look into.
+func (s *schedulerServiceImpl) runTask(task *u.ScheduledTask) {
+	if !s.leader.Load() {
+		// leadership was lost after the task was handed over; the new leader runs it
+		s.mu.Lock()
+		delete(s.running, task.ID)
+		s.idle.Broadcast()
+		if _, reregistered := s.taskMap[task.ID]; !reregistered {
+			heap.Push(s.tasks, task)
+			s.taskMap[task.ID] = task
+		}
+		s.mu.Unlock()
+		return
+	}
+	log.Printf("LOG: (SchedulerService: runTask) - Executing task: %s (Type: %s, ExecuteAt: %s, Attempt: %d)\n", task.ID, task.Type, task.ExecuteAt, task.Attempts+1)
+	err := s.executeTask(task)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, task.ID)
+	s.idle.Broadcast()
+	if _, reregistered := s.taskMap[task.ID]; reregistered {
+		// the task registered its successor under the same ID (e.g. the next turn's timeout), whose
+		// record must stay PENDING
+		return
+	}
+	if err == nil {
+		s.updateTaskStatus(task.ID, enums.ScheduledTaskStatusCompleted)
+		return
+	}
+
+	task.Attempts++
+	if task.Attempts >= maxTaskAttempts || errors.Is(err, errUnrunnableTask) || errors.Is(err, errServiceNotSet) {
+		log.Printf("ERROR: (SchedulerService: runTask) - task %s (Type: %s) failed %d time(s), moving it to the dead-letter list: %v\n", task.ID, task.Type, task.Attempts, err)
+		s.saveTask(task, enums.ScheduledTaskStatusDeadLetter, err)
+		return
+	}
+
+	delay := taskRetryDelay(task.Attempts)
+	log.Printf("WARN: (SchedulerService: runTask) - task %s (Type: %s) failed, retrying in %s: %v\n", task.ID, task.Type, delay, err)
+	task.ExecuteAt = s.clock.Now().Add(delay)
+	heap.Push(s.tasks, task)
 	s.taskMap[task.ID] = task
-	// send to the channel for the scheduler loop to pick up
-	s.taskChan <- task
-	log.Printf("LOG: (SchedulerService: RegisterTask) - Task registered: %s (Type: %s, ExecuteAt: %s)\n", task.ID, task.Type, task.ExecuteAt)
+	s.saveTask(task, enums.ScheduledTaskStatusPending, err)
+	s.wake()
 }
 
-// runSchedulerLoop is the main loop of the scheduler that processes tasks.
-func (s *schedulerServiceImpl) runSchedulerLoop() {
-	var timer *time.Timer
+// AdvanceClock moves the virtual clock forward by d, one due task at a time: the clock is set to the due
+// time of the earliest task, the tasks due by then are run and waited for, and so on until nothing is due
+// before now+d. Tasks registered on the way run too if they come due in time, so a whole season plays out
+// in one call. It needs a u.VirtualClock (ErrTimeTravelDisabled otherwise), and this instance must be the
+// leader, as the clock is local to it.
+func (s *schedulerServiceImpl) AdvanceClock(d time.Duration) (time.Time, error) {
+	clock, ok := s.clock.(*u.VirtualClock)
+	if !ok {
+		return time.Time{}, types.ErrTimeTravelDisabled
+	}
+	if d <= 0 {
+		return time.Time{}, types.ErrInvalidInput
+	}
+	s.advanceMu.Lock()
+	defer s.advanceMu.Unlock()
+
+	target := clock.Now().Add(d)
 	for {
-		now := time.Now()
-		upcomingTask, exists := s.tasks.Peek()
-
-		if exists { // if there was a task
-			if upcomingTask.ExecuteAt.Before(now) {
-				// task is overdue; execute now
-				fmt.Printf("LOG: (SchedulerService: runSchedulerLoop) - A task is overdue. Executing now...\n")
-				timer = time.NewTimer(0) // fire new timer immediately to execute task
-			} else {
-				// the task is not due yet; wait till due
-				waitDuration := upcomingTask.ExecuteAt.Sub(now)
-				timer = time.NewTimer(waitDuration)
-				fmt.Printf("LOG: (SchedulerService: runSchedulerLoop) - Task(s) are scheduled but not due. Earliest due task in: %s\n", waitDuration)
-				s.tasks.Print()
-			}
-		} else {
-			// no tasks on the priority queue, wait for a task
-			fmt.Printf("LOG: (SchedulerService: runSchedulerLoop) - no tasks on the queue, waiting...\n")
-			timer = time.NewTimer(time.Hour * 24 * 365 * 10) // long ahh time
+		if !s.leader.Load() {
+			log.Printf("LOG: (SchedulerService: AdvanceClock) - this instance is not the scheduler leader\n")
+			return time.Time{}, types.ErrInvalidState
+		}
+		s.mu.Lock()
+		next, exists := s.tasks.Peek()
+		s.mu.Unlock()
+		if !exists || next.ExecuteAt.After(target) {
+			break
+		}
+		now := clock.AdvanceTo(next.ExecuteAt)
+		for task, _ := s.nextTask(now); task != nil; task, _ = s.nextTask(now) {
+			s.runTask(task)
 		}
+		s.waitForRunningTasks() // those the scheduling loop handed to the workers meanwhile
+	}
+
+	now := clock.AdvanceTo(target)
+	s.wake()
+	log.Printf("LOG: (SchedulerService: AdvanceClock) - Clock moved forward %s to %s\n", d, now)
+	return now, nil
+}
 
+// waitForRunningTasks blocks until no task is running.
+func (s *schedulerServiceImpl) waitForRunningTasks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.running) > 0 {
+		s.idle.Wait()
+	}
+}
+
+// runHousekeeping keeps leadership and the in-memory schedule up to date until the scheduler stops.
+func (s *schedulerServiceImpl) runHousekeeping() {
+	defer s.routines.Done()
+	ticker := time.NewTicker(housekeepingInterval)
+	defer ticker.Stop()
+	for {
 		select {
-		case newTask := <-s.taskChan:
-			// a new task has been submitted by another service
-			fmt.Printf("LOG: Scheduler recieved a new task: %s (Type: %s, ExecuteAt: %s)\n", newTask.ID, newTask.Type, newTask.ExecuteAt)
-			heap.Push(s.tasks, newTask)
-		case <-s.rescheduleChan:
-			fmt.Println("LOG: (SchedulerService: runSchedulerLoop) - Reschedule signal received. Re-evaluating next task.")
-			// nothing else needs to be done here. timer will be rescheduled in the following iteration
-			continue
-		case <-timer.C:
-			// timer fired; execute the scheduled task
-			task := heap.Pop(s.tasks).(*u.ScheduledTask)
-			fmt.Printf("LOG: Scheduler executing task: %s (Type: %s, ExecuteAt: %s)\n", task.ID, task.Type, task.ExecuteAt)
-			// Execute the task using the injected DraftTaskExecutor
-			s.executeTask(task)
-			delete(s.taskMap, task.ID)
+		case <-ticker.C:
+			s.checkLeadership()
+			s.mu.Lock()
+			s.flushWrites()
+			s.mu.Unlock()
+			s.syncTasks()
 		case <-s.stopChan:
-			// currently nothing sends a signal to this channel
-			// Stop() call was made
-			fmt.Printf("LOG: Scheduler received stop signal. Shutting Down. Scheduler can be restarted by restarting the server.\n")
-			if timer != nil {
-				timer.Stop()
-			}
-			return // stop goroutine
+			return
 		}
 	}
 }
 
-// DeregisterTask removes a task from the scheduler. This is called when a task
-// is completed ahead of schedule, for example, when a player makes a draft pick
-// before their turn timer expires.
-func (s *schedulerServiceImpl) DeregisterTask(taskID string) {
-	task, exists := s.taskMap[taskID]
-	if !exists {
-		log.Printf("WARN: (SchedulerService: DeregisterTask) - Attempted to deregister non-existent task: %s\n", taskID)
+// checkLeadership takes leadership if no instance holds it, or checks this instance still does. An error
+// counts as not being the leader, so two instances never run tasks at once.
+func (s *schedulerServiceImpl) checkLeadership() {
+	held, err := s.leaderLock.TryAcquire()
+	if err != nil {
+		log.Printf("ERROR: (SchedulerService: checkLeadership) - %v\n", err)
+	}
+	wasLeader := s.leader.Swap(held)
+	switch {
+	case held && !wasLeader:
+		log.Printf("LOG: (SchedulerService: checkLeadership) - This instance is now the scheduler leader.\n")
+		s.wake()
+	case !held && wasLeader:
+		log.Printf("WARN: (SchedulerService: checkLeadership) - Lost scheduler leadership; tasks will run on another instance.\n")
+	}
+}
+
+// syncTasks applies the writes other instances made to taskRepo since the last sync: tasks they
+// registered are added to the heap, and tasks they cancelled or ran are dropped from it. Writes of this
+// instance are already in memory, so rows this instance wrote after the read began are skipped as the
+// read may predate them, as are tasks a worker is running.
+func (s *schedulerServiceImpl) syncTasks() {
+	readAt := time.Now()
+	s.mu.Lock()
+	since := s.syncedUpTo.Add(-taskSyncOverlap)
+	s.mu.Unlock()
+	records, err := s.taskRepo.GetUpdatedSince(since)
+	if err != nil {
+		log.Printf("ERROR: (SchedulerService: syncTasks) - could not fetch task changes: %v\n", err)
 		return
 	}
 
-	// Remove from the heap
-	heap.Remove(s.tasks, task.Index)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, record := range records {
+		taskID := record.SchedulerTaskID()
+		if writtenAt, ok := s.localWrites[taskID]; ok && writtenAt.After(readAt) {
+			continue
+		}
+		if _, unsaved := s.failedWrites[taskID]; unsaved {
+			continue // the row is older than the task in memory
+		}
+		if _, running := s.running[taskID]; running {
+			continue
+		}
+		if existing, pending := s.taskMap[taskID]; pending {
+			heap.Remove(s.tasks, existing.Index)
+			delete(s.taskMap, taskID)
+		}
+		if record.Status != enums.ScheduledTaskStatusPending {
+			continue
+		}
+		task, err := taskFromRecord(&record)
+		if err != nil {
+			log.Printf("ERROR: (SchedulerService: syncTasks) - could not restore task %s: %v. Skipping.\n", record.ID, err)
+			continue
+		}
+		heap.Push(s.tasks, task)
+		s.taskMap[task.ID] = task
+	}
+	for id, writtenAt := range s.localWrites {
+		if writtenAt.Before(since) {
+			delete(s.localWrites, id)
+		}
+	}
+	s.syncedUpTo = readAt
+	s.wake()
+}
 
-	// Remove from the map
-	delete(s.taskMap, taskID)
-	log.Printf("LOG: (SchedulerService: DeregisterTask) - Task deregistered: %s\n", taskID)
+// taskRetryDelay is how long to wait before running a task again after its attempts-th failure.
+func taskRetryDelay(attempts int) time.Duration {
+	delay := taskRetryBaseDelay << (attempts - 1)
+	if delay <= 0 || delay > taskRetryMaxDelay {
+		return taskRetryMaxDelay
+	}
+	return delay
+}
 
-	s.rescheduleChan <- struct{}{}
+// saveTask writes task through to the scheduled_tasks table with status, and the error of its last run if
+// any. Re-registering a task ID overwrites its record; a dead letter replaces it with a record of its own
+// that outlives the task ID's reuse. The caller holds s.mu.
+func (s *schedulerServiceImpl) saveTask(task *u.ScheduledTask, status enums.ScheduledTaskStatus, lastErr error) {
+	record, err := taskRecord(task, status)
+	if err != nil {
+		log.Printf("ERROR: (SchedulerService: saveTask) - could not encode payload of task %s: %v\n", task.ID, err)
+		return
+	}
+	if lastErr != nil {
+		message := lastErr.Error()
+		record.LastError = &message
+	}
+	if status != enums.ScheduledTaskStatusPending {
+		now := time.Now()
+		record.CompletedAt = &now
+	}
+	if status == enums.ScheduledTaskStatusDeadLetter {
+		record.ID = fmt.Sprintf("%s_dead_%s", task.ID, uuid.New())
+		s.writeTask(task.ID, func() error { return s.taskRepo.DeadLetter(record) })
+		return
+	}
+	s.writeTask(task.ID, func() error { return s.taskRepo.Save(record) })
+}
+
+// updateTaskStatus records how a stored task ended. The caller holds s.mu.
+func (s *schedulerServiceImpl) updateTaskStatus(taskID string, status enums.ScheduledTaskStatus) {
+	s.writeTask(taskID, func() error { return s.taskRepo.UpdateStatus(taskID, status) })
+}
+
+// writeTask runs a write of taskID to taskRepo. The in-memory schedule is what runs tasks, so a failed
+// write is only kept for flushWrites to retry, until a later write of the same task supersedes it.
+// The caller holds s.mu.
+func (s *schedulerServiceImpl) writeTask(taskID string, write func() error) {
+	s.localWrites[taskID] = time.Now()
+	if err := write(); err != nil {
+		log.Printf("ERROR: (SchedulerService: writeTask) - could not store task %s, will retry: %v\n", taskID, err)
+		s.failedWrites[taskID] = write
+		return
+	}
+	delete(s.failedWrites, taskID)
+}
+
+// flushWrites retries the writes to taskRepo that failed. The caller holds s.mu.
+func (s *schedulerServiceImpl) flushWrites() {
+	for taskID, write := range s.failedWrites {
+		s.writeTask(taskID, write)
+	}
+}
+
+// taskRecord builds the stored form of task.
+func taskRecord(task *u.ScheduledTask, status enums.ScheduledTaskStatus) (*models.ScheduledTask, error) {
+	payload, err := json.Marshal(task.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ScheduledTask{
+		ID:        task.ID,
+		TaskID:    task.ID,
+		Type:      task.Type.String(),
+		LeagueID:  u.PayloadLeagueID(task.Payload),
+		Payload:   string(payload),
+		ExecuteAt: task.ExecuteAt,
+		Status:    status,
+		Attempts:  task.Attempts,
+	}, nil
 }
 
-// executeTask checks the type of the task to execute then makes the appropriate execute call for the task
-func (s *schedulerServiceImpl) executeTask(task *u.ScheduledTask) {
+// taskFromRecord rebuilds the in-memory task from its stored record.
+func taskFromRecord(record *models.ScheduledTask) (*u.ScheduledTask, error) {
+	taskType, err := u.ParseTaskType(record.Type)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := u.DecodePayload(taskType, []byte(record.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s payload: %w", record.Type, err)
+	}
+	return &u.ScheduledTask{
+		ID:        record.SchedulerTaskID(),
+		ExecuteAt: record.ExecuteAt,
+		Type:      taskType,
+		Payload:   payload,
+		Attempts:  record.Attempts,
+	}, nil
+}
+
+// executeTask checks the type of the task to execute then makes the appropriate execute call for the task.
+// A task that returns an error is retried, see runTask.
+func (s *schedulerServiceImpl) executeTask(task *u.ScheduledTask) error {
 	switch task.Type {
 	case u.TaskTypeDraftTurnTimeout:
 		if payload, ok := task.Payload.(u.PayloadDraftTurnTimeout); ok {
 			log.Printf("LOG: (SchedulerService: executeTask) - Draft turn timeout for LeagueID: %s, PlayerID: %s\n", payload.LeagueID, payload.PlayerID)
 			if s.draftService == nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - DraftService is not set. Cannot auto-skip turn for LeagueID: %s, PlayerID: %s\n", payload.LeagueID, payload.PlayerID)
-				return
+				return errServiceNotSet
 			}
 
-			if err := s.draftService.AutoSkipTurn(payload.PlayerID, payload.LeagueID); err != nil {
+			// AutoSkipTurn follows the league's TurnTimeoutPolicy (auto-pick, skip or pause)
+			err := s.draftService.AutoSkipTurn(payload.PlayerID, payload.LeagueID, payload.PickNumber)
+			if errors.Is(err, types.ErrDraftPausedForIntervention) {
+				// pausing is how the timeout was handled; staff take it from here
+				log.Printf("LOG: (SchedulerService: executeTask) - Draft for LeagueID: %s paused for intervention\n", payload.LeagueID)
+				return nil
+			}
+			if err != nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - error occured in AutoSkipTurn: %v\n", err)
-				return
+				return err
 			}
 		} else {
 			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for DraftTurnTimeout task ID %s\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
 		}
 
 	case u.TaskTypeTransferPeriodEnd:
@@ -309,15 +692,16 @@ func (s *schedulerServiceImpl) executeTask(task *u.ScheduledTask) {
 			log.Printf("LOG: (SchedulerService: executeTask) - Transfer period end for LeagueID: %s\n", payload.LeagueID)
 			if s.transferService == nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - TransferService is not set. Cannot end transfer period for LeagueID: %s\n", payload.LeagueID)
-				return
+				return errServiceNotSet
 			}
 
 			if err := s.transferService.EndTransferPeriod(payload.LeagueID); err != nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - error occured in EndTransferPeriod: %v\n", err)
-				return
+				return err
 			}
 		} else {
 			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for TransferPeriodEnd task ID %s\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
 		}
 
 	case u.TaskTypeTransferPeriodStart:
@@ -325,37 +709,102 @@ func (s *schedulerServiceImpl) executeTask(task *u.ScheduledTask) {
 			log.Printf("LOG: (SchedulerService: executeTask) - Transfer Window Start for LeagueID: %s\n", payload.LeagueID)
 			if s.transferService == nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - TransferService is not set. Cannot start transfer period for LeagueID: %s\n", payload.LeagueID)
-				return
+				return errServiceNotSet
 			}
 			if err := s.transferService.StartTransferPeriod(payload.LeagueID); err != nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - error occured in StartTransferPeriod: %v\n", err)
-				return
+				return err
 			}
 		} else {
 			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for StartTransferPeriod task ID %s. Expected PayloadTransferCreditAccrual.\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
 		}
 	case u.TaskTypeLeagueWeeklyTick:
 		if payload, ok := task.Payload.(u.PayloadLeagueWeeklyTick); ok {
 			log.Printf("LOG: (SchedulerService: executeTask) - League weekly tick for LeagueID: %s\n", payload.LeagueID)
 			if s.leagueService == nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - LeagueService is not set. Cannot process weekly tick for LeagueID: %s\n", payload.LeagueID)
-				return
+				return errServiceNotSet
 			}
 			if err := s.leagueService.ProcessWeeklyTick(payload.LeagueID); err != nil {
 				log.Printf("ERROR: (SchedulerService: executeTask) - error occurred in ProcessWeeklyTick: %v\n", err)
-				return
+				return err
 			}
 		} else {
 			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for LeagueWeeklyTick task ID %s.\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
+		}
+	case u.TaskTypeAuctionLotClose:
+		if payload, ok := task.Payload.(u.PayloadAuctionLotClose); ok {
+			log.Printf("LOG: (SchedulerService: executeTask) - Auction lot close for LeagueID: %s, PoolEntryID: %s\n", payload.LeagueID, payload.PoolEntryID)
+			if s.draftService == nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - DraftService is not set. Cannot close auction lot for LeagueID: %s\n", payload.LeagueID)
+				return errServiceNotSet
+			}
+			if err := s.draftService.CloseAuctionLot(payload.LeagueID, payload.PoolEntryID); err != nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - error occurred in CloseAuctionLot: %v\n", err)
+				return err
+			}
+		} else {
+			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for AuctionLotClose task ID %s.\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
+		}
+	case u.TaskTypeDraftTurnReminder:
+		if payload, ok := task.Payload.(u.PayloadDraftTurnReminder); ok {
+			log.Printf("LOG: (SchedulerService: executeTask) - Draft turn reminder (%d%%) for LeagueID: %s, PlayerID: %s\n", payload.Percent, payload.LeagueID, payload.PlayerID)
+			if s.draftService == nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - DraftService is not set. Cannot send turn reminder for LeagueID: %s\n", payload.LeagueID)
+				return errServiceNotSet
+			}
+			if err := s.draftService.SendTurnReminder(payload.LeagueID, payload.PlayerID, payload.PickNumber, payload.Percent); err != nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - error occurred in SendTurnReminder: %v\n", err)
+				return err
+			}
+		} else {
+			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for DraftTurnReminder task ID %s.\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
+		}
+	case u.TaskTypeDraftStart:
+		if payload, ok := task.Payload.(u.PayloadDraftStart); ok {
+			log.Printf("LOG: (SchedulerService: executeTask) - Scheduled draft start for LeagueID: %s\n", payload.LeagueID)
+			if s.draftService == nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - DraftService is not set. Cannot start draft for LeagueID: %s\n", payload.LeagueID)
+				return errServiceNotSet
+			}
+			// failures are reported to league staff by RunScheduledDraftStart
+			if err := s.draftService.RunScheduledDraftStart(payload.LeagueID, payload.ScheduledFor); err != nil {
+				log.Printf("ERROR: (SchedulerService: executeTask) - error occurred in RunScheduledDraftStart: %v\n", err)
+				return err
+			}
+		} else {
+			log.Printf("ERROR: (SchedulerService: executeTask) - Invalid payload type for DraftStart task ID %s.\n", task.ID)
+			return fmt.Errorf("%w: invalid payload type %T", errUnrunnableTask, task.Payload)
 		}
 	default:
 		log.Printf("ERROR: (SchedulerService: executeTask) - Unknown task type: %d for task ID %s\n", task.Type, task.ID)
+		return fmt.Errorf("%w: unknown task type %d", errUnrunnableTask, task.Type)
 	}
+	return nil
 }
 
-// Stop gracefully shuts down the scheduler's background goroutine.
+// Stop shuts down the scheduling loop and the workers, waiting for tasks already running to finish, and
+// retries any write to taskRepo that failed so the next Start (here or on another instance) finds every
+// task as it is in memory. It then gives up leadership so another instance takes over without waiting for
+// this one's session to end.
 func (s *schedulerServiceImpl) Stop() {
-	// Just sends struct{} to the stopChan
-	// which will shut down the go routine
-	s.stopChan <- struct{}{}
+	s.stopOnce.Do(func() { close(s.stopChan) })
+	s.routines.Wait()
+
+	s.mu.Lock()
+	s.flushWrites()
+	if len(s.failedWrites) > 0 {
+		log.Printf("ERROR: (SchedulerService: Stop) - %d task(s) could not be stored and will be lost\n", len(s.failedWrites))
+	}
+	s.mu.Unlock()
+
+	if s.leader.Swap(false) {
+		if err := s.leaderLock.Release(); err != nil {
+			log.Printf("ERROR: (SchedulerService: Stop) - could not release leadership: %v\n", err)
+		}
+	}
 }
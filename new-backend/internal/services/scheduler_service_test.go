@@ -0,0 +1,533 @@
+package services_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	mock_services "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupSchedulerServiceTest() (services.SchedulerService, *mock_repositories.MockScheduledTaskRepository, *mock_services.MockDraftService) {
+	taskRepo := new(mock_repositories.MockScheduledTaskRepository)
+	draftService := new(mock_services.MockDraftService)
+	// a single instance, which is always the leader
+	leaderLock := new(mock_repositories.MockLeaderLock)
+	leaderLock.On("TryAcquire").Return(true, nil).Maybe()
+	leaderLock.On("Release").Return(nil).Maybe()
+	scheduler := services.NewSchedulerService(&utils.TaskHeap{}, taskRepo, leaderLock)
+	scheduler.SetDraftService(draftService)
+	return scheduler, taskRepo, draftService
+}
+
+func TestSchedulerService_TaskPersistence(t *testing.T) {
+	leagueID := uuid.New()
+	playerID := uuid.New()
+	timeoutID := "0_" + leagueID.String()
+
+	t.Run("RegisterTask stores the task as PENDING", func(t *testing.T) {
+		scheduler, taskRepo, _ := setupSchedulerServiceTest()
+		executeAt := time.Now().Add(time.Hour)
+		taskRepo.On("Save", mock.MatchedBy(func(record *models.ScheduledTask) bool {
+			return record.ID == timeoutID && record.Type == "DRAFT_TURN_TIMEOUT" &&
+				record.Status == enums.ScheduledTaskStatusPending && record.ExecuteAt.Equal(executeAt)
+		})).Return(nil).Once()
+
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        timeoutID,
+			ExecuteAt: executeAt,
+			Type:      utils.TaskTypeDraftTurnTimeout,
+			Payload:   utils.PayloadDraftTurnTimeout{LeagueID: leagueID, PlayerID: playerID},
+		})
+
+		taskRepo.AssertExpectations(t)
+	})
+
+	t.Run("Start reloads pending tasks and records how they ran", func(t *testing.T) {
+		scheduler, taskRepo, draftService := setupSchedulerServiceTest()
+		reminderID := "5_" + leagueID.String() + "_50"
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return([]models.ScheduledTask{
+			{
+				ID:        timeoutID,
+				Type:      "DRAFT_TURN_TIMEOUT",
+				Payload:   `{"LeagueID":"` + leagueID.String() + `","PlayerID":"` + playerID.String() + `"}`,
+				ExecuteAt: time.Now().Add(-time.Minute), // came due while the server was down
+			},
+			{
+				ID:        reminderID,
+				Type:      "DRAFT_TURN_REMINDER",
+				Payload:   `{"LeagueID":"` + leagueID.String() + `","PlayerID":"` + playerID.String() + `","PickNumber":3,"Percent":50}`,
+				ExecuteAt: time.Now().Add(-time.Second),
+			},
+			{ID: "unknown", Type: "NOT_A_TASK", Payload: `{}`, ExecuteAt: time.Now()},
+		}, nil).Once()
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(nil).Once()
+		draftService.On("SendTurnReminder", leagueID, playerID, 3, 50).Return(errors.New("webhook down")).Once()
+		ran := make(chan struct{}, 2)
+		signal := func(mock.Arguments) { ran <- struct{}{} }
+		taskRepo.On("UpdateStatus", timeoutID, enums.ScheduledTaskStatusCompleted).Return(nil).Run(signal).Once()
+		// the failed reminder goes back on the schedule with a backoff
+		taskRepo.On("Save", mock.MatchedBy(func(record *models.ScheduledTask) bool {
+			return record.ID == reminderID && record.Status == enums.ScheduledTaskStatusPending && record.Attempts == 1 &&
+				record.LastError != nil && *record.LastError == "webhook down" && record.ExecuteAt.After(time.Now()) &&
+				record.LeagueID == leagueID
+		})).Return(nil).Run(signal).Once()
+
+		assert.NoError(t, scheduler.Start())
+		waitForSignals(t, ran, 2)
+		scheduler.Stop()
+
+		taskRepo.AssertExpectations(t)
+		draftService.AssertExpectations(t)
+		taskRepo.AssertNotCalled(t, "UpdateStatus", "unknown", mock.Anything)
+	})
+
+	t.Run("A task out of attempts is dead-lettered", func(t *testing.T) {
+		scheduler, taskRepo, draftService := setupSchedulerServiceTest()
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return([]models.ScheduledTask{{
+			ID:        timeoutID,
+			Type:      "DRAFT_TURN_TIMEOUT",
+			Payload:   `{"LeagueID":"` + leagueID.String() + `","PlayerID":"` + playerID.String() + `"}`,
+			ExecuteAt: time.Now().Add(-time.Minute),
+			Attempts:  4,
+		}}, nil).Once()
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(errors.New("db down")).Once()
+		ran := make(chan struct{}, 1)
+		// stored under an ID of its own, so the next task registered as timeoutID can't overwrite it
+		taskRepo.On("DeadLetter", mock.MatchedBy(func(record *models.ScheduledTask) bool {
+			return record.TaskID == timeoutID && record.ID != timeoutID && record.Status == enums.ScheduledTaskStatusDeadLetter &&
+				record.Attempts == 5 && record.CompletedAt != nil && *record.LastError == "db down"
+		})).Return(nil).Run(func(mock.Arguments) { ran <- struct{}{} }).Once()
+
+		assert.NoError(t, scheduler.Start())
+		waitForSignals(t, ran, 1)
+		scheduler.Stop()
+
+		taskRepo.AssertExpectations(t)
+		draftService.AssertExpectations(t)
+	})
+
+	t.Run("A timeout that pauses the draft completes without a retry", func(t *testing.T) {
+		scheduler, taskRepo, draftService := setupSchedulerServiceTest()
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return([]models.ScheduledTask{{
+			ID:        timeoutID,
+			Type:      "DRAFT_TURN_TIMEOUT",
+			Payload:   `{"LeagueID":"` + leagueID.String() + `","PlayerID":"` + playerID.String() + `"}`,
+			ExecuteAt: time.Now().Add(-time.Minute),
+		}}, nil).Once()
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(types.ErrDraftPausedForIntervention).Once()
+		ran := make(chan struct{}, 1)
+		taskRepo.On("UpdateStatus", timeoutID, enums.ScheduledTaskStatusCompleted).Return(nil).
+			Run(func(mock.Arguments) { ran <- struct{}{} }).Once()
+
+		assert.NoError(t, scheduler.Start())
+		waitForSignals(t, ran, 1)
+		scheduler.Stop()
+
+		taskRepo.AssertExpectations(t)
+		taskRepo.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("Stop retries writes that failed so the next start finds the task", func(t *testing.T) {
+		scheduler, taskRepo, _ := setupSchedulerServiceTest()
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(nil, nil).Once()
+		isTimeout := mock.MatchedBy(func(record *models.ScheduledTask) bool { return record.ID == timeoutID })
+		taskRepo.On("Save", isTimeout).Return(errors.New("db down")).Once()
+		taskRepo.On("Save", isTimeout).Return(nil).Once()
+		assert.NoError(t, scheduler.Start())
+
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        timeoutID,
+			ExecuteAt: time.Now().Add(time.Hour),
+			Type:      utils.TaskTypeDraftTurnTimeout,
+			Payload:   utils.PayloadDraftTurnTimeout{LeagueID: leagueID, PlayerID: playerID},
+		})
+		scheduler.Stop()
+
+		taskRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetDeadLetterTasks lists the league's dead-lettered tasks", func(t *testing.T) {
+		scheduler, taskRepo, _ := setupSchedulerServiceTest()
+		deadLetters := []models.ScheduledTask{{ID: timeoutID, LeagueID: leagueID, Status: enums.ScheduledTaskStatusDeadLetter}}
+		taskRepo.On("GetByLeagueAndStatus", leagueID, enums.ScheduledTaskStatusDeadLetter).Return(deadLetters, nil).Once()
+
+		tasks, err := scheduler.GetDeadLetterTasks(&leagueID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, deadLetters, tasks)
+	})
+
+	t.Run("GetDeadLetterTasks without a league lists every dead-lettered task", func(t *testing.T) {
+		scheduler, taskRepo, _ := setupSchedulerServiceTest()
+		deadLetters := []models.ScheduledTask{
+			{ID: timeoutID, LeagueID: leagueID, Status: enums.ScheduledTaskStatusDeadLetter},
+			{ID: "other", LeagueID: uuid.New(), Status: enums.ScheduledTaskStatusDeadLetter},
+		}
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusDeadLetter).Return(deadLetters, nil).Once()
+
+		tasks, err := scheduler.GetDeadLetterTasks(nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, deadLetters, tasks)
+		taskRepo.AssertNotCalled(t, "GetByLeagueAndStatus", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSchedulerService_TaskManagement(t *testing.T) {
+	leagueID := uuid.New()
+	otherLeagueID := uuid.New()
+	playerID := uuid.New()
+	timeoutID := "0_" + leagueID.String()
+	tickID := "3_" + otherLeagueID.String()
+
+	setup := func() (services.SchedulerService, *mock_repositories.MockScheduledTaskRepository, *mock_services.MockDraftService) {
+		scheduler, taskRepo, draftService := setupSchedulerServiceTest()
+		taskRepo.On("Save", mock.Anything).Return(nil)
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        tickID,
+			ExecuteAt: time.Now().Add(2 * time.Hour),
+			Type:      utils.TaskTypeLeagueWeeklyTick,
+			Payload:   utils.PayloadLeagueWeeklyTick{LeagueID: otherLeagueID},
+		})
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        timeoutID,
+			ExecuteAt: time.Now().Add(time.Hour),
+			Type:      utils.TaskTypeDraftTurnTimeout,
+			Payload:   utils.PayloadDraftTurnTimeout{LeagueID: leagueID, PlayerID: playerID},
+		})
+		return scheduler, taskRepo, draftService
+	}
+
+	t.Run("ListPendingTasks lists a league's tasks, or every league's", func(t *testing.T) {
+		scheduler, _, _ := setup()
+
+		all := scheduler.ListPendingTasks(nil)
+		league := scheduler.ListPendingTasks(&leagueID)
+
+		assert.Len(t, all, 2)
+		assert.Equal(t, timeoutID, all[0].ID) // earliest first
+		assert.Len(t, league, 1)
+		assert.Equal(t, "DRAFT_TURN_TIMEOUT", league[0].Type)
+		assert.JSONEq(t, `{"DraftID":"00000000-0000-0000-0000-000000000000","LeagueID":"`+leagueID.String()+`","PlayerID":"`+playerID.String()+`","PickNumber":0}`, league[0].Payload)
+	})
+
+	t.Run("RescheduleTask re-registers the task at the new time", func(t *testing.T) {
+		scheduler, taskRepo, _ := setup()
+		executeAt := time.Now().Add(3 * time.Hour)
+
+		task, err := scheduler.RescheduleTask(&leagueID, timeoutID, executeAt)
+
+		assert.NoError(t, err)
+		assert.True(t, task.ExecuteAt.Equal(executeAt))
+		taskRepo.AssertCalled(t, "Save", mock.MatchedBy(func(record *models.ScheduledTask) bool {
+			return record.ID == timeoutID && record.ExecuteAt.Equal(executeAt)
+		}))
+		pending := scheduler.ListPendingTasks(nil)
+		assert.Len(t, pending, 2)
+		assert.Equal(t, tickID, pending[0].ID)
+	})
+
+	t.Run("RescheduleTask rejects a time in the past", func(t *testing.T) {
+		scheduler, _, _ := setup()
+
+		_, err := scheduler.RescheduleTask(&leagueID, timeoutID, time.Now().Add(-time.Minute))
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+
+	t.Run("Staff cannot touch another league's tasks", func(t *testing.T) {
+		scheduler, taskRepo, _ := setup()
+
+		_, rescheduleErr := scheduler.RescheduleTask(&leagueID, tickID, time.Now().Add(time.Hour))
+		_, runErr := scheduler.RunTaskNow(&leagueID, tickID)
+		cancelErr := scheduler.CancelTask(&leagueID, tickID)
+
+		assert.ErrorIs(t, rescheduleErr, types.ErrScheduledTaskNotFound)
+		assert.ErrorIs(t, runErr, types.ErrScheduledTaskNotFound)
+		assert.ErrorIs(t, cancelErr, types.ErrScheduledTaskNotFound)
+		taskRepo.AssertNotCalled(t, "UpdateStatus", tickID, mock.Anything)
+	})
+
+	t.Run("CancelTask deregisters the task", func(t *testing.T) {
+		scheduler, taskRepo, _ := setup()
+		taskRepo.On("UpdateStatus", tickID, enums.ScheduledTaskStatusCancelled).Return(nil).Once()
+
+		err := scheduler.CancelTask(nil, tickID)
+
+		assert.NoError(t, err)
+		assert.Len(t, scheduler.ListPendingTasks(nil), 1)
+		taskRepo.AssertExpectations(t)
+	})
+
+	t.Run("RunTaskNow runs the task right away", func(t *testing.T) {
+		scheduler, taskRepo, draftService := setup()
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(nil, nil).Once()
+		ran := make(chan struct{}, 1)
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(nil).Once()
+		taskRepo.On("UpdateStatus", timeoutID, enums.ScheduledTaskStatusCompleted).Return(nil).Run(func(mock.Arguments) { ran <- struct{}{} }).Once()
+		assert.NoError(t, scheduler.Start())
+
+		_, err := scheduler.RunTaskNow(&leagueID, timeoutID)
+
+		assert.NoError(t, err)
+		waitForSignals(t, ran, 1)
+		scheduler.Stop()
+		draftService.AssertExpectations(t)
+		assert.Len(t, scheduler.ListPendingTasks(nil), 1)
+	})
+}
+
+func TestSchedulerService_LeaderElection(t *testing.T) {
+	leagueID := uuid.New()
+	playerID := uuid.New()
+	timeoutID := "0_" + leagueID.String()
+	overdue := []models.ScheduledTask{{
+		ID:        timeoutID,
+		Type:      "DRAFT_TURN_TIMEOUT",
+		Payload:   `{"LeagueID":"` + leagueID.String() + `","PlayerID":"` + playerID.String() + `"}`,
+		ExecuteAt: time.Now().Add(-time.Minute),
+	}}
+
+	t.Run("A follower keeps the schedule but runs nothing", func(t *testing.T) {
+		taskRepo := new(mock_repositories.MockScheduledTaskRepository)
+		draftService := new(mock_services.MockDraftService)
+		leaderLock := new(mock_repositories.MockLeaderLock)
+		scheduler := services.NewSchedulerService(&utils.TaskHeap{}, taskRepo, leaderLock)
+		scheduler.SetDraftService(draftService)
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(overdue, nil).Once()
+		leaderLock.On("TryAcquire").Return(false, nil)
+		taskRepo.On("Save", mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, scheduler.Start())
+		// followers still take API traffic, so tasks registered here are stored for the leader
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        "3_" + leagueID.String(),
+			ExecuteAt: time.Now(),
+			Type:      utils.TaskTypeLeagueWeeklyTick,
+			Payload:   utils.PayloadLeagueWeeklyTick{LeagueID: leagueID},
+		})
+		time.Sleep(50 * time.Millisecond)
+		scheduler.Stop()
+
+		draftService.AssertNotCalled(t, "AutoSkipTurn", mock.Anything, mock.Anything, mock.Anything)
+		assert.Len(t, scheduler.ListPendingTasks(nil), 2)
+		taskRepo.AssertExpectations(t)
+		leaderLock.AssertNotCalled(t, "Release")
+	})
+
+	t.Run("The leader gives up leadership when it stops", func(t *testing.T) {
+		taskRepo := new(mock_repositories.MockScheduledTaskRepository)
+		draftService := new(mock_services.MockDraftService)
+		leaderLock := new(mock_repositories.MockLeaderLock)
+		scheduler := services.NewSchedulerService(&utils.TaskHeap{}, taskRepo, leaderLock)
+		scheduler.SetDraftService(draftService)
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(overdue, nil).Once()
+		leaderLock.On("TryAcquire").Return(true, nil)
+		leaderLock.On("Release").Return(nil).Once()
+		ran := make(chan struct{}, 1)
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(nil).Once()
+		taskRepo.On("UpdateStatus", timeoutID, enums.ScheduledTaskStatusCompleted).Return(nil).Run(func(mock.Arguments) { ran <- struct{}{} }).Once()
+
+		assert.NoError(t, scheduler.Start())
+		waitForSignals(t, ran, 1)
+		scheduler.Stop()
+
+		draftService.AssertExpectations(t)
+		leaderLock.AssertExpectations(t)
+	})
+}
+
+func TestSchedulerService_TimeTravel(t *testing.T) {
+	leagueID := uuid.New()
+	playerID := uuid.New()
+
+	t.Run("AdvanceClock needs a virtual clock", func(t *testing.T) {
+		scheduler, _, _ := setupSchedulerServiceTest()
+
+		_, err := scheduler.AdvanceClock(time.Hour)
+
+		assert.ErrorIs(t, err, types.ErrTimeTravelDisabled)
+	})
+
+	t.Run("AdvanceClock runs what comes due on the way, in order", func(t *testing.T) {
+		scheduler, taskRepo, draftService := setupSchedulerServiceTest()
+		clock := utils.NewVirtualClock()
+		scheduler.SetClock(clock)
+		taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(nil, nil).Once()
+		taskRepo.On("Save", mock.Anything).Return(nil)
+		taskRepo.On("UpdateStatus", mock.Anything, enums.ScheduledTaskStatusCompleted).Return(nil)
+		assert.NoError(t, scheduler.Start())
+		defer scheduler.Stop()
+
+		startAt := clock.Now().Add(48 * time.Hour)
+		var ran []string
+		var ranAt []time.Time
+		draftService.On("RunScheduledDraftStart", leagueID, startAt).Return(nil).Run(func(mock.Arguments) {
+			ran = append(ran, "start")
+			ranAt = append(ranAt, clock.Now())
+			// the first turn's timeout, registered by the task itself, comes due before the clock stops
+			scheduler.RegisterTask(&utils.ScheduledTask{
+				ID:        "0_" + leagueID.String(),
+				ExecuteAt: clock.Now().Add(time.Hour),
+				Type:      utils.TaskTypeDraftTurnTimeout,
+				Payload:   utils.PayloadDraftTurnTimeout{LeagueID: leagueID, PlayerID: playerID},
+			})
+		}).Once()
+		draftService.On("AutoSkipTurn", playerID, leagueID, 0).Return(nil).Run(func(mock.Arguments) {
+			ran = append(ran, "timeout")
+			ranAt = append(ranAt, clock.Now())
+		}).Once()
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        "6_" + leagueID.String(),
+			ExecuteAt: startAt,
+			Type:      utils.TaskTypeDraftStart,
+			Payload:   utils.PayloadDraftStart{LeagueID: leagueID, ScheduledFor: startAt},
+		})
+		scheduler.RegisterTask(&utils.ScheduledTask{
+			ID:        "3_" + leagueID.String(),
+			ExecuteAt: startAt.Add(7 * 24 * time.Hour),
+			Type:      utils.TaskTypeLeagueWeeklyTick,
+			Payload:   utils.PayloadLeagueWeeklyTick{LeagueID: leagueID},
+		})
+
+		now, err := scheduler.AdvanceClock(50 * time.Hour)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"start", "timeout"}, ran)
+		assert.WithinDuration(t, startAt, ranAt[0], time.Second)
+		assert.WithinDuration(t, startAt.Add(time.Hour), ranAt[1], time.Second)
+		assert.WithinDuration(t, time.Now().Add(50*time.Hour), now, time.Second)
+		draftService.AssertExpectations(t)
+		// the weekly tick isn't due yet
+		assert.Len(t, scheduler.ListPendingTasks(nil), 1)
+	})
+}
+
+func TestSchedulerService_Concurrency(t *testing.T) {
+	scheduler, taskRepo, _ := setupSchedulerServiceTest()
+	taskRepo.On("GetByStatus", enums.ScheduledTaskStatusPending).Return(nil, nil).Once()
+	taskRepo.On("Save", mock.Anything).Return(nil)
+	taskRepo.On("UpdateStatus", mock.Anything, enums.ScheduledTaskStatusCancelled).Return(nil)
+	assert.NoError(t, scheduler.Start())
+	defer scheduler.Stop()
+
+	// request goroutines register and deregister tasks while the loop is running
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			leagueID := uuid.New()
+			taskID := fmt.Sprintf("3_%s_%d", leagueID, i)
+			for range 10 {
+				scheduler.RegisterTask(&utils.ScheduledTask{
+					ID:        taskID,
+					ExecuteAt: time.Now().Add(time.Hour),
+					Type:      utils.TaskTypeLeagueWeeklyTick,
+					Payload:   utils.PayloadLeagueWeeklyTick{LeagueID: leagueID},
+				})
+				scheduler.DeregisterTask(taskID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	taskRepo.AssertNumberOfCalls(t, "Save", 200)
+	taskRepo.AssertNumberOfCalls(t, "UpdateStatus", 200)
+}
+
+func TestBackfillScheduledTasks(t *testing.T) {
+	leagueID := uuid.New()
+	draftLeagueID := uuid.New()
+	memberID := uuid.New()
+	turnStart := time.Now().Add(-time.Minute)
+	nextTick := time.Now().Add(48 * time.Hour)
+	windowStart := time.Now().Add(-time.Hour)
+
+	t.Run("Rebuilds the tasks of drafts and leagues into an empty table", func(t *testing.T) {
+		taskRepo := new(mock_repositories.MockScheduledTaskRepository)
+		leagueRepo := new(mock_repositories.MockLeagueRepository)
+		draftRepo := new(mock_repositories.MockDraftRepository)
+		league := models.League{
+			ID:             leagueID,
+			Status:         enums.LeagueStatusTransferWindow,
+			NextWeeklyTick: &nextTick,
+			Format:         &types.LeagueFormat{AllowTransfers: true, NextTransferWindowStart: &windowStart, TransferWindowDuration: 24},
+		}
+
+		taskRepo.On("Count").Return(int64(0), nil).Once()
+		draftRepo.On("GetAllDraftsByStatus", enums.DraftStatusOngoing).Return([]models.Draft{{
+			ID:                   uuid.New(),
+			LeagueID:             draftLeagueID,
+			Status:               enums.DraftStatusOngoing,
+			CurrentRound:         1,
+			CurrentPickOnClock:   4,
+			CurrentTurnMemberID:  &memberID,
+			CurrentTurnStartTime: &turnStart,
+			TurnTimeLimit:        60,
+		}}, nil).Once()
+		leagueRepo.On("GetLeaguesWithScheduledDraftStart").Return([]models.League{}, nil).Once()
+		leagueRepo.On("GetLeaguesByStatuses", mock.Anything).Return([]models.League{league}, nil).Once()
+		leagueRepo.On("GetLeaguesThatAllowTransfers").Return([]models.League{league}, nil).Once()
+		var saved []*models.ScheduledTask
+		taskRepo.On("Save", mock.AnythingOfType("*models.ScheduledTask")).Run(func(args mock.Arguments) {
+			saved = append(saved, args.Get(0).(*models.ScheduledTask))
+		}).Return(nil)
+
+		err := services.BackfillScheduledTasks(taskRepo, leagueRepo, draftRepo)
+
+		assert.NoError(t, err)
+		byID := make(map[string]*models.ScheduledTask)
+		for _, record := range saved {
+			assert.Equal(t, enums.ScheduledTaskStatusPending, record.Status)
+			byID[record.ID] = record
+		}
+		assert.Len(t, byID, 3)
+		if timeout := byID["0_"+draftLeagueID.String()]; assert.NotNil(t, timeout) {
+			assert.Equal(t, "DRAFT_TURN_TIMEOUT", timeout.Type)
+			assert.Contains(t, timeout.Payload, `"PickNumber":4`)
+		}
+		if tick := byID["3_"+leagueID.String()]; assert.NotNil(t, tick) {
+			assert.True(t, tick.ExecuteAt.Equal(nextTick))
+		}
+		if windowEnd := byID["1_"+leagueID.String()]; assert.NotNil(t, windowEnd) {
+			assert.True(t, windowEnd.ExecuteAt.Equal(windowStart.Add(24*time.Hour)))
+		}
+	})
+
+	t.Run("Leaves a table that already has tasks alone", func(t *testing.T) {
+		taskRepo := new(mock_repositories.MockScheduledTaskRepository)
+		leagueRepo := new(mock_repositories.MockLeagueRepository)
+		draftRepo := new(mock_repositories.MockDraftRepository)
+		taskRepo.On("Count").Return(int64(3), nil).Once()
+
+		err := services.BackfillScheduledTasks(taskRepo, leagueRepo, draftRepo)
+
+		assert.NoError(t, err)
+		taskRepo.AssertNotCalled(t, "Save", mock.Anything)
+		draftRepo.AssertNotCalled(t, "GetAllDraftsByStatus", mock.Anything)
+	})
+}
+
+func waitForSignals(t *testing.T, signals <-chan struct{}, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-signals:
+		case <-time.After(time.Second):
+			t.Fatal("scheduled tasks did not run")
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StandingsService ranks a league's members within their groups, breaking ties with the league's tiebreak
+// chain (LeagueFormat.StandingsTiebreakers). Playoff seeding ranks members the same way.
+type StandingsService interface {
+	// GetStandings returns the standings of every group of the league, or only groupNumber's if given.
+	GetStandings(leagueID uuid.UUID, groupNumber *int) ([]responses.GroupStandingsResponse, error)
+}
+
+type standingsServiceImpl struct {
+	leagueRepo repositories.LeagueRepository
+	memberRepo repositories.LeagueMemberRepository
+	gameRepo   repositories.GameRepository
+}
+
+func NewStandingsService(
+	leagueRepo repositories.LeagueRepository,
+	memberRepo repositories.LeagueMemberRepository,
+	gameRepo repositories.GameRepository,
+) StandingsService {
+	return &standingsServiceImpl{
+		leagueRepo: leagueRepo,
+		memberRepo: memberRepo,
+		gameRepo:   gameRepo,
+	}
+}
+
+func (s *standingsServiceImpl) GetStandings(leagueID uuid.UUID, groupNumber *int) ([]responses.GroupStandingsResponse, error) {
+	league, err := s.leagueRepo.GetLeagueByID(leagueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		log.Printf("ERROR: (StandingsService: GetStandings) - could not fetch league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	groupCount := 1
+	if league.Format != nil {
+		groupCount = max(league.Format.GroupCount, 1)
+	}
+	groups := make([]int, 0, groupCount)
+	for g := 1; g <= groupCount; g++ {
+		if groupNumber == nil || *groupNumber == g {
+			groups = append(groups, g)
+		}
+	}
+	if len(groups) == 0 {
+		return nil, types.ErrInvalidInput
+	}
+
+	ranker := newStandingsRanker(league, s.gameRepo)
+	// every row shows its game differential and strength of schedule, tied or not
+	if err := ranker.loadGames(); err != nil {
+		return nil, err
+	}
+	result := make([]responses.GroupStandingsResponse, 0, len(groups))
+	for _, g := range groups {
+		members, err := s.memberRepo.GetByLeagueAndGroup(leagueID, g)
+		if err != nil {
+			log.Printf("ERROR: (StandingsService: GetStandings) - could not fetch members of group %d of league %s: %v\n", g, leagueID, err)
+			return nil, types.ErrInternalService
+		}
+		ranked, err := ranker.rank(members)
+		if err != nil {
+			return nil, err
+		}
+
+		group := responses.GroupStandingsResponse{
+			GroupNumber:  g,
+			Tiebreakers:  ranker.chain,
+			Standings:    make([]responses.StandingResponse, 0, len(ranked)),
+			TiebreakSeed: league.TiebreakSeed,
+		}
+		for i, st := range ranked {
+			group.Standings = append(group.Standings, responses.StandingResponse{
+				Rank:               i + 1,
+				MemberID:           st.member.ID,
+				InLeagueName:       st.member.InLeagueName,
+				TeamName:           st.member.TeamName,
+				Wins:               st.member.Wins,
+				Losses:             st.member.Losses,
+				GameDifferential:   ranker.gameDifferential[st.member.ID],
+				StrengthOfSchedule: ranker.strengthOfSchedule(st.member.ID),
+				DecidedBy:          st.decidedBy,
+			})
+		}
+		result = append(result, group)
+	}
+	return result, nil
+}
+
+// standing is a member's place while being ranked.
+type standing struct {
+	member    models.LeagueMember
+	decidedBy *enums.StandingsTiebreaker
+}
+
+// standingsRanker orders members by wins, then fewest losses, then the league's tiebreak chain. It
+// fetches the league's completed regular season games at most once: GetStandings loads them up front,
+// since every row shows figures drawn from them, while playoff seeding only loads them once a tie
+// needs them. Ranking never writes anything.
+type standingsRanker struct {
+	league   *models.League
+	gameRepo repositories.GameRepository
+	chain    []enums.StandingsTiebreaker
+
+	gamesLoaded      bool
+	gameDifferential map[uuid.UUID]int
+	opponents        map[uuid.UUID][]uuid.UUID // one entry per series played
+	seriesWins       map[uuid.UUID]int
+	seriesLosses     map[uuid.UUID]int
+}
+
+func newStandingsRanker(league *models.League, gameRepo repositories.GameRepository) *standingsRanker {
+	return &standingsRanker{
+		league:   league,
+		gameRepo: gameRepo,
+		chain:    league.Format.StandingsTiebreakers(),
+	}
+}
+
+// rank returns members best first.
+func (r *standingsRanker) rank(members []models.LeagueMember) ([]standing, error) {
+	standings := make([]standing, len(members))
+	for i, m := range members {
+		standings[i] = standing{member: m}
+	}
+	slices.SortStableFunc(standings, func(a, b standing) int {
+		return cmp.Or(cmp.Compare(b.member.Wins, a.member.Wins), cmp.Compare(a.member.Losses, b.member.Losses))
+	})
+
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && standings[end].member.Wins == standings[start].member.Wins &&
+			standings[end].member.Losses == standings[start].member.Losses {
+			end++
+		}
+		if err := r.breakTie(standings[start:end], r.chain); err != nil {
+			return nil, err
+		}
+		start = end
+	}
+	return standings, nil
+}
+
+// breakTie orders members level on record with the first tiebreaker of chain, then breaks whatever ties
+// remain with the rest of it. The coin flip always settles the tie, so it ends the chain.
+func (r *standingsRanker) breakTie(tied []standing, chain []enums.StandingsTiebreaker) error {
+	if len(tied) < 2 || len(chain) == 0 {
+		return nil
+	}
+	tiebreaker := chain[0]
+	if tiebreaker == enums.StandingsTiebreakerCoinFlip {
+		r.flipCoin(tied)
+		for i := range tied {
+			tied[i].decidedBy = &tiebreaker
+		}
+		return nil
+	}
+
+	scores, err := r.scores(tiebreaker, tied)
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(tied, func(a, b standing) int {
+		return cmp.Compare(scores[b.member.ID], scores[a.member.ID])
+	})
+	if scores[tied[0].member.ID] == scores[tied[len(tied)-1].member.ID] {
+		return r.breakTie(tied, chain[1:]) // no help here
+	}
+	for i := range tied {
+		tied[i].decidedBy = &tiebreaker
+	}
+	for start := 0; start < len(tied); {
+		end := start + 1
+		for end < len(tied) && scores[tied[end].member.ID] == scores[tied[start].member.ID] {
+			end++
+		}
+		if err := r.breakTie(tied[start:end], chain[1:]); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// scores rates each tied member by tiebreaker, higher being better.
+func (r *standingsRanker) scores(tiebreaker enums.StandingsTiebreaker, tied []standing) (map[uuid.UUID]float64, error) {
+	scores := make(map[uuid.UUID]float64, len(tied))
+	switch tiebreaker {
+	case enums.StandingsTiebreakerHeadToHead:
+		for i := range tied {
+			for j := i + 1; j < len(tied); j++ {
+				a, b := tied[i].member.ID, tied[j].member.ID
+				games, err := r.gameRepo.GetHeadToHeadRecord(a, b)
+				if err != nil {
+					log.Printf("ERROR: (StandingsService: scores) - could not fetch head-to-head record of %s and %s: %v\n", a, b, err)
+					return nil, types.ErrInternalService
+				}
+				for _, game := range games {
+					// the record spans every league the two have met in
+					if game.LeagueID != r.league.ID || game.GameType != enums.GameTypeRegularSeason || game.WinnerID == nil {
+						continue
+					}
+					scores[*game.WinnerID]++
+				}
+			}
+		}
+	case enums.StandingsTiebreakerGameDifferential, enums.StandingsTiebreakerStrengthOfSchedule:
+		if err := r.loadGames(); err != nil {
+			return nil, err
+		}
+		for _, st := range tied {
+			if tiebreaker == enums.StandingsTiebreakerGameDifferential {
+				scores[st.member.ID] = float64(r.gameDifferential[st.member.ID])
+			} else {
+				scores[st.member.ID] = r.strengthOfSchedule(st.member.ID)
+			}
+		}
+	}
+	return scores, nil
+}
+
+// loadGames tallies the league's completed regular season games.
+func (r *standingsRanker) loadGames() error {
+	if r.gamesLoaded {
+		return nil
+	}
+	games, err := r.gameRepo.GetCompletedGamesByLeague(r.league.ID)
+	if err != nil {
+		log.Printf("ERROR: (StandingsService: loadGames) - could not fetch completed games of league %s: %v\n", r.league.ID, err)
+		return types.ErrInternalService
+	}
+	r.gameDifferential = make(map[uuid.UUID]int)
+	r.opponents = make(map[uuid.UUID][]uuid.UUID)
+	r.seriesWins = make(map[uuid.UUID]int)
+	r.seriesLosses = make(map[uuid.UUID]int)
+	for _, game := range games {
+		if game.GameType != enums.GameTypeRegularSeason {
+			continue
+		}
+		r.gameDifferential[game.Player1ID] += game.Player1Wins - game.Player2Wins
+		r.gameDifferential[game.Player2ID] += game.Player2Wins - game.Player1Wins
+		r.opponents[game.Player1ID] = append(r.opponents[game.Player1ID], game.Player2ID)
+		r.opponents[game.Player2ID] = append(r.opponents[game.Player2ID], game.Player1ID)
+		if game.WinnerID != nil && game.LoserID != nil {
+			r.seriesWins[*game.WinnerID]++
+			r.seriesLosses[*game.LoserID]++
+		}
+	}
+	r.gamesLoaded = true
+	return nil
+}
+
+// strengthOfSchedule is the average win rate of the opponents memberID has played, counting an opponent
+// once per series against them. loadGames must have run.
+func (r *standingsRanker) strengthOfSchedule(memberID uuid.UUID) float64 {
+	opponents := r.opponents[memberID]
+	if len(opponents) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, opponent := range opponents {
+		if played := r.seriesWins[opponent] + r.seriesLosses[opponent]; played > 0 {
+			total += float64(r.seriesWins[opponent]) / float64(played)
+		}
+	}
+	return total / float64(len(opponents))
+}
+
+// flipCoin shuffles the tied members, in MemberID order, with math/rand seeded with League.TiebreakSeed,
+// so the same tie always comes out the same way. Leagues get their seed when they're created (older ones
+// on startup); should one still lack it, the draw is seeded from the league's ID instead.
+func (r *standingsRanker) flipCoin(tied []standing) {
+	seed := binary.BigEndian.Uint64(r.league.ID[:8])
+	if r.league.TiebreakSeed != nil {
+		seed = uint64(*r.league.TiebreakSeed)
+	}
+	slices.SortFunc(tied, func(a, b standing) int {
+		return strings.Compare(a.member.ID.String(), b.member.ID.String())
+	})
+	draw := rand.New(rand.NewPCG(seed, 0))
+	draw.Shuffle(len(tied), func(i, j int) { tied[i], tied[j] = tied[j], tied[i] })
+}
@@ -0,0 +1,125 @@
+package services_test
+
+import (
+	"testing"
+
+	mock_repos "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStandingsService_GetStandings(t *testing.T) {
+	leagueID := uuid.New()
+	seed := int64(42)
+	newLeague := func(tiebreakers ...enums.StandingsTiebreaker) *models.League {
+		return &models.League{
+			ID:           leagueID,
+			TiebreakSeed: &seed,
+			Format:       &types.LeagueFormat{GroupCount: 1, Tiebreakers: tiebreakers},
+		}
+	}
+	series := func(winner, loser uuid.UUID, winnerGames, loserGames int) models.Game {
+		return models.Game{
+			LeagueID: leagueID, GameType: enums.GameTypeRegularSeason, Status: enums.GameStatusCompleted,
+			Player1ID: winner, Player2ID: loser, Player1Wins: winnerGames, Player2Wins: loserGames,
+			WinnerID: &winner, LoserID: &loser,
+		}
+	}
+	setup := func(league *models.League, members []models.LeagueMember, games []models.Game) (services.StandingsService, *mock_repos.MockLeagueRepository, *mock_repos.MockGameRepository) {
+		leagueRepo := new(mock_repos.MockLeagueRepository)
+		memberRepo := new(mock_repos.MockLeagueMemberRepository)
+		gameRepo := new(mock_repos.MockGameRepository)
+		leagueRepo.On("GetLeagueByID", leagueID).Return(league, nil)
+		memberRepo.On("GetByLeagueAndGroup", leagueID, 1).Return(members, nil)
+		gameRepo.On("GetCompletedGamesByLeague", leagueID).Return(games, nil)
+		return services.NewStandingsService(leagueRepo, memberRepo, gameRepo), leagueRepo, gameRepo
+	}
+
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("Head-to-head separates members level on record", func(t *testing.T) {
+		members := []models.LeagueMember{{ID: a, Wins: 1, Losses: 1}, {ID: b, Wins: 1, Losses: 1}, {ID: c, Wins: 2}}
+		games := []models.Game{series(c, a, 2, 0), series(b, a, 2, 1), series(c, b, 2, 0)}
+		service, _, gameRepo := setup(newLeague(), members, games)
+		// the record spans leagues; a's win over b elsewhere doesn't count here
+		otherLeague := series(a, b, 2, 0)
+		otherLeague.LeagueID = uuid.New()
+		gameRepo.On("GetHeadToHeadRecord", mock.Anything, mock.Anything).Return([]models.Game{games[1], otherLeague}, nil).Once()
+
+		groups, err := service.GetStandings(leagueID, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, groups, 1)
+		standings := groups[0].Standings
+		assert.Equal(t, []uuid.UUID{c, b, a}, []uuid.UUID{standings[0].MemberID, standings[1].MemberID, standings[2].MemberID})
+		assert.Nil(t, standings[0].DecidedBy)
+		assert.Equal(t, enums.StandingsTiebreakerHeadToHead, *standings[1].DecidedBy)
+		assert.Equal(t, enums.StandingsTiebreakerHeadToHead, *standings[2].DecidedBy)
+		assert.Equal(t, -1, standings[1].GameDifferential) // +1 against a, -2 against c
+		assert.Equal(t, types.DefaultTiebreakers, groups[0].Tiebreakers)
+	})
+
+	t.Run("The next tiebreaker decides when head-to-head is level", func(t *testing.T) {
+		members := []models.LeagueMember{{ID: a, Wins: 1, Losses: 1}, {ID: b, Wins: 1, Losses: 1}, {ID: c, Wins: 1, Losses: 1}}
+		// a beats b, b beats c, c beats a: every head-to-head score is 1
+		games := []models.Game{series(a, b, 2, 0), series(b, c, 2, 1), series(c, a, 2, 1)}
+		service, _, gameRepo := setup(newLeague(), members, games)
+		for _, g := range games {
+			inGame := mock.MatchedBy(func(id uuid.UUID) bool { return id == g.Player1ID || id == g.Player2ID })
+			gameRepo.On("GetHeadToHeadRecord", inGame, inGame).Return([]models.Game{g}, nil)
+		}
+
+		groups, err := service.GetStandings(leagueID, nil)
+
+		assert.NoError(t, err)
+		standings := groups[0].Standings
+		// a is +1 on games, c is 0 and b is -1
+		assert.Equal(t, []uuid.UUID{a, c, b}, []uuid.UUID{standings[0].MemberID, standings[1].MemberID, standings[2].MemberID})
+		for _, st := range standings {
+			assert.Equal(t, enums.StandingsTiebreakerGameDifferential, *st.DecidedBy)
+		}
+	})
+
+	t.Run("The coin flip is repeatable", func(t *testing.T) {
+		members := []models.LeagueMember{{ID: a}, {ID: b}, {ID: c}, {ID: d}}
+		service, _, _ := setup(newLeague(enums.StandingsTiebreakerCoinFlip), members, nil)
+
+		first, err := service.GetStandings(leagueID, nil)
+		assert.NoError(t, err)
+		second, err := service.GetStandings(leagueID, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, &seed, first[0].TiebreakSeed)
+		assert.Equal(t, first[0].Standings, second[0].Standings)
+	})
+
+	t.Run("A league without a seed still flips the same way, without writing one", func(t *testing.T) {
+		members := []models.LeagueMember{{ID: a}, {ID: b}, {ID: c}, {ID: d}}
+		league := newLeague(enums.StandingsTiebreakerCoinFlip)
+		league.TiebreakSeed = nil
+		service, leagueRepo, _ := setup(league, members, nil)
+
+		first, err := service.GetStandings(leagueID, nil)
+		assert.NoError(t, err)
+		second, err := service.GetStandings(leagueID, nil)
+		assert.NoError(t, err)
+
+		assert.Nil(t, league.TiebreakSeed)
+		assert.Equal(t, first[0].Standings, second[0].Standings)
+		leagueRepo.AssertNotCalled(t, "UpdateLeague", mock.Anything)
+	})
+
+	t.Run("An unknown group is rejected", func(t *testing.T) {
+		service, _, _ := setup(newLeague(), nil, nil)
+		group := 3
+
+		_, err := service.GetStandings(leagueID, &group)
+
+		assert.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"cmp"
+	"errors"
+	"log"
+	"slices"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/dtos/responses"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StatsService adds up the per Claim stats recorded from battle logs (models.ClaimGameStat) into a
+// league's season leaderboards.
+type StatsService interface {
+	// GetPokemonLeaderboard ranks every Claim that has been brought to a completed game, most KOs first.
+	GetPokemonLeaderboard(leagueID uuid.UUID) ([]responses.PokemonStatsResponse, error)
+	// GetCoachStats ranks the league's members by their Pokémon's combined KO differential.
+	GetCoachStats(leagueID uuid.UUID) ([]responses.CoachStatsResponse, error)
+}
+
+type statsServiceImpl struct {
+	leagueRepo repositories.LeagueRepository
+	gameRepo   repositories.GameRepository
+}
+
+func NewStatsService(
+	leagueRepo repositories.LeagueRepository,
+	gameRepo repositories.GameRepository,
+) StatsService {
+	return &statsServiceImpl{
+		leagueRepo: leagueRepo,
+		gameRepo:   gameRepo,
+	}
+}
+
+func (s *statsServiceImpl) GetPokemonLeaderboard(leagueID uuid.UUID) ([]responses.PokemonStatsResponse, error) {
+	stats, err := s.fetchStats(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	return pokemonLeaderboard(stats), nil
+}
+
+func (s *statsServiceImpl) GetCoachStats(leagueID uuid.UUID) ([]responses.CoachStatsResponse, error) {
+	stats, err := s.fetchStats(leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	coaches := make(map[uuid.UUID]*responses.CoachStatsResponse)
+	games := make(map[uuid.UUID]map[uuid.UUID]bool) // member -> games they have stats in
+	for _, stat := range stats {
+		memberID := stat.Claim.PlayerID
+		coach, ok := coaches[memberID]
+		if !ok {
+			coach = &responses.CoachStatsResponse{MemberID: memberID}
+			if stat.Claim.Player != nil {
+				coach.InLeagueName = stat.Claim.Player.InLeagueName
+				coach.TeamName = stat.Claim.Player.TeamName
+			}
+			coaches[memberID] = coach
+			games[memberID] = make(map[uuid.UUID]bool)
+		}
+		games[memberID][stat.GameID] = true
+		coach.DirectKOs += stat.DirectKOs
+		coach.PassiveKOs += stat.PassiveKOs
+		coach.Faints += stat.Faints
+	}
+	// the leaderboard is best first, so a member's first line there is their MVP
+	for _, line := range pokemonLeaderboard(stats) {
+		if coach := coaches[line.MemberID]; coach.MVP == nil {
+			coach.MVP = &line
+		}
+	}
+
+	result := make([]responses.CoachStatsResponse, 0, len(coaches))
+	for memberID, coach := range coaches {
+		coach.GamesPlayed = len(games[memberID])
+		coach.KODifferential = coach.DirectKOs + coach.PassiveKOs - coach.Faints
+		result = append(result, *coach)
+	}
+	slices.SortFunc(result, func(a, b responses.CoachStatsResponse) int {
+		return cmp.Or(
+			cmp.Compare(b.KODifferential, a.KODifferential),
+			cmp.Compare(b.DirectKOs+b.PassiveKOs, a.DirectKOs+a.PassiveKOs),
+			cmp.Compare(a.MemberID.String(), b.MemberID.String()),
+		)
+	})
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+	return result, nil
+}
+
+func (s *statsServiceImpl) fetchStats(leagueID uuid.UUID) ([]models.ClaimGameStat, error) {
+	if _, err := s.leagueRepo.GetLeagueByID(leagueID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, types.ErrLeagueNotFound
+		}
+		log.Printf("ERROR: (StatsService: fetchStats) - could not fetch league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	stats, err := s.gameRepo.GetClaimGameStatsByLeague(leagueID)
+	if err != nil {
+		log.Printf("ERROR: (StatsService: fetchStats) - could not fetch claim stats of league %s: %v\n", leagueID, err)
+		return nil, types.ErrInternalService
+	}
+	// a claim whose row can't be loaded can't be attributed to anyone
+	return slices.DeleteFunc(stats, func(stat models.ClaimGameStat) bool { return stat.Claim == nil }), nil
+}
+
+// pokemonLeaderboard totals stats per Claim and ranks them by KOs, then KO differential, then fewest
+// battles needed.
+func pokemonLeaderboard(stats []models.ClaimGameStat) []responses.PokemonStatsResponse {
+	lines := make(map[uuid.UUID]*responses.PokemonStatsResponse)
+	for _, stat := range stats {
+		line, ok := lines[stat.ClaimID]
+		if !ok {
+			claim := stat.Claim
+			line = &responses.PokemonStatsResponse{
+				ClaimID:   claim.ID,
+				SpeciesID: claim.SpeciesID,
+				MemberID:  claim.PlayerID,
+				IsActive:  claim.IsActive,
+			}
+			if claim.PokemonSpecies != nil {
+				line.SpeciesName = claim.PokemonSpecies.Name
+			}
+			if claim.Player != nil {
+				line.InLeagueName = claim.Player.InLeagueName
+				line.TeamName = claim.Player.TeamName
+			}
+			lines[stat.ClaimID] = line
+		}
+		line.GamesBrought++
+		line.BattlesBrought += stat.BattlesBrought
+		line.DirectKOs += stat.DirectKOs
+		line.PassiveKOs += stat.PassiveKOs
+		line.Faints += stat.Faints
+	}
+
+	result := make([]responses.PokemonStatsResponse, 0, len(lines))
+	for _, line := range lines {
+		line.KODifferential = line.DirectKOs + line.PassiveKOs - line.Faints
+		result = append(result, *line)
+	}
+	slices.SortFunc(result, func(a, b responses.PokemonStatsResponse) int {
+		return cmp.Or(
+			cmp.Compare(b.DirectKOs+b.PassiveKOs, a.DirectKOs+a.PassiveKOs),
+			cmp.Compare(b.KODifferential, a.KODifferential),
+			cmp.Compare(a.BattlesBrought, b.BattlesBrought),
+			cmp.Compare(a.ClaimID.String(), b.ClaimID.String()),
+		)
+	})
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+	return result
+}
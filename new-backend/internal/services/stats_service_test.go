@@ -0,0 +1,88 @@
+package services_test
+
+import (
+	"testing"
+
+	mock_repos "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestStatsService(t *testing.T) {
+	leagueID := uuid.New()
+	ash, gary := &models.LeagueMember{ID: uuid.New()}, &models.LeagueMember{ID: uuid.New()}
+	claim := func(player *models.LeagueMember, species string, active bool) *models.Claim {
+		return &models.Claim{ID: uuid.New(), PlayerID: player.ID, Player: player, IsActive: active, PokemonSpecies: &models.PokemonSpecies{Name: species}}
+	}
+	tusk, urshifu := claim(ash, "Great Tusk", true), claim(ash, "Urshifu", true)
+	droppedGholdengo, gholdengo := claim(ash, "Gholdengo", false), claim(ash, "Gholdengo", true)
+	heatran := claim(gary, "Heatran", true)
+	game1, game2 := uuid.New(), uuid.New()
+	stat := func(game uuid.UUID, claim *models.Claim, direct, passive, faints int) models.ClaimGameStat {
+		return models.ClaimGameStat{GameID: game, ClaimID: claim.ID, Claim: claim, BattlesBrought: 1, DirectKOs: direct, PassiveKOs: passive, Faints: faints}
+	}
+	stats := []models.ClaimGameStat{
+		stat(game1, tusk, 2, 0, 1),
+		stat(game1, urshifu, 1, 1, 0),
+		stat(game1, droppedGholdengo, 1, 0, 0),
+		stat(game1, heatran, 1, 1, 1),
+		stat(game2, tusk, 1, 0, 0),
+		stat(game2, gholdengo, 0, 0, 1),
+	}
+	setup := func() services.StatsService {
+		leagueRepo := new(mock_repos.MockLeagueRepository)
+		gameRepo := new(mock_repos.MockGameRepository)
+		leagueRepo.On("GetLeagueByID", leagueID).Return(&models.League{ID: leagueID}, nil)
+		gameRepo.On("GetClaimGameStatsByLeague", leagueID).Return(stats, nil)
+		return services.NewStatsService(leagueRepo, gameRepo)
+	}
+
+	t.Run("The Pokémon leaderboard totals each claim and ranks by KOs", func(t *testing.T) {
+		leaderboard, err := setup().GetPokemonLeaderboard(leagueID)
+
+		assert.NoError(t, err)
+		assert.Len(t, leaderboard, 5)
+		assert.Equal(t, tusk.ID, leaderboard[0].ClaimID)
+		assert.Equal(t, 2, leaderboard[0].GamesBrought)
+		assert.Equal(t, 3, leaderboard[0].DirectKOs)
+		assert.Equal(t, 2, leaderboard[0].KODifferential)
+		// both have 2 KOs; Urshifu never fainted
+		assert.Equal(t, urshifu.ID, leaderboard[1].ClaimID)
+		assert.Equal(t, heatran.ID, leaderboard[2].ClaimID)
+		// a dropped claim keeps its own line
+		assert.Equal(t, droppedGholdengo.ID, leaderboard[3].ClaimID)
+		assert.False(t, leaderboard[3].IsActive)
+		assert.Equal(t, gholdengo.ID, leaderboard[4].ClaimID)
+		assert.Equal(t, 5, leaderboard[4].Rank)
+	})
+
+	t.Run("Coach stats add up a member's Pokémon and name their MVP", func(t *testing.T) {
+		coaches, err := setup().GetCoachStats(leagueID)
+
+		assert.NoError(t, err)
+		assert.Len(t, coaches, 2)
+		assert.Equal(t, ash.ID, coaches[0].MemberID)
+		assert.Equal(t, 2, coaches[0].GamesPlayed)
+		assert.Equal(t, 5, coaches[0].DirectKOs)
+		assert.Equal(t, 1, coaches[0].PassiveKOs)
+		assert.Equal(t, 4, coaches[0].KODifferential)
+		assert.Equal(t, tusk.ID, coaches[0].MVP.ClaimID)
+		assert.Equal(t, gary.ID, coaches[1].MemberID)
+		assert.Equal(t, 1, coaches[1].GamesPlayed)
+		assert.Equal(t, heatran.ID, coaches[1].MVP.ClaimID)
+	})
+
+	t.Run("An unknown league is not found", func(t *testing.T) {
+		leagueRepo := new(mock_repos.MockLeagueRepository)
+		leagueRepo.On("GetLeagueByID", leagueID).Return(nil, gorm.ErrRecordNotFound)
+		service := services.NewStatsService(leagueRepo, new(mock_repos.MockGameRepository))
+
+		_, err := service.GetCoachStats(leagueID)
+
+		assert.ErrorIs(t, err, types.ErrLeagueNotFound)
+	})
+}
@@ -22,12 +22,14 @@ type TransferService interface {
 	PickupFreeAgent(currentUser *models.User, leagueID, poolEntryID uuid.UUID) error
 	SetSchedulerService(schedulerService SchedulerService)
 	SetNewRepositories(claimRepo repositories.ClaimRepository, poolEntryRepo repositories.PoolEntryRepository, memberRepo repositories.LeagueMemberRepository)
+	SetClock(clock utils.Clock)
 }
 
 type transferServiceImpl struct {
 	leagueRepo       repositories.LeagueRepository
 	memberRepo       repositories.LeagueMemberRepository
 	schedulerService SchedulerService
+	clock            utils.Clock
 
 	claimRepo     repositories.ClaimRepository
 	poolEntryRepo repositories.PoolEntryRepository
@@ -40,6 +42,7 @@ func NewTransferService(
 	return &transferServiceImpl{
 		leagueRepo: leagueRepo,
 		memberRepo: memberRepo,
+		clock:      utils.SystemClock,
 	}
 }
 
@@ -53,6 +56,11 @@ func (s *transferServiceImpl) SetSchedulerService(schedulerService SchedulerServ
 	s.schedulerService = schedulerService
 }
 
+// SetClock replaces the wall clock that transfer windows are timed against.
+func (s *transferServiceImpl) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
 // StartTransferPeriod begins the transfer window for a league. It updates the league status,
 // allocates transfer credits to players if enabled, and schedules the end of the window.
 func (s *transferServiceImpl) StartTransferPeriod(leagueID uuid.UUID) error {
@@ -97,7 +105,7 @@ func (s *transferServiceImpl) StartTransferPeriod(leagueID uuid.UUID) error {
 
 	// 4. Update League Status
 	league.Status = enums.LeagueStatusTransferWindow
-	now := time.Now()
+	now := s.clock.Now()
 	league.Format.NextTransferWindowStart = &now // The window starts now
 
 	// 5. Schedule EndTransferPeriod
@@ -154,7 +162,7 @@ func (s *transferServiceImpl) EndTransferPeriod(leagueID uuid.UUID) error {
 	// 4. Schedule next StartTransferPeriod
 	taskID := fmt.Sprintf("%d_%s", utils.TaskTypeTransferPeriodStart, league.ID)
 	if league.Format.TransferWindowFrequencyDays > 0 {
-		nextWindowStartTime := time.Now().AddDate(0, 0, league.Format.TransferWindowFrequencyDays)
+		nextWindowStartTime := s.clock.Now().AddDate(0, 0, league.Format.TransferWindowFrequencyDays)
 		league.Format.NextTransferWindowStart = &nextWindowStartTime
 
 		startTask := &utils.ScheduledTask{
@@ -317,6 +325,10 @@ func (s *transferServiceImpl) PickupFreeAgent(currentUser *models.User, leagueID
 		return types.ErrAboveMaxPokemon
 	}
 
+	if err := checkRosterTierLimits(s.claimRepo, s.poolEntryRepo, league, member.ID, []*models.PoolEntry{poolEntry}, nil); err != nil {
+		return err
+	}
+
 	newClaim := &models.Claim{
 		LeagueID:     poolEntry.LeagueID,
 		PlayerID:     member.ID,
@@ -1 +1,88 @@
 package services_test
+
+import (
+	"testing"
+
+	mock_repositories "github.com/GavFurtado/showdown-draft-league/new-backend/internal/mocks/repositories"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/services"
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type transferServiceMocks struct {
+	leagueRepo       *mock_repositories.MockLeagueRepository
+	leagueMemberRepo *mock_repositories.MockLeagueMemberRepository
+	claimRepo        *mock_repositories.MockClaimRepository
+	poolEntryRepo    *mock_repositories.MockPoolEntryRepository
+}
+
+func setupTransferServiceTest() (services.TransferService, transferServiceMocks) {
+	mocks := transferServiceMocks{
+		leagueRepo:       new(mock_repositories.MockLeagueRepository),
+		leagueMemberRepo: new(mock_repositories.MockLeagueMemberRepository),
+		claimRepo:        new(mock_repositories.MockClaimRepository),
+		poolEntryRepo:    new(mock_repositories.MockPoolEntryRepository),
+	}
+	service := services.NewTransferService(mocks.leagueRepo, mocks.leagueMemberRepo)
+	service.SetNewRepositories(mocks.claimRepo, mocks.poolEntryRepo, mocks.leagueMemberRepo)
+	return service, mocks
+}
+
+func TestTransferService_PickupFreeAgent(t *testing.T) {
+	leagueID := uuid.New()
+	user := &models.User{ID: uuid.New()}
+	member := &models.LeagueMember{ID: uuid.New(), UserID: user.ID, LeagueID: leagueID, TransferCredits: 3}
+	intPtr := func(i int) *int { return &i }
+	tierPtr := func(s string) *string { return &s }
+
+	newLeague := func() *models.League {
+		return &models.League{
+			ID:                  leagueID,
+			Status:              enums.LeagueStatusTransferWindow,
+			MinPokemonPerPlayer: 1,
+			MaxPokemonPerPlayer: 6,
+			Format: &types.LeagueFormat{
+				PickupCost: 1,
+				Tiers:      []types.PoolTier{{Name: "S", MaxPerRoster: intPtr(1)}, {Name: "B"}},
+			},
+		}
+	}
+	heldS := models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 1, Cost: intPtr(10), Tier: tierPtr("S")}
+	roster := []models.Claim{{PlayerID: member.ID, LeagueID: leagueID, SpeciesID: heldS.PokemonSpeciesID, IsActive: true}}
+
+	expectPickup := func(mocks transferServiceMocks, freeAgent *models.PoolEntry) {
+		mocks.leagueRepo.On("GetLeagueByID", leagueID).Return(newLeague(), nil).Twice()
+		mocks.poolEntryRepo.On("GetByID", freeAgent.ID).Return(freeAgent, nil).Once()
+		mocks.leagueMemberRepo.On("GetByUserAndLeague", user.ID, leagueID).Return(member, nil).Once()
+		mocks.claimRepo.On("GetActiveCountByPlayer", member.ID).Return(int64(len(roster)), nil).Once()
+		mocks.claimRepo.On("GetActiveByPlayer", member.ID).Return(roster, nil).Once()
+		mocks.poolEntryRepo.On("GetByLeague", leagueID).Return([]models.PoolEntry{heldS, *freeAgent}, nil).Once()
+	}
+
+	t.Run("Success - Signs a free agent within the tier limits", func(t *testing.T) {
+		service, mocks := setupTransferServiceTest()
+		freeAgent := &models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 2, Cost: intPtr(4), Tier: tierPtr("B"), IsAvailable: true}
+		expectPickup(mocks, freeAgent)
+		mocks.claimRepo.On("PickupFreeAgentTx", mock.Anything, member, mock.AnythingOfType("*models.Claim"), freeAgent, 1).Return(nil).Once()
+
+		err := service.PickupFreeAgent(user, leagueID, freeAgent.ID)
+
+		assert.NoError(t, err)
+		mocks.claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure - Free agent would exceed a tier's cap", func(t *testing.T) {
+		service, mocks := setupTransferServiceTest()
+		freeAgent := &models.PoolEntry{ID: uuid.New(), LeagueID: leagueID, PokemonSpeciesID: 2, Cost: intPtr(12), Tier: tierPtr("S"), IsAvailable: true}
+		expectPickup(mocks, freeAgent)
+
+		err := service.PickupFreeAgent(user, leagueID, freeAgent.ID)
+
+		assert.ErrorIs(t, err, types.ErrTierLimitExceeded)
+		mocks.claimRepo.AssertNotCalled(t, "PickupFreeAgentTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,346 @@
+// Package showdown reads Pokémon Showdown battle logs: the line protocol the simulator writes for every
+// battle (|player|, |poke|, |switch|, |faint|, |win|, ...), either as a raw log or embedded in a saved
+// replay page.
+package showdown
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNotBattleLog is returned for input that doesn't name both players of a battle.
+	ErrNotBattleLog = errors.New("not a Pokémon Showdown battle log")
+)
+
+// Battle is what a log says about how a battle went.
+type Battle struct {
+	Format string  `json:"Format"` // e.g. "[Gen 9] OU"; empty if the log doesn't say
+	Turns  int     `json:"Turns"`
+	Sides  [2]Side `json:"Sides"`  // p1, then p2
+	Winner string  `json:"Winner"` // the winning player's username; empty for a tie or a battle that hasn't ended
+	Tie    bool    `json:"Tie"`
+}
+
+// Side is one player and their team.
+type Side struct {
+	ID     string    `json:"ID"` // "p1" or "p2"
+	Player string    `json:"Player"`
+	Team   []Pokemon `json:"Team"` // team preview order, then Pokémon revealed later on
+}
+
+// Pokemon is one member of a side's team.
+type Pokemon struct {
+	Species  string `json:"Species"` // as at team preview or first switch in, e.g. "Urshifu-Rapid-Strike"
+	Nickname string `json:"Nickname"`
+	Brought  bool   `json:"Brought"` // it was sent out at some point; team preview alone doesn't count
+	// DirectKOs are opposing Pokémon its moves knocked out. PassiveKOs are those that fainted to damage it
+	// caused otherwise: status it inflicted, hazards it set, or an effect Showdown names it the source of
+	// (Rocky Helmet, Leech Seed, ...).
+	DirectKOs  int  `json:"DirectKOs"`
+	PassiveKOs int  `json:"PassiveKOs"`
+	Fainted    bool `json:"Fainted"`
+}
+
+// WinnerSide returns the side of the winner, or nil if nobody won.
+func (b *Battle) WinnerSide() *Side {
+	if b.Winner == "" {
+		return nil
+	}
+	for i := range b.Sides {
+		if ToID(b.Sides[i].Player) == ToID(b.Winner) {
+			return &b.Sides[i]
+		}
+	}
+	return nil
+}
+
+// Brought returns the Pokémon the side sent out.
+func (s *Side) Brought() []Pokemon {
+	brought := make([]Pokemon, 0, len(s.Team))
+	for _, p := range s.Team {
+		if p.Brought {
+			brought = append(brought, p)
+		}
+	}
+	return brought
+}
+
+var nonIDChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ToID is Showdown's normalised form of a name: lowercase letters and digits only. Usernames that
+// differ only in case, spaces or punctuation are the same account.
+func ToID(name string) string {
+	return nonIDChars.ReplaceAllString(strings.ToLower(name), "")
+}
+
+// replayLog finds the log a saved replay page embeds in a plain text script tag.
+var replayLog = regexp.MustCompile(`(?s)<script[^>]*class="(?:battle-log-data|log)"[^>]*>(.*?)</script>`)
+
+// Parse reads a battle from a raw log or a saved replay page.
+func Parse(data []byte) (*Battle, error) {
+	if m := replayLog.FindSubmatch(data); m != nil {
+		data = bytes.ReplaceAll(m[1], []byte(`<\/`), []byte(`</`))
+	}
+
+	p := newParser()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		p.line(strings.TrimRight(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if p.battle.Sides[0].Player == "" || p.battle.Sides[1].Player == "" {
+		return nil, ErrNotBattleLog
+	}
+	return &p.battle, nil
+}
+
+// ref points at a Pokémon on a side's team.
+type ref struct {
+	side, index int
+}
+
+// credit is who a Pokémon's faint would be put down to.
+type credit struct {
+	by      ref
+	passive bool
+}
+
+type parser struct {
+	battle Battle
+	// mover is the Pokémon whose move is resolving, until the action ends
+	mover    ref
+	hasMover bool
+	// lastDamage is who dealt each Pokémon its latest damage; nobody if it was self-inflicted or unattributable
+	lastDamage map[ref]credit
+	statusBy   map[ref]ref
+	hazardsBy  [2]map[string]ref // per side, who set each hazard on it
+}
+
+func newParser() *parser {
+	return &parser{
+		battle:     Battle{Sides: [2]Side{{ID: "p1"}, {ID: "p2"}}},
+		lastDamage: make(map[ref]credit),
+		statusBy:   make(map[ref]ref),
+		hazardsBy:  [2]map[string]ref{{}, {}},
+	}
+}
+
+func (p *parser) line(line string) {
+	if !strings.HasPrefix(line, "|") {
+		return
+	}
+	args := strings.Split(line[1:], "|")
+	switch args[0] {
+	case "", "turn", "upkeep":
+		p.hasMover = false
+	}
+	switch args[0] {
+	case "player":
+		// |player|p1|USERNAME|AVATAR|RATING; repeated with an empty name when a player leaves
+		if side := sideIndex(arg(args, 1)); side >= 0 && arg(args, 2) != "" {
+			p.battle.Sides[side].Player = arg(args, 2)
+		}
+	case "tier":
+		p.battle.Format = arg(args, 1)
+	case "turn":
+		if turn, err := strconv.Atoi(arg(args, 1)); err == nil {
+			p.battle.Turns = turn
+		}
+	case "poke":
+		// |poke|p1|DETAILS|ITEM, one per team preview slot
+		if side := sideIndex(arg(args, 1)); side >= 0 {
+			p.battle.Sides[side].Team = append(p.battle.Sides[side].Team, Pokemon{Species: species(arg(args, 2))})
+		}
+	case "switch", "drag", "replace":
+		// |switch|p1a: NICKNAME|DETAILS|HP STATUS
+		p.hasMover = false
+		if r, ok := p.switchIn(arg(args, 1), arg(args, 2)); ok {
+			delete(p.lastDamage, r)
+		}
+	case "move":
+		// |move|p1a: ATTACKER|MOVE|p2a: TARGET
+		p.mover, p.hasMover = p.find(arg(args, 1))
+	case "-damage":
+		// |-damage|p2a: TARGET|HP STATUS, or with |[from] EFFECT|[of] p1a: SOURCE if not from a move
+		if target, ok := p.find(arg(args, 1)); ok {
+			p.damage(target, args[min(3, len(args)):])
+		}
+	case "-status":
+		// |-status|p2a: TARGET|STATUS, with |[from] EFFECT|[of] SOURCE if not from a move
+		if target, ok := p.find(arg(args, 1)); ok {
+			if source, ok := p.source(target, args[min(3, len(args)):]); ok {
+				p.statusBy[target] = source
+			} else if source, ok := p.hazardsBy[target.side]["Toxic Spikes"]; ok && !p.hasMover {
+				p.statusBy[target] = source
+			} else {
+				delete(p.statusBy, target)
+			}
+		}
+	case "-curestatus":
+		if target, ok := p.find(arg(args, 1)); ok {
+			delete(p.statusBy, target)
+		}
+	case "-sidestart":
+		// |-sidestart|p1: USERNAME|move: Stealth Rock
+		side := sideIndex(arg(args, 1)[:min(2, len(arg(args, 1)))])
+		if side >= 0 && p.hasMover && p.mover.side != side {
+			p.hazardsBy[side][strings.TrimPrefix(arg(args, 2), "move: ")] = p.mover
+		}
+	case "-sideend":
+		if side := sideIndex(arg(args, 1)[:min(2, len(arg(args, 1)))]); side >= 0 {
+			delete(p.hazardsBy[side], strings.TrimPrefix(arg(args, 2), "move: "))
+		}
+	case "faint":
+		if r, ok := p.find(arg(args, 1)); ok {
+			p.battle.Sides[r.side].Team[r.index].Fainted = true
+			if c, ok := p.lastDamage[r]; ok {
+				if c.passive {
+					p.battle.Sides[c.by.side].Team[c.by.index].PassiveKOs++
+				} else {
+					p.battle.Sides[c.by.side].Team[c.by.index].DirectKOs++
+				}
+			}
+		}
+	case "win":
+		p.battle.Winner = arg(args, 1)
+	case "tie":
+		p.battle.Tie = true
+	}
+}
+
+// switchIn records a Pokémon being sent out, adding it to its side's team if team preview didn't show it.
+func (p *parser) switchIn(ident, details string) (ref, bool) {
+	side, nickname, ok := parseIdent(ident)
+	if !ok {
+		return ref{}, false
+	}
+	if r, ok := p.find(ident); ok {
+		p.battle.Sides[side].Team[r.index].Brought = true
+		return r, true
+	}
+
+	team := p.battle.Sides[side].Team
+	species := species(details)
+	for i := range team {
+		if team[i].Nickname != "" {
+			continue
+		}
+		// team preview hides some formes, e.g. "Urshifu-*" for either Urshifu
+		base, hidden := strings.CutSuffix(team[i].Species, "-*")
+		if team[i].Species == species || (hidden && strings.HasPrefix(species, base+"-")) || (hidden && species == base) {
+			team[i].Species = species
+			team[i].Nickname = nickname
+			team[i].Brought = true
+			return ref{side, i}, true
+		}
+	}
+	p.battle.Sides[side].Team = append(team, Pokemon{Species: species, Nickname: nickname, Brought: true})
+	return ref{side, len(team)}, true
+}
+
+// damage records who dealt target its latest damage, given the tags of the -damage line.
+func (p *parser) damage(target ref, tags []string) {
+	from, passive := tag(tags, "[from] ")
+	if !passive {
+		// a move's damage
+		if p.hasMover && p.mover.side != target.side {
+			p.lastDamage[target] = credit{by: p.mover}
+			return
+		}
+		delete(p.lastDamage, target)
+		return
+	}
+
+	source, ok := p.source(target, tags)
+	switch {
+	case ok:
+	case from == "psn" || from == "tox" || from == "brn":
+		source, ok = p.statusBy[target]
+	case from == "Stealth Rock" || from == "Spikes":
+		source, ok = p.hazardsBy[target.side][from]
+	}
+	if ok {
+		p.lastDamage[target] = credit{by: source, passive: true}
+	} else {
+		// recoil, weather, confusion, Life Orb, ...
+		delete(p.lastDamage, target)
+	}
+}
+
+// source is who the tags of a line name as causing an effect on target: the [of] Pokémon, or for an
+// effect without [from], the Pokémon whose move is resolving. Only opposing Pokémon count.
+func (p *parser) source(target ref, tags []string) (ref, bool) {
+	var source ref
+	var ok bool
+	if of, tagged := tag(tags, "[of] "); tagged {
+		source, ok = p.find(of)
+	} else if _, tagged := tag(tags, "[from] "); !tagged {
+		source, ok = p.mover, p.hasMover
+	}
+	return source, ok && source.side != target.side
+}
+
+// tag returns the value of the first of tags with prefix.
+func tag(tags []string, prefix string) (string, bool) {
+	for _, t := range tags {
+		if value, ok := strings.CutPrefix(t, prefix); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// find looks up the Pokémon an identifier like "p1a: Nickname" refers to.
+func (p *parser) find(ident string) (ref, bool) {
+	side, nickname, ok := parseIdent(ident)
+	if !ok {
+		return ref{}, false
+	}
+	for i, pokemon := range p.battle.Sides[side].Team {
+		if pokemon.Nickname == nickname {
+			return ref{side, i}, true
+		}
+	}
+	return ref{}, false
+}
+
+// parseIdent splits "p1a: Nickname" (or "p1: Nickname") into its side and nickname.
+func parseIdent(ident string) (int, string, bool) {
+	position, nickname, ok := strings.Cut(ident, ": ")
+	if !ok || len(position) < 2 {
+		return 0, "", false
+	}
+	side := sideIndex(position[:2])
+	return side, nickname, side >= 0
+}
+
+func sideIndex(id string) int {
+	switch id {
+	case "p1":
+		return 0
+	case "p2":
+		return 1
+	}
+	return -1
+}
+
+// species takes the species from details like "Garchomp, L50, F, shiny".
+func species(details string) string {
+	species, _, _ := strings.Cut(details, ",")
+	return strings.TrimSpace(species)
+}
+
+func arg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
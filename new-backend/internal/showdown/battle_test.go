@@ -0,0 +1,133 @@
+package showdown_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/showdown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseFixture(t *testing.T, name string) *showdown.Battle {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	battle, err := showdown.Parse(data)
+	require.NoError(t, err)
+	return battle
+}
+
+// kos maps each of a side's Pokémon that scored a KO or fainted to its direct and passive KOs and
+// whether it fainted.
+func kos(side showdown.Side) map[string][3]int {
+	result := make(map[string][3]int)
+	for _, p := range side.Team {
+		if p.DirectKOs > 0 || p.PassiveKOs > 0 || p.Fainted {
+			fainted := 0
+			if p.Fainted {
+				fainted = 1
+			}
+			result[p.Species] = [3]int{p.DirectKOs, p.PassiveKOs, fainted}
+		}
+	}
+	return result
+}
+
+func species(pokemon []showdown.Pokemon) []string {
+	result := make([]string, 0, len(pokemon))
+	for _, p := range pokemon {
+		result = append(result, p.Species)
+	}
+	return result
+}
+
+func TestParse_Log(t *testing.T) {
+	battle := parseFixture(t, "gen9ou.log")
+
+	assert.Equal(t, "[Gen 9] OU", battle.Format)
+	assert.Equal(t, 7, battle.Turns)
+	assert.Equal(t, "Ash Ketchum", battle.Sides[0].Player)
+	assert.Equal(t, "Gary.Oak", battle.Sides[1].Player)
+	// the chat message pretending to end the battle doesn't
+	assert.Equal(t, "Ash Ketchum", battle.Winner)
+	assert.Equal(t, "p1", battle.WinnerSide().ID)
+
+	ash, gary := battle.Sides[0], battle.Sides[1]
+	assert.Len(t, ash.Team, 6)
+	assert.Equal(t, []string{"Great Tusk", "Urshifu-Rapid-Strike", "Kingambit"}, species(ash.Brought()))
+	assert.Equal(t, "Fist", ash.Team[2].Nickname)
+	assert.Equal(t, []string{"Garchomp", "Corviknight", "Ferrothorn", "Dragonite"}, species(gary.Brought()))
+
+	assert.Equal(t, map[string][3]int{"Great Tusk": {1, 0, 0}, "Urshifu-Rapid-Strike": {1, 0, 1}, "Kingambit": {1, 0, 0}}, kos(ash))
+	// Ferrothorn's Rocky Helmet took Urshifu down with it
+	assert.Equal(t, map[string][3]int{"Garchomp": {0, 0, 1}, "Ferrothorn": {0, 1, 1}, "Dragonite": {0, 0, 1}}, kos(gary))
+}
+
+func TestParse_ReplayPage(t *testing.T) {
+	battle := parseFixture(t, "gen9ou-replay.html")
+
+	assert.Equal(t, "Gary.Oak", battle.Sides[0].Player)
+	assert.Equal(t, "Ash Ketchum", battle.Sides[1].Player)
+	assert.Equal(t, "Gary.Oak", battle.Winner)
+	assert.Equal(t, 5, battle.Turns)
+	// Dragapult fainted to the poison of Heatran's Toxic
+	assert.Equal(t, map[string][3]int{"Iron Valiant": {0, 0, 1}, "Heatran": {1, 1, 0}}, kos(battle.Sides[0]))
+	assert.Equal(t, map[string][3]int{"Gholdengo": {1, 0, 1}, "Dragapult": {0, 0, 1}}, kos(battle.Sides[1]))
+}
+
+func TestParse_Unfinished(t *testing.T) {
+	battle, err := showdown.Parse([]byte("|player|p1|Red|1|\n|player|p2|Blue|2|\n|tie\n"))
+
+	require.NoError(t, err)
+	assert.True(t, battle.Tie)
+	assert.Nil(t, battle.WinnerSide())
+}
+
+func TestParse_NotABattleLog(t *testing.T) {
+	_, err := showdown.Parse([]byte("<html><body>Replay not found</body></html>"))
+
+	assert.ErrorIs(t, err, showdown.ErrNotBattleLog)
+}
+
+func TestToID(t *testing.T) {
+	assert.Equal(t, "garyoak", showdown.ToID("Gary.Oak"))
+	assert.Equal(t, "ashketchum", showdown.ToID(" Ash Ketchum"))
+}
+
+func TestParse_PassiveKOs(t *testing.T) {
+	log := `|player|p1|Red|1|
+|player|p2|Blue|2|
+|switch|p1a: Ting-Lu|Ting-Lu|100/100
+|switch|p2a: Iron Moth|Iron Moth|100/100
+|turn|1
+|move|p1a: Ting-Lu|Spikes|p2a: Iron Moth
+|-sidestart|p2: Blue|Spikes
+|move|p2a: Iron Moth|Fiery Dance|p1a: Ting-Lu
+|-damage|p1a: Ting-Lu|70/100
+|
+|upkeep
+|turn|2
+|switch|p2a: Glimmora|Glimmora|100/100
+|-damage|p2a: Glimmora|0 fnt|[from] Spikes
+|faint|p2a: Glimmora
+|
+|switch|p2a: Iron Moth|Iron Moth|100/100
+|-damage|p2a: Iron Moth|88/100|[from] Spikes
+|turn|3
+|move|p2a: Iron Moth|Fiery Dance|p1a: Ting-Lu
+|-damage|p1a: Ting-Lu|1/100
+|move|p1a: Ting-Lu|Earthquake|p2a: Iron Moth
+|-damage|p2a: Iron Moth|0 fnt
+|faint|p2a: Iron Moth
+|-damage|p1a: Ting-Lu|0 fnt|[from] Recoil
+|faint|p1a: Ting-Lu
+|win|Red
+`
+	battle, err := showdown.Parse([]byte(log))
+
+	require.NoError(t, err)
+	// Spikes set by Ting-Lu; its recoil faint is nobody's KO
+	assert.Equal(t, map[string][3]int{"Ting-Lu": {1, 1, 1}}, kos(battle.Sides[0]))
+	assert.Equal(t, map[string][3]int{"Glimmora": {0, 0, 1}, "Iron Moth": {0, 0, 1}}, kos(battle.Sides[1]))
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DraftClock is how a draft's turn clocks run: per round time limits, nightly quiet hours in the
+// league's time zone during which clocks are frozen, and when to remind the member on the clock.
+// It is copied from the league's format when the draft starts, the same way Draft.TurnTimeLimit is
+// fixed for the whole draft. A nil clock always runs and uses Draft.TurnTimeLimit for every round.
+type DraftClock struct {
+	Timezone            string               `json:"Timezone"`
+	QuietHoursStart     string               `json:"QuietHoursStart"`
+	QuietHoursEnd       string               `json:"QuietHoursEnd"`
+	RoundTurnTimeLimits []RoundTurnTimeLimit `json:"RoundTurnTimeLimits"`
+	// percentages of a turn's running time after which the member on the clock is reminded
+	TurnReminderPercents []int `json:"TurnReminderPercents"`
+}
+
+// RoundTurnTimeLimit overrides the draft's TurnTimeLimit from FromRound on, until a later override.
+type RoundTurnTimeLimit struct {
+	FromRound     int `json:"FromRound"`
+	TurnTimeLimit int `json:"TurnTimeLimit"` // minutes
+}
+
+// maxQuietWindows bounds the walk in Deadline; far more nights than any turn clock spans.
+const maxQuietWindows = 10000
+
+// Validate checks the time zone, the quiet hours ("HH:MM", both or neither), the round overrides and
+// the reminder percentages (1-99, each at most once).
+func (c *DraftClock) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("unknown time zone %q: %w", c.Timezone, err)
+	}
+	if (c.QuietHoursStart == "") != (c.QuietHoursEnd == "") {
+		return errors.New("quiet hours need both a start and an end")
+	}
+	if c.QuietHoursStart != "" {
+		if _, err := parseClockTime(c.QuietHoursStart); err != nil {
+			return err
+		}
+		if _, err := parseClockTime(c.QuietHoursEnd); err != nil {
+			return err
+		}
+	}
+	for _, override := range c.RoundTurnTimeLimits {
+		if override.FromRound < 1 || override.TurnTimeLimit < 1 {
+			return fmt.Errorf("invalid turn time limit override %+v", override)
+		}
+	}
+	for i, percent := range c.TurnReminderPercents {
+		if percent < 1 || percent > 99 {
+			return fmt.Errorf("turn reminder at %d%% of the clock, expected 1-99", percent)
+		}
+		if slices.Contains(c.TurnReminderPercents[:i], percent) {
+			return fmt.Errorf("turn reminder at %d%% given twice", percent)
+		}
+	}
+	return nil
+}
+
+// ReminderPercents returns the reminder percentages, or nil for a clock without reminders.
+func (c *DraftClock) ReminderPercents() []int {
+	if c == nil {
+		return nil
+	}
+	return c.TurnReminderPercents
+}
+
+// TurnTimeLimit returns the turn time limit in minutes for round: the override with the highest
+// FromRound not after round, or defaultMinutes if no override applies.
+func (c *DraftClock) TurnTimeLimit(round, defaultMinutes int) int {
+	if c == nil {
+		return defaultMinutes
+	}
+	minutes, from := defaultMinutes, 0
+	for _, override := range c.RoundTurnTimeLimits {
+		if override.FromRound <= round && override.FromRound > from {
+			minutes, from = override.TurnTimeLimit, override.FromRound
+		}
+	}
+	return minutes
+}
+
+// Deadline returns when a turn clock started at start runs out after limit of running time. The clock
+// doesn't run during quiet hours, so a turn started during them only starts counting once they end.
+func (c *DraftClock) Deadline(start time.Time, limit time.Duration) time.Time {
+	quietStart, quietEnd, ok := c.quietHours()
+	if !ok {
+		return start.Add(limit)
+	}
+	loc := c.location()
+	t := start.In(loc)
+	remaining := limit
+	for range maxQuietWindows {
+		windowStart, windowEnd := nextQuietWindow(t, quietStart, quietEnd, loc)
+		if t.Before(windowStart) {
+			if !t.Add(remaining).After(windowStart) {
+				break
+			}
+			remaining -= windowStart.Sub(t)
+		}
+		t = windowEnd
+	}
+	return t.Add(remaining).In(start.Location())
+}
+
+// nextQuietWindow returns the quiet window t falls in, or else the next one to start after t.
+// quietStart and quietEnd are minutes after local midnight; a window ending at or before its start
+// runs past midnight into the next day.
+func nextQuietWindow(t time.Time, quietStart, quietEnd int, loc *time.Location) (time.Time, time.Time) {
+	for dayOffset := -1; ; dayOffset++ {
+		day := t.Day() + dayOffset
+		windowStart := time.Date(t.Year(), t.Month(), day, quietStart/60, quietStart%60, 0, 0, loc)
+		if quietEnd <= quietStart {
+			day++
+		}
+		windowEnd := time.Date(t.Year(), t.Month(), day, quietEnd/60, quietEnd%60, 0, 0, loc)
+		if windowEnd.After(t) {
+			return windowStart, windowEnd
+		}
+	}
+}
+
+func (c *DraftClock) quietHours() (start, end int, ok bool) {
+	if c == nil || c.QuietHoursStart == "" || c.QuietHoursEnd == "" {
+		return 0, 0, false
+	}
+	start, err := parseClockTime(c.QuietHoursStart)
+	if err != nil {
+		return 0, 0, false
+	}
+	end, err = parseClockTime(c.QuietHoursEnd)
+	if err != nil || start == end {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func (c *DraftClock) location() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// parseClockTime parses "HH:MM" into minutes after midnight.
+func parseClockTime(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Value implements the driver.Valuer interface for DraftClock.
+func (c DraftClock) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan implements the sql.Scanner interface for DraftClock.
+func (c *DraftClock) Scan(value any) error {
+	var byteValue []byte
+	switch v := value.(type) {
+	case []byte:
+		byteValue = v
+	case string:
+		byteValue = []byte(v)
+	default:
+		return errors.New("unsupported type for DraftClock")
+	}
+	return json.Unmarshal(byteValue, c)
+}
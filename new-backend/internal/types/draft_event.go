@@ -0,0 +1,109 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DraftEventType identifies the kind of change pushed on a league's draft event stream.
+type DraftEventType string // not persisted; only used on the wire
+
+const (
+	DraftEventPickMade        DraftEventType = "PICK_MADE"
+	DraftEventTurnChanged     DraftEventType = "TURN_CHANGED"
+	DraftEventPickAccumulated DraftEventType = "PICK_ACCUMULATED"
+	DraftEventPickUndone      DraftEventType = "PICK_UNDONE"
+	DraftEventDraftPaused     DraftEventType = "DRAFT_PAUSED"
+	DraftEventDraftResumed    DraftEventType = "DRAFT_RESUMED"
+	DraftEventDraftCompleted  DraftEventType = "DRAFT_COMPLETED"
+	DraftEventAuctionLotOpen  DraftEventType = "AUCTION_LOT_OPENED"
+	DraftEventAuctionBid      DraftEventType = "AUCTION_BID_PLACED"
+	DraftEventAuctionLotClose DraftEventType = "AUCTION_LOT_CLOSED"
+	DraftEventPickTraded      DraftEventType = "PICK_TRADED"
+	DraftEventStartFailed     DraftEventType = "DRAFT_START_FAILED"
+	// DraftEventResync is sent to a reconnecting client whose last seen event is no longer
+	// buffered. The client should refetch the draft and continue from the new event ID.
+	DraftEventResync DraftEventType = "RESYNC"
+)
+
+// DraftEvent is a single entry on a league's draft event stream.
+// ID is monotonically increasing per league and is what clients send back as Last-Event-ID.
+type DraftEvent struct {
+	ID        uint64         `json:"ID"`
+	LeagueID  uuid.UUID      `json:"LeagueID"`
+	Type      DraftEventType `json:"Type"`
+	Payload   any            `json:"Payload,omitempty"`
+	Timestamp time.Time      `json:"Timestamp"`
+}
+
+// payloads
+
+type DraftEventPickMadePayload struct {
+	MemberID        uuid.UUID `json:"MemberID"`
+	PoolEntryID     uuid.UUID `json:"PoolEntryID"`
+	DraftPickNumber int       `json:"DraftPickNumber"`
+	Cost            int       `json:"Cost"`
+}
+
+type DraftEventTurnChangedPayload struct {
+	CurrentTurnMemberID  uuid.UUID  `json:"CurrentTurnMemberID"`
+	CurrentRound         int        `json:"CurrentRound"`
+	CurrentPickInRound   int        `json:"CurrentPickInRound"`
+	CurrentPickOnClock   int        `json:"CurrentPickOnClock"`
+	CurrentTurnStartTime *time.Time `json:"CurrentTurnStartTime"`
+	TurnEndsAt           *time.Time `json:"TurnEndsAt"`
+}
+
+type DraftEventPickAccumulatedPayload struct {
+	MemberID         uuid.UUID `json:"MemberID"`
+	DraftPickNumber  int       `json:"DraftPickNumber"`
+	AccumulatedPicks []int     `json:"AccumulatedPicks"`
+}
+
+type DraftEventPickUndonePayload struct {
+	MemberID        uuid.UUID `json:"MemberID"`
+	PoolEntryID     uuid.UUID `json:"PoolEntryID"`
+	DraftPickNumber int       `json:"DraftPickNumber"`
+	RefundedPoints  int       `json:"RefundedPoints"`
+}
+
+type DraftEventDraftPausedPayload struct {
+	MemberID *uuid.UUID `json:"MemberID,omitempty"` // member on the clock when the draft paused, if any
+	Reason   string     `json:"Reason"`
+}
+
+// DraftEventPickTradedPayload is sent when a trade moves slots between members: on accept,
+// or with status VETOED when staff reverse an accepted trade.
+type DraftEventPickTradedPayload struct {
+	TradeID     uuid.UUID `json:"TradeID"`
+	ProposerID  uuid.UUID `json:"ProposerID"`
+	RecipientID uuid.UUID `json:"RecipientID"`
+	Status      string    `json:"Status"`
+}
+
+// DraftEventStartFailedPayload is sent when a scheduled draft start can't start the draft, so staff can
+// fix the league (e.g. finish a manual draft order) and start it themselves.
+type DraftEventStartFailedPayload struct {
+	ScheduledFor time.Time `json:"ScheduledFor"`
+	Reason       string    `json:"Reason"`
+}
+
+type DraftEventDraftCompletedPayload struct {
+	EndTime time.Time `json:"EndTime"`
+}
+
+// DraftEventAuctionLotPayload describes the open lot of an auction draft; sent when it is nominated and on every bid.
+type DraftEventAuctionLotPayload struct {
+	PoolEntryID  uuid.UUID `json:"PoolEntryID"`
+	HighBid      int       `json:"HighBid"`
+	HighBidderID uuid.UUID `json:"HighBidderID"`
+	LotEndsAt    time.Time `json:"LotEndsAt"`
+}
+
+type DraftEventAuctionLotClosedPayload struct {
+	PoolEntryID uuid.UUID  `json:"PoolEntryID"`
+	Sold        bool       `json:"Sold"`
+	WinnerID    *uuid.UUID `json:"WinnerID,omitempty"`
+	WinningBid  int        `json:"WinningBid"`
+}
@@ -15,6 +15,10 @@ var (
 	ErrPoolEntryNotFound     = errors.New("pool entry not found")
 	ErrClaimNotFound         = errors.New("claim not found")
 	ErrDraftPickNotFound     = errors.New("draft pick not found")
+	ErrDraftPickTradeNotFound = errors.New("draft pick trade not found")
+	ErrMockDraftNotFound     = errors.New("mock draft not found")
+	ErrMockDraftOnOtherInstance = errors.New("mock draft is held by another backend instance")
+	ErrScheduledTaskNotFound = errors.New("scheduled task not found")
 
 	// Player creation specific errors
 	ErrUserAlreadyInLeague  = errors.New("user is already a player in this league")
@@ -37,6 +41,7 @@ var (
 	ErrPokemonAlreadyReleased         = errors.New("this pokemon has already been released")
 	ErrBelowMinPokemon                = errors.New("dropping this pokemon would put you below the league's minimum")
 	ErrAboveMaxPokemon                = errors.New("picking up this pokemon would put you above the league's maximum")
+	ErrTierLimitExceeded              = errors.New("this would break the league's per-tier roster limits")
 	ErrNoPlayerForDraft               = errors.New("not enough players to start draft")
 	ErrTooManyRequestedPicks          = errors.New("too many draft picks were requested")
 	ErrCannotSkipBelowMinimumRoster   = errors.New("skip action not allowed as your roster size will be too small")
@@ -48,6 +53,11 @@ var (
 	ErrInvalidLeagueConfiguration     = errors.New("invalid league configuration")
 	ErrGamesAlreadyGenerated          = errors.New("games have already been generated for this league/season")
 	ErrExceedsMaxAllowableGroupCount  = errors.New("requested group count exceeds max allowed group count ")
+	ErrNotAuctionDraft                = errors.New("this league does not use an auction draft")
+	ErrAuctionLotOpen                 = errors.New("an auction lot is already open")
+	ErrNoAuctionLotOpen               = errors.New("no auction lot is open")
+	ErrBidTooLow                      = errors.New("bid must be higher than the current high bid")
+	ErrTimeTravelDisabled             = errors.New("the clock can only be moved in dev")
 
 	// Internal Service Errors
 	ErrInternalService = errors.New("internal service error")
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/GavFurtado/showdown-draft-league/new-backend/internal/models/enums"
@@ -11,13 +12,26 @@ import (
 
 type LeagueFormat struct {
 	IsSnakeRoundDraft           bool                           `json:"IsSnakeRoundDraft"`
+	DraftRoundOrder             enums.DraftRoundOrder          `json:"DraftRoundOrder"`  // empty falls back to IsSnakeRoundDraft
+	CustomDraftOrder            [][]int                        `json:"CustomDraftOrder"` // per round, draft positions in picking order; only for CUSTOM
 	DraftOrderType              enums.DraftOrderType           `json:"DraftOrderType"`
+	DraftMode                   enums.DraftMode                `json:"DraftMode"`             // empty is treated as STANDARD
+	AuctionBidTimeSeconds       int                            `json:"AuctionBidTimeSeconds"` // countdown reset by every bid in an auction draft
+	TurnTimeoutPolicy           enums.DraftTimeoutPolicy       `json:"TurnTimeoutPolicy"`     // empty is treated as AUTO_PICK_QUEUE
+	StartingSkips               *int                           `json:"StartingSkips"`         // SkipsLeft members start with; nil for max - min roster size
+	Timezone                    string                         `json:"Timezone"`              // IANA name, e.g. "America/New_York"; empty is UTC
+	DraftQuietHoursStart        string                         `json:"DraftQuietHoursStart"`  // "HH:MM" league time; turn clocks freeze until DraftQuietHoursEnd
+	DraftQuietHoursEnd          string                         `json:"DraftQuietHoursEnd"`    // "HH:MM"; before the start means overnight
+	RoundTurnTimeLimits         []RoundTurnTimeLimit           `json:"RoundTurnTimeLimits"`   // per round overrides of the draft's TurnTimeLimit
+	TurnReminderPercents        []int                          `json:"TurnReminderPercents"`  // how far into a turn clock (e.g. 50, 90) to remind the member on it
 	SeasonType                  enums.LeagueSeasonType         `json:"SeasonType"`
 	GroupCount                  int                            `json:"GroupCount"`
 	PlayoffType                 enums.LeaguePlayoffType        `json:"PlayoffType"`
 	PlayoffParticipantCount     int                            `json:"PlayoffParticipantCount"`
 	PlayoffByesCount            int                            `json:"PlayoffByesCount"`
 	PlayoffSeedingType          enums.LeaguePlayoffSeedingType `json:"PlayoffSeedingType"`
+	NoGrandFinalReset           bool                           `json:"NoGrandFinalReset"`          // double elimination: the lower bracket's winner needs only one grand final win
+	AutoFinalizeConfirmedGames  bool                           `json:"AutoFinalizeConfirmedGames"` // a result the opponent confirms needs no staff approval
 	AllowTransfers              bool                           `json:"AllowTransfers"`
 	TransfersCostCredits        bool                           `json:"TransfersCostCredits"`
 	TransferCreditsPerWindow    int                            `json:"TransferCreditsPerWindow"`
@@ -27,6 +41,23 @@ type LeagueFormat struct {
 	DropCost                    int                            `json:"DropCost"`
 	PickupCost                  int                            `json:"PickupCost"`
 	NextTransferWindowStart     *time.Time                     `json:"NextTransferWindowStart"`
+	Tiers                       []PoolTier                     `json:"Tiers"`       // pool tiers PoolEntry.Tier refers to; empty means an untiered pool
+	Tiebreakers                 []enums.StandingsTiebreaker    `json:"Tiebreakers"` // applied in order to members level on wins and losses; empty for DefaultTiebreakers
+}
+
+// DefaultTiebreakers is the tiebreak chain of a league that hasn't picked one.
+var DefaultTiebreakers = []enums.StandingsTiebreaker{
+	enums.StandingsTiebreakerHeadToHead,
+	enums.StandingsTiebreakerGameDifferential,
+	enums.StandingsTiebreakerStrengthOfSchedule,
+	enums.StandingsTiebreakerCoinFlip,
+}
+
+// PoolTier is one tier of a league's pool (S, A, B, ...) and how many of a roster may or must come from it.
+type PoolTier struct {
+	Name         string `json:"Name"`
+	MaxPerRoster *int   `json:"MaxPerRoster"` // nil for no cap
+	MinPerRoster *int   `json:"MinPerRoster"` // nil for no minimum
 }
 
 // Scan implements the sql.Scanner interface for GORM JSONB deserialization.
@@ -44,9 +75,63 @@ func (f *LeagueFormat) Scan(value any) error {
 	if val, ok := m["is_snake_round_draft"].(bool); ok {
 		f.IsSnakeRoundDraft = val
 	}
+	if val, ok := m["draft_round_order"].(string); ok {
+		f.DraftRoundOrder = enums.DraftRoundOrder(val).Normalize()
+	}
+	if val, ok := m["custom_draft_order"].([]any); ok {
+		f.CustomDraftOrder = make([][]int, 0, len(val))
+		for _, r := range val {
+			positions, _ := r.([]any)
+			round := make([]int, 0, len(positions))
+			for _, p := range positions {
+				if pos, ok := p.(float64); ok {
+					round = append(round, int(pos))
+				}
+			}
+			f.CustomDraftOrder = append(f.CustomDraftOrder, round)
+		}
+	}
 	if val, ok := m["draft_order_type"].(string); ok {
 		f.DraftOrderType = enums.DraftOrderType(val)
 	}
+	if val, ok := m["draft_mode"].(string); ok {
+		f.DraftMode = enums.DraftMode(val).Normalize()
+	}
+	if val, ok := m["auction_bid_time_seconds"].(float64); ok {
+		f.AuctionBidTimeSeconds = int(val)
+	}
+	if val, ok := m["turn_timeout_policy"].(string); ok {
+		f.TurnTimeoutPolicy = enums.DraftTimeoutPolicy(val).Normalize()
+	}
+	if val, ok := m["starting_skips"].(float64); ok {
+		startingSkips := int(val)
+		f.StartingSkips = &startingSkips
+	}
+	if val, ok := m["timezone"].(string); ok {
+		f.Timezone = val
+	}
+	if val, ok := m["draft_quiet_hours_start"].(string); ok {
+		f.DraftQuietHoursStart = val
+	}
+	if val, ok := m["draft_quiet_hours_end"].(string); ok {
+		f.DraftQuietHoursEnd = val
+	}
+	if val, ok := m["round_turn_time_limits"].([]any); ok {
+		f.RoundTurnTimeLimits = make([]RoundTurnTimeLimit, 0, len(val))
+		for _, o := range val {
+			om, _ := o.(map[string]any)
+			fromRound, _ := om["from_round"].(float64)
+			turnTimeLimit, _ := om["turn_time_limit"].(float64)
+			f.RoundTurnTimeLimits = append(f.RoundTurnTimeLimits, RoundTurnTimeLimit{FromRound: int(fromRound), TurnTimeLimit: int(turnTimeLimit)})
+		}
+	}
+	if val, ok := m["turn_reminder_percents"].([]any); ok {
+		f.TurnReminderPercents = make([]int, 0, len(val))
+		for _, p := range val {
+			percent, _ := p.(float64)
+			f.TurnReminderPercents = append(f.TurnReminderPercents, int(percent))
+		}
+	}
 	if val, ok := m["season_type"].(string); ok {
 		f.SeasonType = enums.LeagueSeasonType(val)
 	}
@@ -65,6 +150,12 @@ func (f *LeagueFormat) Scan(value any) error {
 	if val, ok := m["playoff_seeding_type"].(string); ok {
 		f.PlayoffSeedingType = enums.LeaguePlayoffSeedingType(val)
 	}
+	if val, ok := m["no_grand_final_reset"].(bool); ok {
+		f.NoGrandFinalReset = val
+	}
+	if val, ok := m["auto_finalize_confirmed_games"].(bool); ok {
+		f.AutoFinalizeConfirmedGames = val
+	}
 	if val, ok := m["allow_transfer"].(bool); ok {
 		f.AllowTransfers = val
 	}
@@ -89,6 +180,30 @@ func (f *LeagueFormat) Scan(value any) error {
 	if val, ok := m["pickup_cost"].(float64); ok {
 		f.PickupCost = int(val)
 	}
+	if val, ok := m["tiers"].([]any); ok {
+		f.Tiers = make([]PoolTier, 0, len(val))
+		for _, t := range val {
+			tm, _ := t.(map[string]any)
+			tier := PoolTier{}
+			tier.Name, _ = tm["name"].(string)
+			if limit, ok := tm["max_per_roster"].(float64); ok {
+				maxPerRoster := int(limit)
+				tier.MaxPerRoster = &maxPerRoster
+			}
+			if limit, ok := tm["min_per_roster"].(float64); ok {
+				minPerRoster := int(limit)
+				tier.MinPerRoster = &minPerRoster
+			}
+			f.Tiers = append(f.Tiers, tier)
+		}
+	}
+	if val, ok := m["tiebreakers"].([]any); ok {
+		f.Tiebreakers = make([]enums.StandingsTiebreaker, 0, len(val))
+		for _, t := range val {
+			tiebreaker, _ := t.(string)
+			f.Tiebreakers = append(f.Tiebreakers, enums.StandingsTiebreaker(tiebreaker).Normalize())
+		}
+	}
 	if val, ok := m["next_transfer_window_start"].(string); ok {
 		t, err := time.Parse(time.RFC3339, val)
 		if err == nil {
@@ -101,15 +216,43 @@ func (f *LeagueFormat) Scan(value any) error {
 
 // Value implements the driver.Valuer interface for GORM JSONB serialization.
 func (f LeagueFormat) Value() (driver.Value, error) {
+	tiers := make([]map[string]any, 0, len(f.Tiers))
+	for _, tier := range f.Tiers {
+		tiers = append(tiers, map[string]any{
+			"name":           tier.Name,
+			"max_per_roster": tier.MaxPerRoster,
+			"min_per_roster": tier.MinPerRoster,
+		})
+	}
+	roundTurnTimeLimits := make([]map[string]any, 0, len(f.RoundTurnTimeLimits))
+	for _, override := range f.RoundTurnTimeLimits {
+		roundTurnTimeLimits = append(roundTurnTimeLimits, map[string]any{
+			"from_round":      override.FromRound,
+			"turn_time_limit": override.TurnTimeLimit,
+		})
+	}
 	m := map[string]any{
 		"is_snake_round_draft":           f.IsSnakeRoundDraft,
+		"draft_round_order":              f.DraftRoundOrder,
+		"custom_draft_order":             f.CustomDraftOrder,
 		"draft_order_type":               f.DraftOrderType,
+		"draft_mode":                     f.DraftMode,
+		"auction_bid_time_seconds":       f.AuctionBidTimeSeconds,
+		"turn_timeout_policy":            f.TurnTimeoutPolicy,
+		"starting_skips":                 f.StartingSkips,
+		"timezone":                       f.Timezone,
+		"draft_quiet_hours_start":        f.DraftQuietHoursStart,
+		"draft_quiet_hours_end":          f.DraftQuietHoursEnd,
+		"round_turn_time_limits":         roundTurnTimeLimits,
+		"turn_reminder_percents":         f.TurnReminderPercents,
 		"season_type":                    f.SeasonType,
 		"group_count":                    f.GroupCount,
 		"playoff_type":                   f.PlayoffType,
 		"playoff_participant_count":      f.PlayoffParticipantCount,
 		"playoff_byes_count":             f.PlayoffByesCount,
 		"playoff_seeding_type":           f.PlayoffSeedingType,
+		"no_grand_final_reset":           f.NoGrandFinalReset,
+		"auto_finalize_confirmed_games":  f.AutoFinalizeConfirmedGames,
 		"allow_trading":                  f.AllowTransfers,
 		"allow_transfer_credits":         f.TransfersCostCredits,
 		"transfer_credits_per_window":    f.TransferCreditsPerWindow,
@@ -119,6 +262,106 @@ func (f LeagueFormat) Value() (driver.Value, error) {
 		"drop_cost":                      f.DropCost,
 		"pickup_cost":                    f.PickupCost,
 		"next_transfer_window_start":     f.NextTransferWindowStart,
+		"tiers":                          tiers,
+		"tiebreakers":                    f.Tiebreakers,
 	}
 	return json.Marshal(m)
 }
+
+// RoundOrder returns the order members pick in within each round. Formats saved before DraftRoundOrder
+// existed only have IsSnakeRoundDraft.
+func (f *LeagueFormat) RoundOrder() enums.DraftRoundOrder {
+	if f == nil {
+		return enums.DraftRoundOrderLinear
+	}
+	if f.DraftRoundOrder != "" {
+		return f.DraftRoundOrder
+	}
+	if f.IsSnakeRoundDraft {
+		return enums.DraftRoundOrderSnake
+	}
+	return enums.DraftRoundOrderLinear
+}
+
+// IsAuctionDraft reports whether the league drafts by nomination and bidding instead of taking turns picking.
+func (f *LeagueFormat) IsAuctionDraft() bool {
+	return f != nil && f.DraftMode == enums.DraftModeAuction
+}
+
+// TimeoutPolicy returns what the draft does when a turn timer runs out. Formats saved before the
+// setting existed keep the original behaviour: draft from the member's queue, else skip.
+func (f *LeagueFormat) TimeoutPolicy() enums.DraftTimeoutPolicy {
+	if f == nil || f.TurnTimeoutPolicy == "" {
+		return enums.DraftTimeoutPolicyAutoPickQueue
+	}
+	return f.TurnTimeoutPolicy
+}
+
+// StartingSkipsLeft returns the SkipsLeft a member starts the draft with. It never exceeds
+// maxPokemon - minPokemon, since SkipsLeft is what keeps a roster from ending the draft short.
+func (f *LeagueFormat) StartingSkipsLeft(minPokemon, maxPokemon int) int {
+	skips := maxPokemon - minPokemon
+	if f != nil && f.StartingSkips != nil {
+		skips = min(max(*f.StartingSkips, 0), skips)
+	}
+	return skips
+}
+
+// DraftClock returns the turn clock settings a draft starting now should run on, or nil if the league
+// uses a plain clock.
+func (f *LeagueFormat) DraftClock() *DraftClock {
+	if f == nil || (f.Timezone == "" && f.DraftQuietHoursStart == "" && f.DraftQuietHoursEnd == "" && len(f.RoundTurnTimeLimits) == 0 && len(f.TurnReminderPercents) == 0) {
+		return nil
+	}
+	return &DraftClock{
+		Timezone:             f.Timezone,
+		QuietHoursStart:      f.DraftQuietHoursStart,
+		QuietHoursEnd:        f.DraftQuietHoursEnd,
+		RoundTurnTimeLimits:  slices.Clone(f.RoundTurnTimeLimits),
+		TurnReminderPercents: slices.Clone(f.TurnReminderPercents),
+	}
+}
+
+// Tier returns the league's tier called name, or nil if the league has no such tier.
+func (f *LeagueFormat) Tier(name string) *PoolTier {
+	if f == nil {
+		return nil
+	}
+	for i := range f.Tiers {
+		if f.Tiers[i].Name == name {
+			return &f.Tiers[i]
+		}
+	}
+	return nil
+}
+
+// HasTiers reports whether the league's pool is split into tiers.
+func (f *LeagueFormat) HasTiers() bool {
+	return f != nil && len(f.Tiers) > 0
+}
+
+// StandingsTiebreakers returns the tiebreak chain the league's standings and playoff seeding use. It always
+// ends in a coin flip, so every tie is broken.
+func (f *LeagueFormat) StandingsTiebreakers() []enums.StandingsTiebreaker {
+	chain := DefaultTiebreakers
+	if f != nil && len(f.Tiebreakers) > 0 {
+		chain = f.Tiebreakers
+	}
+	if !slices.Contains(chain, enums.StandingsTiebreakerCoinFlip) {
+		chain = append(slices.Clone(chain), enums.StandingsTiebreakerCoinFlip)
+	}
+	return chain
+}
+
+// ValidateTiebreakers checks the league's tiebreak chain names each tiebreaker at most once.
+func (f *LeagueFormat) ValidateTiebreakers() error {
+	for i, tiebreaker := range f.Tiebreakers {
+		if !tiebreaker.IsValid() {
+			return fmt.Errorf("unknown tiebreaker %q", tiebreaker)
+		}
+		if slices.Contains(f.Tiebreakers[:i], tiebreaker) {
+			return fmt.Errorf("tiebreaker %s is listed twice", tiebreaker)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time. Services read it instead of calling time.Now, so that in dev the whole
+// app can be moved forward in time (VirtualClock) to play through a season without waiting for it.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock is the wall clock. Services use it unless given another Clock.
+var SystemClock Clock = systemClock{}
+
+// VirtualClock runs at wall-clock speed, ahead of the wall clock by an offset that only grows. It is
+// never moved backward, so tasks and deadlines already in the past stay in the past.
+type VirtualClock struct {
+	mu     sync.RWMutex
+	offset time.Duration
+}
+
+func NewVirtualClock() *VirtualClock {
+	return &VirtualClock{}
+}
+
+func (c *VirtualClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Now().Add(c.offset)
+}
+
+// AdvanceTo moves the clock forward to t, and returns the new current time. A t already in the past
+// leaves the clock as it is.
+func (c *VirtualClock) AdvanceTo(t time.Time) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if ahead := t.Sub(now); ahead > c.offset {
+		c.offset = ahead
+	}
+	return now.Add(c.offset)
+}
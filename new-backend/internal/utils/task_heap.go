@@ -1,18 +1,22 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-type TaskType int // not a string like my other enums cuz it's not going into the db
+type TaskType int // stored by its String() name (models.ScheduledTask.Type), so reordering these is safe
 const (
 	TaskTypeDraftTurnTimeout TaskType = iota
 	TaskTypeTransferPeriodEnd
 	TaskTypeTransferPeriodStart
 	TaskTypeLeagueWeeklyTick
+	TaskTypeAuctionLotClose
+	TaskTypeDraftTurnReminder
+	TaskTypeDraftStart
 )
 
 func (t TaskType) String() string {
@@ -25,23 +29,89 @@ func (t TaskType) String() string {
 		return "TRADING_PERIOD_START"
 	case TaskTypeLeagueWeeklyTick:
 		return "LEAGUE_WEEKLY_TICK"
+	case TaskTypeAuctionLotClose:
+		return "AUCTION_LOT_CLOSE"
+	case TaskTypeDraftTurnReminder:
+		return "DRAFT_TURN_REMINDER"
+	case TaskTypeDraftStart:
+		return "DRAFT_START"
 	}
 	return ""
 }
 
+// ParseTaskType is the inverse of TaskType.String.
+func ParseTaskType(name string) (TaskType, error) {
+	for t := TaskTypeDraftTurnTimeout; t <= TaskTypeDraftStart; t++ {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown task type %q", name)
+}
+
+// DecodePayload unmarshals a task payload stored as JSON into the payload struct of taskType.
+func DecodePayload(taskType TaskType, data []byte) (any, error) {
+	switch taskType {
+	case TaskTypeDraftTurnTimeout:
+		return decodePayload[PayloadDraftTurnTimeout](data)
+	case TaskTypeTransferPeriodEnd:
+		return decodePayload[PayloadTransferPeriodEnd](data)
+	case TaskTypeTransferPeriodStart:
+		return decodePayload[PayloadTransferPeriodStart](data)
+	case TaskTypeLeagueWeeklyTick:
+		return decodePayload[PayloadLeagueWeeklyTick](data)
+	case TaskTypeAuctionLotClose:
+		return decodePayload[PayloadAuctionLotClose](data)
+	case TaskTypeDraftTurnReminder:
+		return decodePayload[PayloadDraftTurnReminder](data)
+	case TaskTypeDraftStart:
+		return decodePayload[PayloadDraftStart](data)
+	}
+	return nil, fmt.Errorf("no payload type for task type %d", taskType)
+}
+
+func decodePayload[P any](data []byte) (any, error) {
+	var payload P
+	err := json.Unmarshal(data, &payload)
+	return payload, err
+}
+
 type ScheduledTask struct {
 	ID        string
 	ExecuteAt time.Time
 	Type      TaskType
 	Payload   any
 	Index     int
+	Attempts  int // failed runs so far; drives the retry backoff
+}
+
+// PayloadLeagueID returns the league a task payload acts on, or uuid.Nil for a payload without one.
+func PayloadLeagueID(payload any) uuid.UUID {
+	switch p := payload.(type) {
+	case PayloadDraftTurnTimeout:
+		return p.LeagueID
+	case PayloadTransferPeriodEnd:
+		return p.LeagueID
+	case PayloadTransferPeriodStart:
+		return p.LeagueID
+	case PayloadLeagueWeeklyTick:
+		return p.LeagueID
+	case PayloadAuctionLotClose:
+		return p.LeagueID
+	case PayloadDraftTurnReminder:
+		return p.LeagueID
+	case PayloadDraftStart:
+		return p.LeagueID
+	}
+	return uuid.Nil
 }
 
 // payloads
 type PayloadDraftTurnTimeout struct {
-	DraftID  uuid.UUID
-	LeagueID uuid.UUID
-	PlayerID uuid.UUID // The player whose turn it is
+	DraftID    uuid.UUID
+	LeagueID   uuid.UUID
+	PlayerID   uuid.UUID // The player whose turn it is
+	PickNumber int       // The pick on the clock; a timeout for a turn that has since ended is ignored. 0 in tasks stored before it was added
 }
 type PayloadTransferPeriodEnd struct {
 	LeagueID uuid.UUID
@@ -52,6 +122,20 @@ type PayloadTransferPeriodStart struct {
 type PayloadLeagueWeeklyTick struct {
 	LeagueID uuid.UUID
 }
+type PayloadAuctionLotClose struct {
+	LeagueID    uuid.UUID
+	PoolEntryID uuid.UUID // The pool entry up for auction; a stale task for an already closed lot is ignored
+}
+type PayloadDraftTurnReminder struct {
+	LeagueID   uuid.UUID
+	PlayerID   uuid.UUID // The player whose turn it is
+	PickNumber int       // The pick on the clock; a reminder for a turn that has since ended is ignored
+	Percent    int       // How much of the turn clock has run
+}
+type PayloadDraftStart struct {
+	LeagueID     uuid.UUID
+	ScheduledFor time.Time // The League.ScheduledDraftStart the task was registered for; a stale task is ignored
+}
 
 type TaskHeap []*ScheduledTask
 